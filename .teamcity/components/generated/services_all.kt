@@ -111,6 +111,7 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
     "healthlake" to ServiceSpec("HealthLake"),
@@ -157,6 +158,7 @@ val services = mapOf(
     "neptunegraph" to ServiceSpec("Neptune Analytics"),
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager", vpcLock = true),
+    "networkmonitor" to ServiceSpec("CloudWatch Network Monitor"),
     "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
     "opensearch" to ServiceSpec("OpenSearch", vpcLock = true),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
@@ -180,6 +182,7 @@ val services = mapOf(
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
     "rekognition" to ServiceSpec("Rekognition"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -211,6 +214,7 @@ val services = mapOf(
     "shield" to ServiceSpec("Shield"),
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
+    "snowball" to ServiceSpec("Snow Family"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.54.2
-	github.com/aws/aws-sdk-go-v2 v1.27.2
+	github.com/aws/aws-sdk-go-v2 v1.32.3
 	github.com/aws/aws-sdk-go-v2/config v1.27.18
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.18
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.5
@@ -101,6 +101,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/fms v1.33.7
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.22.10
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.24.1
+	github.com/aws/aws-sdk-go-v2/service/greengrassv2 v1.35.3
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.27.6
 	github.com/aws/aws-sdk-go-v2/service/guardduty v1.43.0
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.24.6
@@ -130,6 +131,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mq v1.22.11
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.27.4
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.8.7
+	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.5.3
 	github.com/aws/aws-sdk-go-v2/service/oam v1.11.6
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.11.13
 	github.com/aws/aws-sdk-go-v2/service/organizations v1.27.9
@@ -148,6 +150,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.25.10
 	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.18.8
 	github.com/aws/aws-sdk-go-v2/service/rekognition v1.40.6
+	github.com/aws/aws-sdk-go-v2/service/resiliencehub v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.10.11
 	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.22.6
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.21.10
@@ -193,7 +196,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.6
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.6
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.10
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.22.0
 	github.com/beevik/etree v1.4.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -247,8 +250,8 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.9 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.9 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.22 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.22 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.9 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -37,6 +38,8 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
+	adoptExistingResources    bool                       // From provider configuration.
+	apiCallLimiters           map[string]*apiCallLimiter // From provider configuration.
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
@@ -52,6 +55,11 @@ type AWSClient struct {
 	stsRegion                 string // From provider configuration.
 }
 
+// AdoptExistingResources returns the adopt_existing_resources provider configuration value.
+func (c *AWSClient) AdoptExistingResources(context.Context) bool {
+	return c.adoptExistingResources
+}
+
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
 func (c *AWSClient) CredentialsProvider(context.Context) aws_sdkv2.CredentialsProvider {
 	if c.awsConfig == nil {
@@ -272,6 +280,12 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if l, ok := c.apiCallLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), l.addMiddleware)
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = l.session(c.session)
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes that are never rate limited.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Test",
+	"Validate",
+}
+
+// isMutatingOperation returns whether or not the named AWS API operation modifies resources.
+func isMutatingOperation(operationName string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operationName, prefix) {
+			return false
+		}
+	}
+
+	return operationName != ""
+}
+
+// apiCallLimiter caps the number of in-flight mutating API calls to a single service.
+// Callers blocked on a full limiter are admitted in the order they arrived.
+type apiCallLimiter struct {
+	name      string
+	semaphore chan struct{}
+	acquired  sync.Map // SDK v1 requests holding a slot.
+}
+
+func newAPICallLimiter(servicePackageName string, limit int) *apiCallLimiter {
+	return &apiCallLimiter{
+		name:      servicePackageName,
+		semaphore: make(chan struct{}, limit),
+	}
+}
+
+func (l *apiCallLimiter) acquire(ctx context.Context, operationName string) error {
+	select {
+	case l.semaphore <- struct{}{}:
+		return nil
+	default:
+	}
+
+	tflog.Debug(ctx, "waiting for mutating API call slot", map[string]any{
+		"tf_aws.service_package": l.name,
+		"tf_aws.operation":       operationName,
+	})
+
+	select {
+	case l.semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *apiCallLimiter) release() {
+	<-l.semaphore
+}
+
+// addMiddleware registers the limiter with an AWS SDK for Go v2 API client's middleware stack.
+// The slot is held for the duration of the operation, including any retries.
+func (l *apiCallLimiter) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("ServiceConcurrencyLimit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operationName := awsmiddleware.GetOperationName(ctx)
+
+		if !isMutatingOperation(operationName) {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		if err := l.acquire(ctx, operationName); err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+		defer l.release()
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+// session returns a copy of the specified AWS SDK for Go v1 session with the limiter's request handlers registered.
+func (l *apiCallLimiter) session(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	sess = sess.Copy()
+
+	// Send handlers run on every attempt; the slot is only acquired on the first.
+	sess.Handlers.Send.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.ServiceConcurrencyLimit.Acquire",
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Operation == nil || !isMutatingOperation(r.Operation.Name) {
+				return
+			}
+
+			if _, ok := l.acquired.Load(r); ok {
+				return
+			}
+
+			if err := l.acquire(r.Context(), r.Operation.Name); err != nil {
+				r.Error = err
+				return
+			}
+
+			l.acquired.Store(r, struct{}{})
+		},
+	})
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.ServiceConcurrencyLimit.Release",
+		Fn: func(r *request_sdkv1.Request) {
+			if _, ok := l.acquired.LoadAndDelete(r); ok {
+				l.release()
+			}
+		},
+	})
+
+	return sess
+}
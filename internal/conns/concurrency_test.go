@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsMutatingOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"":                   false,
+		"AttachRolePolicy":   true,
+		"BatchGetItem":       false,
+		"CreateDistribution": true,
+		"DeleteHostedZone":   true,
+		"DescribeInstances":  false,
+		"GetRole":            false,
+		"ListTagsForRole":    false,
+		"PutBucketPolicy":    true,
+		"UpdateServer":       true,
+	}
+
+	for operationName, want := range testCases {
+		operationName, want := operationName, want
+
+		t.Run(operationName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isMutatingOperation(operationName); got != want {
+				t.Errorf("isMutatingOperation(%q) = %t, want %t", operationName, got, want)
+			}
+		})
+	}
+}
+
+func TestAPICallLimiterAcquire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newAPICallLimiter("iam", 1)
+
+	if err := l.acquire(ctx, "CreateRole"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		if err := l.acquire(ctx, "CreateRole"); err == nil {
+			close(doneCh)
+		}
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second slot was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	l.release()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second slot was not taken after release.")
+	}
+}
+
+func TestAPICallLimiterAcquireContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := newAPICallLimiter("route53", 1)
+
+	if err := l.acquire(ctx, "ChangeResourceRecordSets"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancel()
+
+	if err := l.acquire(ctx, "ChangeResourceRecordSets"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	AdoptExistingResources         bool
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
@@ -43,6 +44,7 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MutatingAPIConcurrency         map[string]int
 	NoProxy                        string
 	Profile                        string
 	Region                         string
@@ -210,6 +212,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	client.adoptExistingResources = c.AdoptExistingResources
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
@@ -219,6 +222,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
+	client.apiCallLimiters = make(map[string]*apiCallLimiter, len(c.MutatingAPIConcurrency))
+	for servicePackageName, limit := range c.MutatingAPIConcurrency {
+		client.apiCallLimiters[servicePackageName] = newAPICallLimiter(servicePackageName, limit)
+	}
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
@@ -102,6 +102,19 @@ func AppendDiagWarningMessage(diags diag.Diagnostics, service, action, resource,
 	)
 }
 
+// AppendDiagAdoptedWarning appends a standardized warning that an existing resource was
+// adopted into state instead of being created.
+func AppendDiagAdoptedWarning(diags diag.Diagnostics, service, resource, id string) diag.Diagnostics {
+	return append(diags,
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  ProblemStandardMessage(service, ErrActionCreating, resource, id, errors.New("already exists, adopted into state")),
+			Detail: "The provider's adopt_existing_resources setting is enabled. The existing resource was updated to match " +
+				"the configuration and read into state. It is now managed by Terraform and will be deleted on destroy.",
+		},
+	)
+}
+
 // WarnLog logs to the default logger a standardized problem message
 func WarnLog(service, action, resource, id string, gotError error) {
 	log.Printf("[WARN] %s", ProblemStandardMessage(service, action, resource, id, gotError))
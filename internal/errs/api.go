@@ -4,6 +4,10 @@
 package errs
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	smithy "github.com/aws/smithy-go"
 )
 
@@ -14,3 +18,66 @@ func APIError[T ~string](code T, message string) smithy.APIError {
 		Message: message,
 	}
 }
+
+// APIErrorDetails identifies a failed AWS API call.
+type APIErrorDetails struct {
+	Service   string
+	Operation string
+	RequestID string
+	Code      string
+}
+
+// NewAPIErrorDetails extracts the service, operation, request ID and error code from an
+// AWS SDK for Go v1 or v2 error.
+// The second return value is false if err does not carry any of these fields.
+func NewAPIErrorDetails(err error) (APIErrorDetails, bool) {
+	var details APIErrorDetails
+
+	if err == nil {
+		return details, false
+	}
+
+	// AWS SDK for Go v2.
+	if opErr, ok := As[*smithy.OperationError](err); ok {
+		details.Service = opErr.Service()
+		details.Operation = opErr.Operation()
+	}
+	var respErr interface{ ServiceRequestID() string }
+	if errors.As(err, &respErr) {
+		details.RequestID = respErr.ServiceRequestID()
+	}
+	if apiErr, ok := As[smithy.APIError](err); ok {
+		details.Code = apiErr.ErrorCode()
+	}
+
+	// AWS SDK for Go v1.
+	if v, ok := As[awserr.RequestFailure](err); ok && details.RequestID == "" {
+		details.RequestID = v.RequestID()
+	}
+	if v, ok := As[awserr.Error](err); ok && details.Code == "" {
+		details.Code = v.Code()
+	}
+
+	return details, details != APIErrorDetails{}
+}
+
+// String returns the non-empty fields, one per line.
+func (d APIErrorDetails) String() string {
+	var lines []string
+
+	if d.Operation != "" {
+		if d.Service != "" {
+			lines = append(lines, "Operation: "+d.Service+" "+d.Operation)
+		} else {
+			lines = append(lines, "Operation: "+d.Operation)
+		}
+	}
+	if d.RequestID != "" {
+		lines = append(lines, "Request ID: "+d.RequestID)
+	}
+	if d.Code != "" {
+		lines = append(lines, "Error Code: "+d.Code)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestNewAPIErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	sdkv2Err := &smithy.OperationError{
+		ServiceID:     "S3",
+		OperationName: "PutObject",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+				Err:      &smithy.GenericAPIError{Code: "NoSuchBucket", Message: "The specified bucket does not exist"},
+			},
+			RequestID: "request-1",
+		},
+	}
+	sdkv1Err := awserr.NewRequestFailure(awserr.New("ValidationException", "invalid", nil), http.StatusBadRequest, "request-2")
+
+	testCases := []struct {
+		testName string
+		err      error
+		want     errs.APIErrorDetails
+		wantOK   bool
+		wantText string
+	}{
+		{
+			testName: "nil error",
+		},
+		{
+			testName: "non-AWS error",
+			err:      errors.New("test"),
+		},
+		{
+			testName: "AWS SDK for Go v2 error",
+			err:      fmt.Errorf("wrapped: %w", sdkv2Err),
+			want: errs.APIErrorDetails{
+				Service:   "S3",
+				Operation: "PutObject",
+				RequestID: "request-1",
+				Code:      "NoSuchBucket",
+			},
+			wantOK:   true,
+			wantText: "Operation: S3 PutObject\nRequest ID: request-1\nError Code: NoSuchBucket",
+		},
+		{
+			testName: "AWS SDK for Go v1 error",
+			err:      fmt.Errorf("wrapped: %w", sdkv1Err),
+			want: errs.APIErrorDetails{
+				RequestID: "request-2",
+				Code:      "ValidationException",
+			},
+			wantOK:   true,
+			wantText: "Request ID: request-2\nError Code: ValidationException",
+		},
+		{
+			testName: "API error without request",
+			err:      errs.APIError("ConflictException", "conflict"),
+			want: errs.APIErrorDetails{
+				Code: "ConflictException",
+			},
+			wantOK:   true,
+			wantText: "Error Code: ConflictException",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.NewAPIErrorDetails(testCase.err)
+
+			if ok != testCase.wantOK {
+				t.Errorf("ok = %t, want %t", ok, testCase.wantOK)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %+v, want %+v", got, testCase.want)
+			}
+
+			if got, want := got.String(), testCase.wantText; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +18,19 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error Diagnostic formatted from format and a.
+// If a contains an AWS API error, its operation, request ID and error code are added to the Diagnostic's Detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withAPIErrorDetails(diag.Errorf(format, a...), lastError(a))...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
 }
 
+// AppendFromErr appends an error Diagnostic for err.
+// If err is an AWS API error, its operation, request ID and error code are added to the Diagnostic's Detail.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, withAPIErrorDetails(diag.FromErr(err), err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -43,3 +48,30 @@ func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics
 		}
 	})
 }
+
+func withAPIErrorDetails(diags diag.Diagnostics, err error) diag.Diagnostics {
+	details, ok := errs.NewAPIErrorDetails(err)
+	if !ok {
+		return diags
+	}
+
+	return tfslices.ApplyToAll(diags, func(d diag.Diagnostic) diag.Diagnostic {
+		if d.Detail == "" {
+			d.Detail = details.String()
+		} else {
+			d.Detail = fmt.Sprintf("%s\n\n%s", d.Detail, details.String())
+		}
+		return d
+	})
+}
+
+// lastError returns the last error in a, or nil.
+func lastError(a []any) error {
+	for i := len(a) - 1; i >= 0; i-- {
+		if err, ok := a[i].(error); ok {
+			return err
+		}
+	}
+
+	return nil
+}
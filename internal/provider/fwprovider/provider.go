@@ -52,6 +52,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The access key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"adopt_existing_resources": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Adopt existing resources into state instead of failing when a create returns an \"already exists\" error. Only supported by some resource types.",
+			},
 			"allowed_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -88,6 +92,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"mutating_api_concurrency": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Maximum number of concurrent mutating AWS API calls per service, keyed by service name (e.g. `iam`). Calls to services not listed are not limited.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
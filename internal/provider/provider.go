@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,6 +44,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The access key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"adopt_existing_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Adopt existing resources into state instead of failing when a create " +
+					"returns an \"already exists\" error. Only supported by some resource types.",
+			},
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -140,6 +147,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"mutating_api_concurrency": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Maximum number of concurrent mutating AWS API calls per service, keyed by service name (e.g. `iam`). " +
+					"Calls to services not listed are not limited.",
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -477,6 +491,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AdoptExistingResources:         d.Get("adopt_existing_resources").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -571,6 +586,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("mutating_api_concurrency"); ok && len(v.(map[string]interface{})) > 0 {
+		concurrency, dx := expandMutatingAPIConcurrency(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.MutatingAPIConcurrency = concurrency
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -975,6 +999,44 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 	return endpoints, diags
 }
 
+func expandMutatingAPIConcurrency(_ context.Context, tfMap map[string]interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	concurrencyPath := cty.GetAttrPath("mutating_api_concurrency")
+	concurrency := make(map[string]int, len(tfMap))
+
+	for k, v := range tfMap {
+		elementPath := concurrencyPath.IndexString(k)
+
+		pkg := k
+		if !slices.Contains(names.ProviderPackages(), pkg) {
+			var err error
+			if pkg, err = names.ProviderPackageForAlias(k); err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath,
+					"Invalid Attribute Value",
+					fmt.Sprintf("Unknown service %q.", k),
+				))
+				continue
+			}
+		}
+
+		limit := v.(int)
+		if limit < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q must be at least 1, got: %d.", errs.PathString(elementPath), limit),
+			))
+			continue
+		}
+
+		concurrency[pkg] = limit
+	}
+
+	return concurrency, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
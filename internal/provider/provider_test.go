@@ -123,6 +123,32 @@ func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandMutatingAPIConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandMutatingAPIConcurrency(ctx, map[string]interface{}{
+		"iam":               2,
+		"transcribeservice": 1,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(results, map[string]int{names.IAM: 2, names.Transcribe: 1}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, diags = expandMutatingAPIConcurrency(ctx, map[string]interface{}{
+		"iam":       0,
+		"not-a-svc": 1,
+	})
+	if got, want := len(diags), 2; got != want {
+		t.Errorf("expected %d diagnostics, got %d", want, got)
+	}
+}
+
 func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -6,6 +6,7 @@ package amp
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"gopkg.in/yaml.v2"
 )
 
 // @SDKResource("aws_prometheus_alert_manager_definition", name="Alert Manager Definition")
@@ -36,8 +38,9 @@ func resourceAlertManagerDefinition() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validAlertManagerDefinition,
 			},
 			"workspace_id": {
 				Type:     schema.TypeString,
@@ -249,3 +252,50 @@ func waitAlertManagerDefinitionDeleted(ctx context.Context, conn *amp.Client, id
 
 	return nil, err
 }
+
+// validAlertManagerDefinition checks that an alert manager definition is a YAML document
+// containing an alertmanager_config string and, optionally, a template_files map.
+// See https://docs.aws.amazon.com/prometheus/latest/userguide/AMP-alertmanager-config.html.
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errors []error) {
+	var definition map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(v.(string)), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid YAML: %w", k, err))
+		return
+	}
+
+	for key := range definition {
+		switch key {
+		case "alertmanager_config", "template_files":
+		default:
+			errors = append(errors, fmt.Errorf("%q contains unsupported key %q, expected alertmanager_config or template_files", k, key))
+		}
+	}
+
+	config, ok := definition["alertmanager_config"].(string)
+	if !ok || config == "" {
+		errors = append(errors, fmt.Errorf("%q must contain an alertmanager_config string", k))
+		return
+	}
+
+	var alertmanagerConfig map[string]interface{}
+	if err := yaml.Unmarshal([]byte(config), &alertmanagerConfig); err != nil {
+		errors = append(errors, fmt.Errorf("%q alertmanager_config contains invalid YAML: %w", k, err))
+	}
+
+	if v, ok := definition["template_files"]; ok {
+		templateFiles, ok := v.(map[interface{}]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q template_files must be a map of file names to templates", k))
+			return
+		}
+
+		for name, template := range templateFiles {
+			if _, ok := template.(string); !ok {
+				errors = append(errors, fmt.Errorf("%q template_files entry %q must be a string", k, name))
+			}
+		}
+	}
+
+	return
+}
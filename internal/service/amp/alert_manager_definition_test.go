@@ -17,6 +17,84 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidAlertManagerDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		expectErr  bool
+	}{
+		"valid": {
+			definition: defaultAlertManagerDefinition(),
+		},
+		"valid with template files": {
+			definition: `
+template_files:
+  default_template: |
+    {{ define "sns.default.message" }}{{ .Status }}{{ end }}
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		},
+		"invalid YAML": {
+			definition: "alertmanager_config: [",
+			expectErr:  true,
+		},
+		"missing alertmanager_config": {
+			definition: `
+template_files:
+  default_template: ""
+`,
+			expectErr: true,
+		},
+		"alertmanager_config not a string": {
+			definition: `
+alertmanager_config:
+  route:
+    receiver: 'default'
+`,
+			expectErr: true,
+		},
+		"unsupported key": {
+			definition: `
+alertmanager_config: |
+  route:
+    receiver: 'default'
+route:
+  receiver: 'default'
+`,
+			expectErr: true,
+		},
+		"invalid template_files": {
+			definition: `
+alertmanager_config: |
+  route:
+    receiver: 'default'
+template_files:
+  - default_template
+`,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := tfamp.ValidAlertManagerDefinition(testCase.definition, "definition")
+
+			if got, want := len(errs) > 0, testCase.expectErr; got != want {
+				t.Errorf("ValidAlertManagerDefinition() errors = %v, expected error: %t", errs, want)
+			}
+		})
+	}
+}
+
 func TestAccAMPAlertManagerDefinition_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_prometheus_alert_manager_definition.test"
@@ -14,4 +14,6 @@ var (
 	FindRuleGroupNamespaceByARN    = findRuleGroupNamespaceByARN
 	FindScraperByID                = findScraperByID
 	FindWorkspaceByID              = findWorkspaceByID
+
+	ValidAlertManagerDefinition = validAlertManagerDefinition
 )
@@ -6,6 +6,7 @@ package appconfig
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -62,7 +63,51 @@ func ResourceExtensionAssociation() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceExtensionAssociationCustomizeDiff,
+	}
+}
+
+// resourceExtensionAssociationCustomizeDiff validates a new association's parameters against those declared by the extension,
+// so that missing required parameters are reported at plan time rather than when a deployment invokes the extension.
+// Updates are not validated as the extension's parameters may be changing in the same plan.
+func resourceExtensionAssociationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("extension_arn") || !d.NewValueKnown(names.AttrParameters) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	extensionARN := d.Get("extension_arn").(string)
+	extension, err := FindExtensionById(ctx, conn, extensionARN)
+
+	if tfresource.NotFound(err) {
+		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("reading AppConfig Extension (%s): %w", extensionARN, err)
+	}
+
+	parameters := d.Get(names.AttrParameters).(map[string]interface{})
+
+	for name, parameter := range extension.Parameters {
+		if _, ok := parameters[name]; parameter.Required && !ok {
+			return fmt.Errorf("AppConfig Extension (%s) requires parameter %q", extensionARN, name)
+		}
+	}
+
+	for name := range parameters {
+		if _, ok := extension.Parameters[name]; !ok {
+			return fmt.Errorf("AppConfig Extension (%s) does not declare parameter %q", extensionARN, name)
+		}
+	}
+
+	return nil
 }
 
 func resourceExtensionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_appconfig_extension", name="Extension")
+func DataSourceExtension() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceExtensionRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_point": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"point": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrAction: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrRoleARN: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrURI: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrParameter: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+const (
+	DSNameExtension = "Extension Data Source"
+)
+
+func dataSourceExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	out, err := findExtensionByIDAndVersion(ctx, conn, name, int32(d.Get(names.AttrVersion).(int)))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.AppConfig, create.ErrActionReading, DSNameExtension, name, err)
+	}
+
+	d.SetId(aws.ToString(out.Arn))
+	d.Set(names.AttrARN, out.Arn)
+	d.Set("action_point", flattenExtensionActionPoints(out.Actions))
+	d.Set(names.AttrDescription, out.Description)
+	d.Set(names.AttrName, out.Name)
+	d.Set(names.AttrParameter, flattenExtensionParameters(out.Parameters))
+	d.Set(names.AttrVersion, out.VersionNumber)
+
+	return diags
+}
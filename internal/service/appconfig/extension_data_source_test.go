@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppConfigExtensionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appconfig_extension.test"
+	resourceName := "aws_appconfig_extension.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "action_point.#", resourceName, "action_point.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parameter.#", resourceName, "parameter.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVersion, resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigExtensionDataSource_awsAuthored(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_appconfig_extension.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionDataSourceConfig_awsAuthored,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARNAccountID(dataSourceName, names.AttrARN, "appconfig", "aws", regexache.MustCompile(`extension/.+`)),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, "AWS.AppConfig.DeploymentNotificationsToEventBridge"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccExtensionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExtensionConfig_name(rName), `
+data "aws_appconfig_extension" "test" {
+  name = aws_appconfig_extension.test.arn
+}
+`)
+}
+
+const testAccExtensionDataSourceConfig_awsAuthored = `
+data "aws_appconfig_extension" "test" {
+  name    = "AWS.AppConfig.DeploymentNotificationsToEventBridge"
+  version = 1
+}
+`
@@ -108,6 +108,27 @@ func TestAccAppConfigExtensionAssociation_Parameters(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigExtensionAssociation_parametersValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationConfig_parametersValidation(rName, ""),
+			},
+			{
+				Config:      testAccExtensionAssociationConfig_parametersValidation(rName, "parameter2"),
+				ExpectError: regexache.MustCompile(`requires parameter "parameter1"`),
+			},
+		},
+	})
+}
+
 func TestAccAppConfigExtensionAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -319,3 +340,43 @@ resource "aws_appconfig_extension_association" "test" {
 }
 `, rName, pName, pDescription, pRequired, pValue))
 }
+
+func testAccExtensionAssociationConfig_parametersValidation(rName, associationParameter string) string {
+	config := acctest.ConfigCompose(
+		testAccExtensionAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_extension" "test" {
+  name = %[1]q
+  action_point {
+    point = "ON_DEPLOYMENT_COMPLETE"
+    action {
+      name     = "test"
+      role_arn = aws_iam_role.test.arn
+      uri      = aws_sns_topic.test.arn
+    }
+  }
+  parameter {
+    name     = "parameter1"
+    required = true
+  }
+  parameter {
+    name     = "parameter2"
+    required = false
+  }
+}
+`, rName))
+
+	if associationParameter == "" {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, fmt.Sprintf(`
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn = aws_appconfig_extension.test.arn
+  resource_arn  = aws_appconfig_application.test.arn
+  parameters = {
+    %[1]s = "value"
+  }
+}
+`, associationParameter))
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	featureFlagsContentType = "application/json"
+	featureFlagsVersion     = "1"
+)
+
+// @SDKResource("aws_appconfig_feature_flags", name="Feature Flags")
+func ResourceFeatureFlags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeatureFlagsCreate,
+		ReadWithoutTimeout:   resourceFeatureFlagsRead,
+		DeleteWithoutTimeout: resourceHostedConfigurationVersionDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrApplicationID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
+			},
+			names.AttrContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"flag": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringNotInSlice([]string{names.AttrEnabled}, false),
+									},
+									"required": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrValues: {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFeatureFlagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	appID := d.Get(names.AttrApplicationID).(string)
+	profileID := d.Get("configuration_profile_id").(string)
+
+	content, err := expandFeatureFlagsContent(d.Get("flag").([]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppConfig Feature Flags for Application (%s): %s", appID, err)
+	}
+
+	input := &appconfig.CreateHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(profileID),
+		Content:                content,
+		ContentType:            aws.String(featureFlagsContentType),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateHostedConfigurationVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppConfig Feature Flags for Application (%s): %s", appID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", aws.ToString(output.ApplicationId), aws.ToString(output.ConfigurationProfileId), output.VersionNumber))
+
+	return append(diags, resourceFeatureFlagsRead(ctx, d, meta)...)
+}
+
+func resourceFeatureFlagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	appID, confProfID, versionNumber, err := HostedConfigurationVersionParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppConfig Feature Flags (%s): %s", d.Id(), err)
+	}
+
+	output, err := conn.GetHostedConfigurationVersion(ctx, &appconfig.GetHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(confProfID),
+		VersionNumber:          aws.Int32(versionNumber),
+	})
+
+	if !d.IsNewResource() && errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		log.Printf("[WARN] Appconfig Feature Flags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppConfig Feature Flags (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s/hostedconfigurationversion/%d", appID, confProfID, versionNumber),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set(names.AttrApplicationID, output.ApplicationId)
+	d.Set(names.AttrARN, arn)
+	d.Set("configuration_profile_id", output.ConfigurationProfileId)
+	d.Set(names.AttrContent, string(output.Content))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("version_number", output.VersionNumber)
+
+	return diags
+}
+
+// featureFlagsDocument is the AWS.AppConfig.FeatureFlags configuration profile content.
+// See https://docs.aws.amazon.com/appconfig/latest/userguide/appconfig-type-reference-feature-flags.html.
+type featureFlagsDocument struct {
+	Flags   map[string]featureFlag    `json:"flags"`
+	Values  map[string]map[string]any `json:"values"`
+	Version string                    `json:"version"`
+}
+
+type featureFlag struct {
+	Attributes  map[string]featureFlagAttribute `json:"attributes,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Name        string                          `json:"name"`
+}
+
+type featureFlagAttribute struct {
+	Constraints featureFlagAttributeConstraints `json:"constraints"`
+}
+
+type featureFlagAttributeConstraints struct {
+	Required bool   `json:"required,omitempty"`
+	Type     string `json:"type"`
+}
+
+func expandFeatureFlagsContent(tfList []interface{}) ([]byte, error) {
+	doc := featureFlagsDocument{
+		Flags:   make(map[string]featureFlag),
+		Values:  make(map[string]map[string]any),
+		Version: featureFlagsVersion,
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := tfMap[names.AttrKey].(string)
+		if _, ok := doc.Flags[key]; ok {
+			return nil, fmt.Errorf("duplicate flag %q", key)
+		}
+
+		flag := featureFlag{
+			Description: tfMap[names.AttrDescription].(string),
+			Name:        tfMap[names.AttrName].(string),
+		}
+		if flag.Name == "" {
+			flag.Name = key
+		}
+
+		values := map[string]any{
+			names.AttrEnabled: tfMap[names.AttrEnabled].(bool),
+		}
+
+		for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			attrKey := tfMap[names.AttrKey].(string)
+			if _, ok := values[attrKey]; ok {
+				return nil, fmt.Errorf("flag %q: duplicate attribute %q", key, attrKey)
+			}
+
+			attrType := tfMap[names.AttrType].(string)
+			value, err := expandFeatureFlagAttributeValue(attrType, tfMap[names.AttrValue].(string), tfMap[names.AttrValues].([]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("flag %q: attribute %q: %w", key, attrKey, err)
+			}
+
+			if flag.Attributes == nil {
+				flag.Attributes = make(map[string]featureFlagAttribute)
+			}
+			flag.Attributes[attrKey] = featureFlagAttribute{
+				Constraints: featureFlagAttributeConstraints{
+					Required: tfMap["required"].(bool),
+					Type:     attrType,
+				},
+			}
+			values[attrKey] = value
+		}
+
+		doc.Flags[key] = flag
+		doc.Values[key] = values
+	}
+
+	return json.Marshal(doc)
+}
+
+func expandFeatureFlagAttributeValue(attrType, value string, values []interface{}) (any, error) {
+	switch attrType {
+	case featureFlagAttributeTypeBoolean:
+		return strconv.ParseBool(value)
+	case featureFlagAttributeTypeNumber:
+		return strconv.ParseFloat(value, 64)
+	case featureFlagAttributeTypeString:
+		return value, nil
+	case featureFlagAttributeTypeNumberArray:
+		apiObject := make([]float64, 0, len(values))
+		for _, v := range values {
+			n, err := strconv.ParseFloat(v.(string), 64)
+			if err != nil {
+				return nil, err
+			}
+			apiObject = append(apiObject, n)
+		}
+		return apiObject, nil
+	case featureFlagAttributeTypeStringArray:
+		apiObject := make([]string, 0, len(values))
+		for _, v := range values {
+			apiObject = append(apiObject, v.(string))
+		}
+		return apiObject, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %q", attrType)
+	}
+}
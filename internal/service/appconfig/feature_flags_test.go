@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfappconfig "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppConfigFeatureFlags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagsConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "appconfig", regexache.MustCompile(`application/[0-9a-z]{4,7}/configurationprofile/[0-9a-z]{4,7}/hostedconfigurationversion/[0-9]+`)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_appconfig_application.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_profile_id", "aws_appconfig_configuration_profile.test", "configuration_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrContent, `{"flags":{"checkout":{"attributes":{"limit":{"constraints":{"type":"number"}},"regions":{"constraints":{"required":true,"type":"string[]"}}},"name":"checkout"}},"values":{"checkout":{"enabled":false,"limit":10,"regions":["us-east-1","us-west-2"]}},"version":"1"}`),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttr(resourceName, "flag.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccFeatureFlagsConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrContent, `{"flags":{"checkout":{"attributes":{"limit":{"constraints":{"type":"number"}},"regions":{"constraints":{"required":true,"type":"string[]"}}},"name":"checkout"}},"values":{"checkout":{"enabled":true,"limit":10,"regions":["us-east-1","us-west-2"]}},"version":"1"}`),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlags_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagsConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappconfig.ResourceFeatureFlags(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFeatureFlagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appconfig_feature_flags" {
+				continue
+			}
+
+			appID, confProfID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = conn.GetHostedConfigurationVersion(ctx, &appconfig.GetHostedConfigurationVersionInput{
+				ApplicationId:          aws.String(appID),
+				ConfigurationProfileId: aws.String(confProfID),
+				VersionNumber:          aws.Int32(versionNumber),
+			})
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppConfig Feature Flags %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFeatureFlagsConfig_basic(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_appconfig_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+
+resource "aws_appconfig_feature_flags" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  description              = %[1]q
+
+  flag {
+    key     = "checkout"
+    enabled = %[2]t
+
+    attribute {
+      key   = "limit"
+      type  = "number"
+      value = "10"
+    }
+
+    attribute {
+      key      = "regions"
+      type     = "string[]"
+      required = true
+      values   = ["us-east-1", "us-west-2"]
+    }
+  }
+}
+`, rName, enabled)
+}
@@ -15,7 +15,17 @@ import (
 )
 
 func FindExtensionById(ctx context.Context, conn *appconfig.Client, id string) (*appconfig.GetExtensionOutput, error) {
+	return findExtensionByIDAndVersion(ctx, conn, id, 0)
+}
+
+// findExtensionByIDAndVersion returns the specified version of an extension.
+// A version of 0 returns the latest version.
+func findExtensionByIDAndVersion(ctx context.Context, conn *appconfig.Client, id string, version int32) (*appconfig.GetExtensionOutput, error) {
 	in := &appconfig.GetExtensionInput{ExtensionIdentifier: aws.String(id)}
+	if version > 0 {
+		in.VersionNumber = aws.Int32(version)
+	}
+
 	out, err := conn.GetExtension(ctx, in)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
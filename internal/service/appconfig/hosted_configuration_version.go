@@ -4,9 +4,11 @@
 package appconfig
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -29,6 +31,7 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedConfigurationVersionCreate,
 		ReadWithoutTimeout:   resourceHostedConfigurationVersionRead,
+		UpdateWithoutTimeout: resourceHostedConfigurationVersionUpdate,
 		DeleteWithoutTimeout: resourceHostedConfigurationVersionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -69,6 +72,11 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"retained_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"version_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -103,6 +111,12 @@ func resourceHostedConfigurationVersionCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(fmt.Sprintf("%s/%s/%d", aws.ToString(output.ApplicationId), aws.ToString(output.ConfigurationProfileId), output.VersionNumber))
 
+	if v, ok := d.GetOk("retained_versions"); ok {
+		if err := purgeHostedConfigurationVersions(ctx, conn, appID, profileID, output.VersionNumber, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "purging AppConfig Hosted Configuration Versions (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceHostedConfigurationVersionRead(ctx, d, meta)...)
 }
 
@@ -158,6 +172,24 @@ func resourceHostedConfigurationVersionRead(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func resourceHostedConfigurationVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	appID, confProfID, versionNumber, err := HostedConfigurationVersionParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if v, ok := d.GetOk("retained_versions"); ok && d.HasChange("retained_versions") {
+		if err := purgeHostedConfigurationVersions(ctx, conn, appID, confProfID, versionNumber, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "purging AppConfig Hosted Configuration Versions (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceHostedConfigurationVersionRead(ctx, d, meta)...)
+}
+
 func resourceHostedConfigurationVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
@@ -199,3 +231,57 @@ func HostedConfigurationVersionParseID(id string) (string, string, int32, error)
 
 	return parts[0], parts[1], int32(version), nil
 }
+
+// purgeHostedConfigurationVersions deletes all but the newest retain hosted configuration versions of a configuration profile.
+// The version with number keep is never deleted.
+func purgeHostedConfigurationVersions(ctx context.Context, conn *appconfig.Client, appID, confProfID string, keep int32, retain int) error {
+	input := &appconfig.ListHostedConfigurationVersionsInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(confProfID),
+	}
+	var versions []int32
+
+	pages := appconfig.NewListHostedConfigurationVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("listing versions: %w", err)
+		}
+
+		for _, v := range page.Items {
+			versions = append(versions, v.VersionNumber)
+		}
+	}
+
+	if len(versions) <= retain {
+		return nil
+	}
+
+	slices.SortFunc(versions, func(a, b int32) int {
+		return cmp.Compare(b, a)
+	})
+
+	for _, v := range versions[retain:] {
+		if v == keep {
+			continue
+		}
+
+		log.Printf("[INFO] Purging AppConfig Hosted Configuration Version: %s/%s/%d", appID, confProfID, v)
+		_, err := conn.DeleteHostedConfigurationVersion(ctx, &appconfig.DeleteHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(appID),
+			ConfigurationProfileId: aws.String(confProfID),
+			VersionNumber:          aws.Int32(v),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting version %d: %w", v, err)
+		}
+	}
+
+	return nil
+}
@@ -78,6 +78,43 @@ func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_retainedVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionConfig_retainedVersions(rName, "one", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccHostedConfigurationVersionConfig_retainedVersions(rName, "two", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+					testAccCheckHostedConfigurationVersionCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retained_versions"},
+			},
+		},
+	})
+}
+
 func testAccCheckHostedConfigurationVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
@@ -155,6 +192,32 @@ func testAccCheckHostedConfigurationVersionExists(ctx context.Context, resourceN
 	}
 }
 
+func testAccCheckHostedConfigurationVersionCount(ctx context.Context, resourceName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		output, err := conn.ListHostedConfigurationVersions(ctx, &appconfig.ListHostedConfigurationVersionsInput{
+			ApplicationId:          aws.String(rs.Primary.Attributes[names.AttrApplicationID]),
+			ConfigurationProfileId: aws.String(rs.Primary.Attributes["configuration_profile_id"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.Items); got != want {
+			return fmt.Errorf("AppConfig Hosted Configuration Version count: got %d, want %d", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccHostedConfigurationVersionConfig_basic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccConfigurationProfileConfig_name(rName),
@@ -172,3 +235,22 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_retainedVersions(rName, value string, retainedVersions int) string {
+	return acctest.ConfigCompose(
+		testAccConfigurationProfileConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    foo = %[2]q
+  })
+
+  description       = %[1]q
+  retained_versions = %[3]d
+}
+`, rName, value, retainedVersions))
+}
@@ -59,6 +59,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_appconfig_environments",
 			Name:     "Environments",
 		},
+		{
+			Factory:  DataSourceExtension,
+			TypeName: "aws_appconfig_extension",
+			Name:     "Extension",
+		},
 	}
 }
 
@@ -108,6 +113,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceExtensionAssociation,
 			TypeName: "aws_appconfig_extension_association",
 		},
+		{
+			Factory:  ResourceFeatureFlags,
+			TypeName: "aws_appconfig_feature_flags",
+			Name:     "Feature Flags",
+		},
 		{
 			Factory:  ResourceHostedConfigurationVersion,
 			TypeName: "aws_appconfig_hosted_configuration_version",
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_app_block", name="App Block")
+// @Tags(identifierAttribute="arn")
+func ResourceAppBlock() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBlockCreate,
+		ReadWithoutTimeout:   resourceAppBlockRead,
+		UpdateWithoutTimeout: resourceAppBlockUpdate,
+		DeleteWithoutTimeout: resourceAppBlockDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"packaging_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PackagingType](),
+			},
+			"post_setup_script_details": scriptDetailsSchema(),
+			"setup_script_details":      scriptDetailsSchema(),
+			"source_s3_location":        s3LocationSchema(true),
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func scriptDetailsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"executable_parameters": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"executable_path": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"script_s3_location": s3LocationSchema(false),
+				"timeout_in_seconds": {
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func s3LocationSchema(optionalKey bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrS3Bucket: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"s3_key": {
+					Type:     schema.TypeString,
+					Required: !optionalKey,
+					Optional: optionalKey,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAppBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &appstream.CreateAppBlockInput{
+		Name:             aws.String(name),
+		SourceS3Location: expandS3Location(d.Get("source_s3_location").([]interface{})),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("packaging_type"); ok {
+		input.PackagingType = awstypes.PackagingType(v.(string))
+	}
+
+	if v, ok := d.GetOk("post_setup_script_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PostSetupScriptDetails = expandScriptDetails(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("setup_script_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SetupScriptDetails = expandScriptDetails(v.([]interface{}))
+	}
+
+	output, err := conn.CreateAppBlock(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream App Block (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.AppBlock.Arn))
+
+	return append(diags, resourceAppBlockRead(ctx, d, meta)...)
+}
+
+func resourceAppBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	appBlock, err := FindAppBlockByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream App Block (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream App Block (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, appBlock.Arn)
+	d.Set(names.AttrCreatedTime, aws.ToTime(appBlock.CreatedTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, appBlock.Description)
+	d.Set(names.AttrDisplayName, appBlock.DisplayName)
+	d.Set(names.AttrName, appBlock.Name)
+	d.Set("packaging_type", appBlock.PackagingType)
+	if err := d.Set("post_setup_script_details", flattenScriptDetails(appBlock.PostSetupScriptDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting post_setup_script_details: %s", err)
+	}
+	if err := d.Set("setup_script_details", flattenScriptDetails(appBlock.SetupScriptDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setup_script_details: %s", err)
+	}
+	if err := d.Set("source_s3_location", flattenS3Location(appBlock.SourceS3Location)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_s3_location: %s", err)
+	}
+	d.Set(names.AttrState, appBlock.State)
+
+	return diags
+}
+
+func resourceAppBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAppBlockRead(ctx, d, meta)
+}
+
+func resourceAppBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	log.Printf("[DEBUG] Deleting AppStream App Block: %s", d.Id())
+	_, err := conn.DeleteAppBlock(ctx, &appstream.DeleteAppBlockInput{
+		Name: aws.String(d.Get(names.AttrName).(string)),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream App Block (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandS3Location(tfList []interface{}) *awstypes.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.S3Location{
+		S3Bucket: aws.String(tfMap[names.AttrS3Bucket].(string)),
+	}
+
+	if v, ok := tfMap["s3_key"].(string); ok && v != "" {
+		apiObject.S3Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandScriptDetails(tfList []interface{}) *awstypes.ScriptDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.ScriptDetails{
+		ExecutablePath:   aws.String(tfMap["executable_path"].(string)),
+		ScriptS3Location: expandS3Location(tfMap["script_s3_location"].([]interface{})),
+		TimeoutInSeconds: aws.Int32(int32(tfMap["timeout_in_seconds"].(int))),
+	}
+
+	if v, ok := tfMap["executable_parameters"].(string); ok && v != "" {
+		apiObject.ExecutableParameters = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Location(apiObject *awstypes.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrS3Bucket: aws.ToString(apiObject.S3Bucket),
+		"s3_key":           aws.ToString(apiObject.S3Key),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScriptDetails(apiObject *awstypes.ScriptDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"executable_parameters": aws.ToString(apiObject.ExecutableParameters),
+		"executable_path":       aws.ToString(apiObject.ExecutablePath),
+		"script_s3_location":    flattenS3Location(apiObject.ScriptS3Location),
+		"timeout_in_seconds":    aws.ToInt32(apiObject.TimeoutInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppStreamAppBlock_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "appstream", fmt.Sprintf("app-block/%s", rName)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "packaging_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.executable_path", "/usr/bin/sh"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.script_s3_location.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.timeout_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_location.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_location.0.s3_bucket", "aws_s3_bucket.test", names.AttrBucket),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlock_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceAppBlock(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppBlockExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		_, err := tfappstream.FindAppBlockByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAppBlockDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_app_block" {
+				continue
+			}
+
+			_, err := tfappstream.FindAppBlockByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream App Block %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppBlockConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+data "aws_iam_policy_document" "test_bucket" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["appstream.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = data.aws_iam_policy_document.test_bucket.json
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "source.zip"
+  content = "test"
+}
+
+resource "aws_s3_object" "setup" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "setup.sh"
+  content = "#!/bin/sh\nexit 0\n"
+}
+`, rName)
+}
+
+func testAccAppBlockConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name           = %[1]q
+  packaging_type = "CUSTOM"
+
+  source_s3_location {
+    s3_bucket = aws_s3_object.source.bucket
+    s3_key    = aws_s3_object.source.key
+  }
+
+  setup_script_details {
+    executable_path    = "/usr/bin/sh"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_object.setup.bucket
+      s3_key    = aws_s3_object.setup.key
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName))
+}
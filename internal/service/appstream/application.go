@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_block_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"icon_s3_location": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrS3Bucket: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"s3_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"instance_families": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"launch_parameters": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platforms": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.PlatformType](),
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"working_directory": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &appstream.CreateApplicationInput{
+		AppBlockArn:      aws.String(d.Get("app_block_arn").(string)),
+		IconS3Location:   expandS3Location(d.Get("icon_s3_location").([]interface{})),
+		InstanceFamilies: flex.ExpandStringValueSet(d.Get("instance_families").(*schema.Set)),
+		LaunchPath:       aws.String(d.Get("launch_path").(string)),
+		Name:             aws.String(name),
+		Platforms:        flex.ExpandStringyValueSet[awstypes.PlatformType](d.Get("platforms").(*schema.Set)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_parameters"); ok {
+		input.LaunchParameters = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("working_directory"); ok {
+		input.WorkingDirectory = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateApplication(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Application.Arn))
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	application, err := FindApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_block_arn", application.AppBlockArn)
+	d.Set(names.AttrARN, application.Arn)
+	d.Set(names.AttrCreatedTime, aws.ToTime(application.CreatedTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, application.Description)
+	d.Set(names.AttrDisplayName, application.DisplayName)
+	if err := d.Set("icon_s3_location", flattenS3Location(application.IconS3Location)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting icon_s3_location: %s", err)
+	}
+	d.Set("instance_families", application.InstanceFamilies)
+	d.Set("launch_parameters", application.LaunchParameters)
+	d.Set("launch_path", application.LaunchPath)
+	d.Set(names.AttrName, application.Name)
+	d.Set("platforms", application.Platforms)
+	d.Set("working_directory", application.WorkingDirectory)
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &appstream.UpdateApplicationInput{
+			Name: aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if d.HasChange("app_block_arn") {
+			input.AppBlockArn = aws.String(d.Get("app_block_arn").(string))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		if d.HasChange("icon_s3_location") {
+			input.IconS3Location = expandS3Location(d.Get("icon_s3_location").([]interface{}))
+		}
+
+		if d.HasChange("launch_parameters") {
+			if v, ok := d.GetOk("launch_parameters"); ok {
+				input.LaunchParameters = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, awstypes.ApplicationAttributeLaunchParameters)
+			}
+		}
+
+		if d.HasChange("launch_path") {
+			input.LaunchPath = aws.String(d.Get("launch_path").(string))
+		}
+
+		if d.HasChange("working_directory") {
+			if v, ok := d.GetOk("working_directory"); ok {
+				input.WorkingDirectory = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, awstypes.ApplicationAttributeWorkingDirectory)
+			}
+		}
+
+		_, err := conn.UpdateApplication(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppStream Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	log.Printf("[DEBUG] Deleting AppStream Application: %s", d.Id())
+	_, err := conn.DeleteApplication(ctx, &appstream.DeleteApplicationInput{
+		Name: aws.String(d.Get(names.AttrName).(string)),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_appstream_application_fleet_association", name="Application Fleet Association")
+func ResourceApplicationFleetAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationFleetAssociationCreate,
+		ReadWithoutTimeout:   resourceApplicationFleetAssociationRead,
+		DeleteWithoutTimeout: resourceApplicationFleetAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"fleet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationFleetAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	fleetName, applicationARN := d.Get("fleet_name").(string), d.Get("application_arn").(string)
+	id := EncodeApplicationFleetID(fleetName, applicationARN)
+	input := &appstream.AssociateApplicationFleetInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ResourceNotFoundException](ctx, fleetOperationTimeout, func() (interface{}, error) {
+		return conn.AssociateApplicationFleet(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream Application Fleet Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceApplicationFleetAssociationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationFleetAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	fleetName, applicationARN, err := DecodeApplicationFleetID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, err = FindApplicationFleetAssociation(ctx, conn, fleetName, applicationARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Application Fleet Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Application Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", applicationARN)
+	d.Set("fleet_name", fleetName)
+
+	return diags
+}
+
+func resourceApplicationFleetAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	fleetName, applicationARN, err := DecodeApplicationFleetID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Application Fleet Association: %s", d.Id())
+	_, err = conn.DisassociateApplicationFleet(ctx, &appstream.DisassociateApplicationFleetInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream Application Fleet Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func EncodeApplicationFleetID(fleetName, applicationARN string) string {
+	return fmt.Sprintf("%s/%s", fleetName, applicationARN)
+}
+
+func DecodeApplicationFleetID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format FleetName/ApplicationARN, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
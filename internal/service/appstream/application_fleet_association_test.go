@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppStreamApplicationFleetAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_application_fleet_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationFleetAssociationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationFleetAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", "aws_appstream_application.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", "aws_appstream_fleet.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamApplicationFleetAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_application_fleet_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationFleetAssociationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationFleetAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceApplicationFleetAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationFleetAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		fleetName, applicationARN, err := tfappstream.DecodeApplicationFleetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfappstream.FindApplicationFleetAssociation(ctx, conn, fleetName, applicationARN)
+
+		return err
+	}
+}
+
+func testAccCheckApplicationFleetAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_application_fleet_association" {
+				continue
+			}
+
+			fleetName, applicationARN, err := tfappstream.DecodeApplicationFleetID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfappstream.FindApplicationFleetAssociation(ctx, conn, fleetName, applicationARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream Application Fleet Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccApplicationFleetAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_basic(rName, "/usr/bin/test"),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                    = %[1]q
+  fleet_type              = "ELASTIC"
+  instance_type           = "stream.standard.small"
+  max_concurrent_sessions = 1
+  platform                = "AMAZON_LINUX2"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_appstream_application_fleet_association" "test" {
+  application_arn = aws_appstream_application.test.arn
+  fleet_name      = aws_appstream_fleet.test.name
+}
+`, rName))
+}
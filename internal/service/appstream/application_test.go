@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppStreamApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, "/usr/bin/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_block_arn", "aws_appstream_app_block.test", names.AttrARN),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "appstream", fmt.Sprintf("application/%s", rName)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, "icon_s3_location.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "instance_families.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_families.*", "GENERAL_PURPOSE"),
+					resource.TestCheckResourceAttr(resourceName, "launch_path", "/usr/bin/test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "platforms.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "platforms.*", "AMAZON_LINUX2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_basic(rName, "/usr/bin/test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "launch_path", "/usr/bin/test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, "/usr/bin/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		_, err := tfappstream.FindApplicationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_application" {
+				continue
+			}
+
+			_, err := tfappstream.FindApplicationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream Application %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccApplicationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_basic(rName), `
+resource "aws_s3_object" "icon" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "icon.png"
+  content = "test"
+}
+`)
+}
+
+func testAccApplicationConfig_basic(rName, launchPath string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_application" "test" {
+  name              = %[1]q
+  app_block_arn     = aws_appstream_app_block.test.arn
+  instance_families = ["GENERAL_PURPOSE"]
+  launch_path       = %[2]q
+  platforms         = ["AMAZON_LINUX2"]
+
+  icon_s3_location {
+    s3_bucket = aws_s3_object.icon.bucket
+    s3_key    = aws_s3_object.icon.key
+  }
+}
+`, rName, launchPath))
+}
@@ -182,3 +182,209 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.Client, flee
 
 	return nil
 }
+
+func FindAppBlockBuilderByName(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) {
+	input := &appstream.DescribeAppBlockBuildersInput{
+		Names: []string{name},
+	}
+
+	output, err := findAppBlockBuilder(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.Name) != name {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilders(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlockBuildersInput) ([]awstypes.AppBlockBuilder, error) {
+	var output []awstypes.AppBlockBuilder
+
+	err := describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.AppBlockBuilders...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilder(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlockBuildersInput) (*awstypes.AppBlockBuilder, error) {
+	output, err := findAppBlockBuilders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func FindAppBlockByARN(ctx context.Context, conn *appstream.Client, arn string) (*awstypes.AppBlock, error) {
+	input := &appstream.DescribeAppBlocksInput{
+		Arns: []string{arn},
+	}
+
+	output, err := findAppBlock(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.Arn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlocks(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlocksInput) ([]awstypes.AppBlock, error) {
+	var output []awstypes.AppBlock
+
+	err := describeAppBlocksPages(ctx, conn, input, func(page *appstream.DescribeAppBlocksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.AppBlocks...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlock(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlocksInput) (*awstypes.AppBlock, error) {
+	output, err := findAppBlocks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func FindApplicationByARN(ctx context.Context, conn *appstream.Client, arn string) (*awstypes.Application, error) {
+	input := &appstream.DescribeApplicationsInput{
+		Arns: []string{arn},
+	}
+
+	output, err := findApplication(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.Arn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findApplications(ctx context.Context, conn *appstream.Client, input *appstream.DescribeApplicationsInput) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	err := describeApplicationsPages(ctx, conn, input, func(page *appstream.DescribeApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Applications...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findApplication(ctx context.Context, conn *appstream.Client, input *appstream.DescribeApplicationsInput) (*awstypes.Application, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func FindApplicationFleetAssociation(ctx context.Context, conn *appstream.Client, fleetName, applicationARN string) (*awstypes.ApplicationFleetAssociation, error) {
+	input := &appstream.DescribeApplicationFleetAssociationsInput{
+		ApplicationArn: aws.String(applicationARN),
+		FleetName:      aws.String(fleetName),
+	}
+
+	var output []awstypes.ApplicationFleetAssociation
+
+	err := describeApplicationFleetAssociationsPages(ctx, conn, input, func(page *appstream.DescribeApplicationFleetAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.ApplicationFleetAssociations...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
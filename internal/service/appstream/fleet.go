@@ -55,7 +55,8 @@ func ResourceFleet() *schema.Resource {
 			"compute_capacity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"available": {
@@ -167,6 +168,10 @@ func ResourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_concurrent_sessions": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			"max_sessions_per_instance": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -182,6 +187,12 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"platform": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PlatformType](),
+			},
 			"stream_view": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -271,6 +282,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("max_sessions_per_instance"); ok {
 		input.MaxSessionsPerInstance = aws.Int32(int32(v.(int)))
 	}
@@ -279,6 +294,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.MaxUserDurationInSeconds = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = awstypes.PlatformType(v.(string))
+	}
+
 	if v, ok := d.GetOk("stream_view"); ok {
 		input.StreamView = awstypes.StreamView(v.(string))
 	}
@@ -388,9 +407,11 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_name", fleet.ImageName)
 	d.Set("image_arn", fleet.ImageArn)
 	d.Set(names.AttrInstanceType, fleet.InstanceType)
+	d.Set("max_concurrent_sessions", fleet.MaxConcurrentSessions)
 	d.Set("max_sessions_per_instance", fleet.MaxSessionsPerInstance)
 	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
 	d.Set(names.AttrName, fleet.Name)
+	d.Set("platform", fleet.Platform)
 	d.Set(names.AttrState, fleet.State)
 	d.Set("stream_view", fleet.StreamView)
 
@@ -414,7 +435,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	shouldStop := false
 
-	if d.HasChanges(names.AttrDescription, "domain_join_info", "enable_default_internet_access", names.AttrIAMRoleARN, names.AttrInstanceType, "max_user_duration_in_seconds", "stream_view", names.AttrVPCConfig) {
+	if d.HasChanges(names.AttrDescription, "domain_join_info", "enable_default_internet_access", names.AttrIAMRoleARN, names.AttrInstanceType, "max_user_duration_in_seconds", "platform", "stream_view", names.AttrVPCConfig) {
 		shouldStop = true
 	}
 
@@ -471,6 +492,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(d.Get(names.AttrIAMRoleARN).(string))
 	}
 
+	if d.HasChange("platform") {
+		input.Platform = awstypes.PlatformType(d.Get("platform").(string))
+	}
+
 	if d.HasChange("stream_view") {
 		input.StreamView = awstypes.StreamView(d.Get("stream_view").(string))
 	}
@@ -479,6 +504,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceType = aws.String(d.Get(names.AttrInstanceType).(string))
 	}
 
+	if d.HasChange("max_concurrent_sessions") {
+		input.MaxConcurrentSessions = aws.Int32(int32(d.Get("max_concurrent_sessions").(int)))
+	}
+
 	if d.HasChange("max_sessions_per_instance") {
 		input.MaxSessionsPerInstance = aws.Int32(int32(d.Get("max_sessions_per_instance").(int)))
 	}
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=DescribeAppBlockBuilders,DescribeAppBlocks,DescribeApplicationFleetAssociations,DescribeApplications,DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
 
 package appstream
 
@@ -9,6 +9,70 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 )
 
+func describeAppBlockBuildersPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlockBuildersInput, fn func(*appstream.DescribeAppBlockBuildersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlockBuilders(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeAppBlocksPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlocksInput, fn func(*appstream.DescribeAppBlocksOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlocks(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeApplicationFleetAssociationsPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeApplicationFleetAssociationsInput, fn func(*appstream.DescribeApplicationFleetAssociationsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeApplicationFleetAssociations(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeApplicationsPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeApplicationsInput, fn func(*appstream.DescribeApplicationsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeApplications(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeDirectoryConfigsPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeDirectoryConfigsInput, fn func(*appstream.DescribeDirectoryConfigsOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeDirectoryConfigs(ctx, input)
@@ -29,6 +29,35 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAppBlock,
+			TypeName: "aws_appstream_app_block",
+			Name:     "App Block",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceAppBlockBuilder,
+			TypeName: "aws_appstream_app_block_builder",
+			Name:     "App Block Builder",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceApplication,
+			TypeName: "aws_appstream_application",
+			Name:     "Application",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceApplicationFleetAssociation,
+			TypeName: "aws_appstream_application_fleet_association",
+			Name:     "Application Fleet Association",
+		},
 		{
 			Factory:  ResourceDirectoryConfig,
 			TypeName: "aws_appstream_directory_config",
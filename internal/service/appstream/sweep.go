@@ -16,6 +16,11 @@ import (
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_appstream_app_block_builder", &resource.Sweeper{
+		Name: "aws_appstream_app_block_builder",
+		F:    sweepAppBlockBuilders,
+	})
+
 	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
@@ -37,6 +42,50 @@ func RegisterSweepers() {
 	})
 }
 
+func sweepAppBlockBuilders(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.AppStreamClient(ctx)
+	input := &appstream.DescribeAppBlockBuildersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlockBuilders {
+			r := ResourceAppBlockBuilder()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream App Block Builder sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppStream App Block Builders (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping AppStream App Block Builders (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDirectoryConfigs(region string) error {
 	ctx := sweep.Context(region)
 	if region == names.USWest1RegionID {
@@ -18,6 +18,9 @@ import (
 )
 
 const (
+	// appBlockBuilderStateTimeout Maximum amount of time to wait for the statusAppBlockBuilderState to be RUNNING or STOPPED
+	// or for the AppBlockBuilder to be deleted
+	appBlockBuilderStateTimeout = 60 * time.Minute
 	// fleetStateTimeout Maximum amount of time to wait for the statusFleetState to be RUNNING or STOPPED
 	fleetStateTimeout = 180 * time.Minute
 	// fleetOperationTimeout Maximum amount of time to wait for Fleet operation eventual consistency
@@ -158,3 +161,91 @@ func waitUserAvailable(ctx context.Context, conn *appstream.Client, username, au
 
 	return nil, err
 }
+
+func waitAppBlockBuilderStateStable(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStarting, awstypes.AppBlockBuilderStateStopping),
+		Target:  enum.Slice(awstypes.AppBlockBuilderStateRunning, awstypes.AppBlockBuilderStateStopped),
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		setAppBlockBuilderLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderStateRunning(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStarting),
+		Target:  enum.Slice(awstypes.AppBlockBuilderStateRunning),
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		setAppBlockBuilderLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderStateStopped(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStarting, awstypes.AppBlockBuilderStateRunning, awstypes.AppBlockBuilderStateStopping),
+		Target:  enum.Slice(awstypes.AppBlockBuilderStateStopped),
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		setAppBlockBuilderLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderDeleted(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStopped, awstypes.AppBlockBuilderStateStopping),
+		Target:  []string{},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		setAppBlockBuilderLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setAppBlockBuilderLastError(err error, apiObject *awstypes.AppBlockBuilder) {
+	if v := apiObject.AppBlockBuilderErrors; len(v) > 0 {
+		var errs []error
+
+		for _, err := range v {
+			errs = append(errs, fmt.Errorf("%s: %s", string(err.ErrorCode), aws.ToString(err.ErrorMessage)))
+		}
+
+		tfresource.SetLastError(err, errors.Join(errs...))
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	evidenceFinderEnabledTimeout  = 10 * time.Minute
+	evidenceFinderDisabledTimeout = 10 * time.Minute
+)
+
+// @FrameworkResource
+func newResourceEvidenceFinder(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceEvidenceFinder{}, nil
+}
+
+const (
+	ResNameEvidenceFinder = "EvidenceFinder"
+)
+
+type resourceEvidenceFinder struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceEvidenceFinder) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_auditmanager_evidence_finder"
+}
+
+func (r *resourceEvidenceFinder) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backfill_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"disable_on_destroy": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enablement_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"event_data_store_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *resourceEvidenceFinder) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+	// Evidence finder is enabled per region, so use this as the ID
+	id := r.Meta().Region
+
+	var plan resourceEvidenceFinderData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := auditmanager.UpdateSettingsInput{
+		EvidenceFinderEnabled: aws.Bool(true),
+	}
+	_, err := conn.UpdateSettings(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameEvidenceFinder, id, nil),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := waitEvidenceFinderEnabled(ctx, conn, evidenceFinderEnabledTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionWaitingForCreation, ResNameEvidenceFinder, id, nil),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = types.StringValue(id)
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceEvidenceFinder) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var state resourceEvidenceFinderData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findEvidenceFinderEnablement(ctx, conn)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameEvidenceFinder, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	// Once disabled, evidence finder cannot be enabled again.
+	switch out.EnablementStatus {
+	case awstypes.EvidenceFinderEnablementStatusDisabled, awstypes.EvidenceFinderEnablementStatusDisableInProgress:
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEvidenceFinder) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceEvidenceFinderData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DisableOnDestroy.Equal(state.DisableOnDestroy) {
+		state.DisableOnDestroy = plan.DisableOnDestroy
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEvidenceFinder) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var state resourceEvidenceFinderData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disabling evidence finder deletes the event data store and is permanent,
+	// so only do so when explicitly requested.
+	if !state.DisableOnDestroy.ValueBool() {
+		return
+	}
+
+	in := auditmanager.UpdateSettingsInput{
+		EvidenceFinderEnabled: aws.Bool(false),
+	}
+	_, err := conn.UpdateSettings(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameEvidenceFinder, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitEvidenceFinderDisabled(ctx, conn, evidenceFinderDisabledTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionWaitingForDeletion, ResNameEvidenceFinder, state.ID.String(), nil),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceEvidenceFinder) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func findEvidenceFinderEnablement(ctx context.Context, conn *auditmanager.Client) (*awstypes.EvidenceFinderEnablement, error) {
+	in := &auditmanager.GetSettingsInput{
+		Attribute: awstypes.SettingAttributeEvidenceFinderEnablement,
+	}
+	out, err := conn.GetSettings(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Settings == nil || out.Settings.EvidenceFinderEnablement == nil || out.Settings.EvidenceFinderEnablement.EnablementStatus == "" {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Settings.EvidenceFinderEnablement, nil
+}
+
+func statusEvidenceFinderEnablement(ctx context.Context, conn *auditmanager.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findEvidenceFinderEnablement(ctx, conn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.EnablementStatus), nil
+	}
+}
+
+func waitEvidenceFinderEnabled(ctx context.Context, conn *auditmanager.Client, timeout time.Duration) (*awstypes.EvidenceFinderEnablement, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EvidenceFinderEnablementStatusEnableInProgress),
+		Target:  enum.Slice(awstypes.EvidenceFinderEnablementStatusEnabled),
+		Refresh: statusEvidenceFinderEnablement(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.EvidenceFinderEnablement); ok {
+		if v := aws.ToString(out.Error); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitEvidenceFinderDisabled(ctx context.Context, conn *auditmanager.Client, timeout time.Duration) (*awstypes.EvidenceFinderEnablement, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EvidenceFinderEnablementStatusDisableInProgress),
+		Target:  enum.Slice(awstypes.EvidenceFinderEnablementStatusDisabled),
+		Refresh: statusEvidenceFinderEnablement(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.EvidenceFinderEnablement); ok {
+		if v := aws.ToString(out.Error); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+type resourceEvidenceFinderData struct {
+	BackfillStatus    types.String `tfsdk:"backfill_status"`
+	DisableOnDestroy  types.Bool   `tfsdk:"disable_on_destroy"`
+	EnablementStatus  types.String `tfsdk:"enablement_status"`
+	EventDataStoreARN types.String `tfsdk:"event_data_store_arn"`
+	ID                types.String `tfsdk:"id"`
+}
+
+func (rd *resourceEvidenceFinderData) refreshFromOutput(ctx context.Context, out *awstypes.EvidenceFinderEnablement) {
+	if out == nil {
+		return
+	}
+
+	rd.BackfillStatus = flex.StringValueToFramework(ctx, out.BackfillStatus)
+	rd.EnablementStatus = flex.StringValueToFramework(ctx, out.EnablementStatus)
+	rd.EventDataStoreARN = flex.StringToFramework(ctx, out.EventDataStoreArn)
+}
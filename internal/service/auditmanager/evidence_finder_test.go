@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerEvidenceFinder_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccEvidenceFinder_basic,
+		acctest.CtDisappears: testAccEvidenceFinder_disappears,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccEvidenceFinder_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_auditmanager_evidence_finder.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvidenceFinderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvidenceFinderConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvidenceFinderIsEnabled(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enablement_status", string(types.EvidenceFinderEnablementStatusEnabled)),
+					resource.TestCheckResourceAttrSet(resourceName, "backfill_status"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "event_data_store_arn", "cloudtrail", regexache.MustCompile(`eventdatastore/.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"backfill_status",
+					"disable_on_destroy",
+				},
+			},
+		},
+	})
+}
+
+func testAccEvidenceFinder_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if os.Getenv("AUDITMANAGER_DISABLE_EVIDENCE_FINDER_ON_DESTROY") == "" {
+		t.Skip("Environment variable AUDITMANAGER_DISABLE_EVIDENCE_FINDER_ON_DESTROY is not set")
+	}
+
+	resourceName := "aws_auditmanager_evidence_finder.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvidenceFinderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// disable_on_destroy must be enabled for the disappears helper to disable
+				// evidence finder on destroy and trigger the non-empty plan after state refresh
+				Config: testAccEvidenceFinderConfig_disableOnDestroy(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvidenceFinderIsEnabled(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfauditmanager.ResourceEvidenceFinder, resourceName),
+				),
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckEvidenceFinderDestroy verfies GetSettings does not return an error
+//
+// Evidence finder remains enabled unless disable_on_destroy was set, so this
+// function only checks that the settings can still be read.
+func testAccCheckEvidenceFinderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_auditmanager_evidence_finder" {
+				continue
+			}
+
+			_, err := conn.GetSettings(ctx, &auditmanager.GetSettingsInput{
+				Attribute: types.SettingAttributeEvidenceFinderEnablement,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckEvidenceFinderIsEnabled verifies evidence finder is enabled in the current account/region combination
+func testAccCheckEvidenceFinderIsEnabled(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameEvidenceFinder, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameEvidenceFinder, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient(ctx)
+		out, err := conn.GetSettings(ctx, &auditmanager.GetSettingsInput{
+			Attribute: types.SettingAttributeEvidenceFinderEnablement,
+		})
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameEvidenceFinder, rs.Primary.ID, err)
+		}
+		if out == nil || out.Settings == nil || out.Settings.EvidenceFinderEnablement == nil ||
+			out.Settings.EvidenceFinderEnablement.EnablementStatus != types.EvidenceFinderEnablementStatusEnabled {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameEvidenceFinder, rs.Primary.ID, errors.New("evidence finder not enabled"))
+		}
+
+		return nil
+	}
+}
+
+func testAccEvidenceFinderConfig_basic() string {
+	return `
+resource "aws_auditmanager_evidence_finder" "test" {}
+`
+}
+
+func testAccEvidenceFinderConfig_disableOnDestroy() string {
+	return `
+resource "aws_auditmanager_evidence_finder" "test" {
+  disable_on_destroy = true
+}
+`
+}
@@ -10,6 +10,7 @@ var (
 	ResourceAssessment                           = newResourceAssessment
 	ResourceAssessmentDelegation                 = newResourceAssessmentDelegation
 	ResourceAssessmentReport                     = newResourceAssessmentReport
+	ResourceEvidenceFinder                       = newResourceEvidenceFinder
 	ResourceControl                              = newResourceControl
 	ResourceFramework                            = newResourceFramework
 	ResourceFrameworkShare                       = newResourceFrameworkShare
@@ -51,6 +51,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newResourceEvidenceFinder,
+		},
 		{
 			Factory: newResourceFramework,
 			Name:    "Framework",
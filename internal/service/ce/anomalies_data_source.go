@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ce_anomalies", name="Anomalies")
+func dataSourceAnomalies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAnomaliesRead,
+
+		Schema: map[string]*schema.Schema{
+			"anomalies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"anomaly_end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anomaly_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anomaly_score": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"current_score": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"max_score": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"anomaly_start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dimension_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"feedback": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"impact": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_impact": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"total_actual_spend": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"total_expected_spend": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"total_impact": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"total_impact_percentage": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"monitor_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_cause": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"linked_account": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"linked_account_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"usage_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"date_interval": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_date": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"feedback": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AnomalyFeedbackType](),
+			},
+			"monitor_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"total_impact": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_value": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"numeric_operator": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.NumericOperator](),
+						},
+						"start_value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAnomaliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CEClient(ctx)
+
+	input := &costexplorer.GetAnomaliesInput{
+		DateInterval: expandAnomalyDateInterval(d.Get("date_interval").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("feedback"); ok {
+		input.Feedback = awstypes.AnomalyFeedbackType(v.(string))
+	}
+
+	if v, ok := d.GetOk("monitor_arn"); ok {
+		input.MonitorArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("total_impact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TotalImpact = expandTotalImpactFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	anomalies, err := findAnomalies(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cost Explorer Anomalies: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("anomalies", flattenAnomalies(anomalies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting anomalies: %s", err)
+	}
+
+	return diags
+}
+
+func findAnomalies(ctx context.Context, conn *costexplorer.Client, input *costexplorer.GetAnomaliesInput) ([]awstypes.Anomaly, error) {
+	var output []awstypes.Anomaly
+
+	for {
+		page, err := conn.GetAnomalies(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Anomalies...)
+
+		if aws.ToString(page.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
+
+func expandAnomalyDateInterval(tfMap map[string]interface{}) *awstypes.AnomalyDateInterval {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.AnomalyDateInterval{
+		StartDate: aws.String(tfMap["start_date"].(string)),
+	}
+
+	if v, ok := tfMap["end_date"].(string); ok && v != "" {
+		apiObject.EndDate = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTotalImpactFilter(tfMap map[string]interface{}) *awstypes.TotalImpactFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.TotalImpactFilter{
+		NumericOperator: awstypes.NumericOperator(tfMap["numeric_operator"].(string)),
+		StartValue:      tfMap["start_value"].(float64),
+	}
+
+	if v, ok := tfMap["end_value"].(float64); ok {
+		apiObject.EndValue = v
+	}
+
+	return apiObject
+}
+
+func flattenAnomalies(apiObjects []awstypes.Anomaly) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"anomaly_end_date":   aws.ToString(apiObject.AnomalyEndDate),
+			"anomaly_id":         aws.ToString(apiObject.AnomalyId),
+			"anomaly_start_date": aws.ToString(apiObject.AnomalyStartDate),
+			"dimension_value":    aws.ToString(apiObject.DimensionValue),
+			"feedback":           apiObject.Feedback,
+			"monitor_arn":        aws.ToString(apiObject.MonitorArn),
+			"root_cause":         flattenRootCauses(apiObject.RootCauses),
+		}
+
+		if v := apiObject.AnomalyScore; v != nil {
+			tfMap["anomaly_score"] = []interface{}{map[string]interface{}{
+				"current_score": v.CurrentScore,
+				"max_score":     v.MaxScore,
+			}}
+		}
+
+		if v := apiObject.Impact; v != nil {
+			tfMap["impact"] = []interface{}{map[string]interface{}{
+				"max_impact":              v.MaxImpact,
+				"total_actual_spend":      aws.ToFloat64(v.TotalActualSpend),
+				"total_expected_spend":    aws.ToFloat64(v.TotalExpectedSpend),
+				"total_impact":            v.TotalImpact,
+				"total_impact_percentage": aws.ToFloat64(v.TotalImpactPercentage),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenRootCauses(apiObjects []awstypes.RootCause) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"linked_account":      aws.ToString(apiObject.LinkedAccount),
+			"linked_account_name": aws.ToString(apiObject.LinkedAccountName),
+			names.AttrRegion:      aws.ToString(apiObject.Region),
+			"service":             aws.ToString(apiObject.Service),
+			"usage_type":          aws.ToString(apiObject.UsageType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCEAnomaliesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ce_anomalies.test"
+
+	formatDate := "2006-01-02"
+	currentTime := time.Now()
+	startDate := currentTime.AddDate(0, 0, -80).Format(formatDate)
+	endDate := currentTime.Format(formatDate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomaliesDataSourceConfig_basic(startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "anomalies.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnomaliesDataSourceConfig_basic(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_anomalies" "test" {
+  date_interval {
+    start_date = %[1]q
+    end_date   = %[2]q
+  }
+
+  total_impact {
+    numeric_operator = "GREATER_THAN_OR_EQUAL"
+    start_value      = 0
+  }
+}
+`, start, end)
+}
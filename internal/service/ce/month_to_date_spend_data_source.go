@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	costAndUsageDateFormat = "2006-01-02"
+)
+
+// Metric names accepted by GetCostAndUsage. These differ from the Metric enum
+// values, which are only used by the reservation and savings plans APIs.
+func costMetric_Values() []string {
+	return []string{
+		"AmortizedCost",
+		"BlendedCost",
+		"NetAmortizedCost",
+		"NetUnblendedCost",
+		"UnblendedCost",
+	}
+}
+
+// @SDKDataSource("aws_ce_month_to_date_spend", name="Month To Date Spend")
+func dataSourceMonthToDateSpend() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMonthToDateSpendRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UnblendedCost",
+				ValidateFunc: validation.StringInSlice(costMetric_Values(), false),
+			},
+			"service": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMonthToDateSpendRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CEClient(ctx)
+
+	// The end date is exclusive, so include today's spend by ending tomorrow.
+	// This also keeps the interval valid on the first day of the month.
+	now := time.Now().UTC()
+	startDate := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).Format(costAndUsageDateFormat)
+	endDate := now.AddDate(0, 0, 1).Format(costAndUsageDateFormat)
+	metric := d.Get("metric").(string)
+
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: awstypes.GranularityMonthly,
+		GroupBy: []awstypes.GroupDefinition{{
+			Key:  aws.String(string(awstypes.DimensionService)),
+			Type: awstypes.GroupDefinitionTypeDimension,
+		}},
+		Metrics: []string{metric},
+		TimePeriod: &awstypes.DateInterval{
+			End:   aws.String(endDate),
+			Start: aws.String(startDate),
+		},
+	}
+
+	results, err := findCostAndUsageResults(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cost Explorer month-to-date spend: %s", err)
+	}
+
+	amounts := make(map[string]float64)
+	var total float64
+	var unit string
+
+	for _, result := range results {
+		for _, group := range result.Groups {
+			if len(group.Keys) == 0 {
+				continue
+			}
+
+			v, ok := group.Metrics[metric]
+			if !ok {
+				continue
+			}
+
+			amount, err := strconv.ParseFloat(aws.ToString(v.Amount), 64)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing Cost Explorer %s amount (%s): %s", metric, aws.ToString(v.Amount), err)
+			}
+
+			amounts[group.Keys[0]] += amount
+			total += amount
+			if unit == "" {
+				unit = aws.ToString(v.Unit)
+			}
+		}
+	}
+
+	serviceNames := make([]string, 0, len(amounts))
+	for k := range amounts {
+		serviceNames = append(serviceNames, k)
+	}
+	sort.Strings(serviceNames)
+
+	tfList := make([]interface{}, 0, len(serviceNames))
+	for _, k := range serviceNames {
+		tfList = append(tfList, map[string]interface{}{
+			"amount":       amounts[k],
+			names.AttrName: k,
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("end_date", endDate)
+	d.Set("metric", metric)
+	if err := d.Set("service", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service: %s", err)
+	}
+	d.Set("start_date", startDate)
+	d.Set("total", total)
+	d.Set("unit", unit)
+
+	return diags
+}
+
+func findCostAndUsageResults(ctx context.Context, conn *costexplorer.Client, input *costexplorer.GetCostAndUsageInput) ([]awstypes.ResultByTime, error) {
+	var output []awstypes.ResultByTime
+
+	for {
+		page, err := conn.GetCostAndUsage(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResultsByTime...)
+
+		if aws.ToString(page.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
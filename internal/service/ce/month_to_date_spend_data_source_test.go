@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCEMonthToDateSpendDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ce_month_to_date_spend.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonthToDateSpendDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric", "UnblendedCost"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMonthToDateSpendDataSourceConfig_basic = `
+data "aws_ce_month_to_date_spend" "test" {}
+`
@@ -25,11 +25,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAnomalies,
+			TypeName: "aws_ce_anomalies",
+			Name:     "Anomalies",
+		},
 		{
 			Factory:  dataSourceCostCategory,
 			TypeName: "aws_ce_cost_category",
 			Name:     "Cost Category",
 		},
+		{
+			Factory:  dataSourceMonthToDateSpend,
+			TypeName: "aws_ce_month_to_date_spend",
+			Name:     "Month To Date Spend",
+		},
 		{
 			Factory:  dataSourceTags,
 			TypeName: "aws_ce_tags",
@@ -0,0 +1,596 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule", name="Configured Table Analysis Rule")
+func resourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"dimension_columns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(2, 100000),
+									},
+									names.AttrType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ConfiguredTableAnalysisRuleType](),
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_analyses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_analysis_providers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"differential_privacy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceConfiguredTableAnalysisRuleCustomizeDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDPartCount = 2
+)
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_identifier").(string)
+	analysisRuleType := d.Get("analysis_rule_type").(string)
+	id, err := flex.FlattenResourceId([]string{configuredTableID, analysisRuleType}, configuredTableAnalysisRuleIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d),
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err = conn.CreateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	configuredTableID, analysisRuleType := parts[0], parts[1]
+	out, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	d.Set("analysis_rule_type", out.Type)
+	d.Set("configured_table_arn", out.ConfiguredTableArn)
+	d.Set("configured_table_identifier", out.ConfiguredTableId)
+	d.Set(names.AttrCreateTime, out.CreateTime.String())
+	d.Set("update_time", out.UpdateTime.String())
+
+	var aggregation, custom, list []interface{}
+	if v, ok := out.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			aggregation = flattenAnalysisRuleAggregation(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+			custom = flattenAnalysisRuleCustom(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			list = flattenAnalysisRuleList(&v.Value)
+		}
+	}
+	if err := d.Set("aggregation", aggregation); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aggregation: %s", err)
+	}
+	if err := d.Set("custom", custom); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting custom: %s", err)
+	}
+	if err := d.Set("list", list); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting list: %s", err)
+	}
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d),
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string)),
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_identifier").(string)),
+	}
+
+	_, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Analysis Rule %s", d.Id())
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string)),
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_identifier").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	analysisRuleType := types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string))
+
+	var block string
+	switch analysisRuleType {
+	case types.ConfiguredTableAnalysisRuleTypeAggregation:
+		block = "aggregation"
+	case types.ConfiguredTableAnalysisRuleTypeCustom:
+		block = "custom"
+	case types.ConfiguredTableAnalysisRuleTypeList:
+		block = "list"
+	default:
+		return nil
+	}
+
+	if v, ok := d.GetOk(block); !ok || len(v.([]interface{})) == 0 {
+		return fmt.Errorf("%s must be specified when analysis_rule_type is %s", block, analysisRuleType)
+	}
+
+	return nil
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID, analysisRuleType string) (*types.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData) types.ConfiguredTableAnalysisRulePolicy {
+	var policy types.ConfiguredTableAnalysisRulePolicyV1
+
+	if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+			Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("custom"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+			Value: expandAnalysisRuleCustom(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+			Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+		Value: policy,
+	}
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		// The API requires dimension and scalar function lists, even if empty.
+		DimensionColumns: []string{},
+		ScalarFunctions:  []types.ScalarFunctions{},
+	}
+
+	if v, ok := tfMap["aggregate_columns"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.AggregateColumns = append(apiObject.AggregateColumns, types.AggregateColumn{
+				ColumnNames: flex.ExpandStringValueSet(tfMap["column_names"].(*schema.Set)),
+				Function:    types.AggregateFunctionName(tfMap["function"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["dimension_columns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DimensionColumns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["join_columns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JoinColumns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	if v, ok := tfMap["output_constraints"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.OutputConstraints = append(apiObject.OutputConstraints, types.AggregationConstraint{
+				ColumnName: aws.String(tfMap["column_name"].(string)),
+				Minimum:    aws.Int32(int32(tfMap["minimum"].(int))),
+				Type:       types.AggregationType(tfMap[names.AttrType].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["scalar_functions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ScalarFunctions = flex.ExpandStringyValueSet[types.ScalarFunctions](v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) types.AnalysisRuleCustom {
+	apiObject := types.AnalysisRuleCustom{}
+
+	if v, ok := tfMap["allowed_analyses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalyses = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DifferentialPrivacy = &types.DifferentialPrivacyConfiguration{}
+
+		for _, name := range flex.ExpandStringValueSet(v[0].(map[string]interface{})["columns"].(*schema.Set)) {
+			apiObject.DifferentialPrivacy.Columns = append(apiObject.DifferentialPrivacy.Columns, types.DifferentialPrivacyColumn{
+				Name: aws.String(name),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["join_columns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JoinColumns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["list_columns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ListColumns = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisRuleAggregation(apiObject *types.AnalysisRuleAggregation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var aggregateColumns []interface{}
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     v.Function,
+		})
+	}
+
+	var outputConstraints []interface{}
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name":  aws.ToString(v.ColumnName),
+			"minimum":      aws.ToInt32(v.Minimum),
+			names.AttrType: v.Type,
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"aggregate_columns":      aggregateColumns,
+		"allowed_join_operators": flex.FlattenStringyValueSet(apiObject.AllowedJoinOperators),
+		"dimension_columns":      apiObject.DimensionColumns,
+		"join_columns":           apiObject.JoinColumns,
+		"join_required":          apiObject.JoinRequired,
+		"output_constraints":     outputConstraints,
+		"scalar_functions":       flex.FlattenStringyValueSet(apiObject.ScalarFunctions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRuleCustom(apiObject *types.AnalysisRuleCustom) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed_analyses":           apiObject.AllowedAnalyses,
+		"allowed_analysis_providers": apiObject.AllowedAnalysisProviders,
+	}
+
+	if v := apiObject.DifferentialPrivacy; v != nil {
+		var columns []string
+		for _, v := range v.Columns {
+			columns = append(columns, aws.ToString(v.Name))
+		}
+
+		tfMap["differential_privacy"] = []interface{}{map[string]interface{}{
+			"columns": columns,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRuleList(apiObject *types.AnalysisRuleList) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed_join_operators": flex.FlattenStringyValueSet(apiObject.AllowedJoinOperators),
+		"join_columns":           apiObject.JoinColumns,
+		"list_columns":           apiObject.ListColumns,
+	}
+
+	return []interface{}{tfMap}
+}
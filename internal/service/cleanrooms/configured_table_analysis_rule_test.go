@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "AGGREGATION"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_identifier", "aws_cleanrooms_configured_table.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "configured_table_arn"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "list.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "list.0.join_columns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "list.0.list_columns.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_custom(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysisRule types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckConfiguredTable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_custom(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analysis_providers.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.differential_privacy.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "custom.0.differential_privacy.0.columns.*", "my_column_1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Clean Rooms Configured Table Analysis Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, n string, v *types.ConfiguredTableAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_base(rName string) string {
+	return testAccConfiguredTableConfig(rName, TEST_NAME, TEST_DESCRIPTION, TEST_TAG, TEST_ALLOWED_COLUMNS,
+		TEST_ANALYSIS_METHOD, rName, rName)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string, minimum int) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type          = "AGGREGATION"
+
+  aggregation {
+    aggregate_columns {
+      column_names = ["my_column_1"]
+      function     = "COUNT_DISTINCT"
+    }
+
+    join_columns      = ["my_column_2"]
+    dimension_columns = []
+    scalar_functions  = []
+
+    output_constraints {
+      column_name = "my_column_2"
+      minimum     = %[1]d
+      type        = "COUNT_DISTINCT"
+    }
+  }
+}
+`, minimum))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type          = "LIST"
+
+  list {
+    join_columns = ["my_column_1"]
+    list_columns = ["my_column_2"]
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_custom(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type          = "CUSTOM"
+
+  custom {
+    allowed_analyses           = ["ANY_QUERY"]
+    allowed_analysis_providers = [data.aws_caller_identity.current.account_id]
+
+    differential_privacy {
+      columns = ["my_column_1"]
+    }
+  }
+}
+`)
+}
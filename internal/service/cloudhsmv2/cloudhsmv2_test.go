@@ -14,9 +14,14 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			acctest.CtBasic:      testAccCluster_basic,
-			acctest.CtDisappears: testAccCluster_disappears,
-			"tags":               testAccCluster_tags,
+			acctest.CtBasic:         testAccCluster_basic,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			acctest.CtDisappears:    testAccCluster_disappears,
+			"hsm2mMode":             testAccCluster_hsm2mMode,
+			"tags":                  testAccCluster_tags,
+		},
+		"ClusterInitialization": {
+			acctest.CtBasic: testAccClusterInitialization_basic,
 		},
 		"Hsm": {
 			"availabilityZone":   testAccHSM_AvailabilityZone,
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -48,6 +49,27 @@ func resourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.BackupRetentionTypeDays),
+							ValidateDiagFunc: enum.Validate[types.BackupRetentionType](),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
 			"cluster_certificates": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -88,7 +110,14 @@ func resourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium", "hsm2m.medium"}, false),
+			},
+			names.AttrMode: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ClusterMode](),
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -127,6 +156,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TagList:   getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrMode); ok {
+		input.Mode = types.ClusterMode(v.(string))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -167,12 +204,16 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("backup_retention_policy", flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting backup_retention_policy: %s", err)
+	}
 	if err := d.Set("cluster_certificates", flattenCertificates(cluster)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
 	d.Set("cluster_id", cluster.ClusterId)
 	d.Set("cluster_state", cluster.State)
 	d.Set("hsm_type", cluster.HsmType)
+	d.Set(names.AttrMode, cluster.Mode)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	d.Set("source_backup_identifier", cluster.SourceBackupId)
 	d.Set(names.AttrSubnetIDs, tfmaps.Values(cluster.SubnetMapping))
@@ -185,8 +226,22 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
 
-	// Tags only.
+			_, err := conn.ModifyCluster(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudHSMv2 Cluster (%s) backup retention policy: %s", d.Id(), err)
+			}
+		}
+	}
 
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
@@ -360,7 +415,7 @@ func flattenCertificates(apiObject *types.Cluster) []map[string]interface{} {
 			tfMap["aws_hardware_certificate"] = aws.ToString(apiObject.AwsHardwareCertificate)
 			tfMap["hsm_certificate"] = aws.ToString(apiObject.HsmCertificate)
 			tfMap["manufacturer_hardware_certificate"] = aws.ToString(apiObject.ManufacturerHardwareCertificate)
-		} else if clusterState == types.ClusterStateActive {
+		} else if clusterState == types.ClusterStateActive || clusterState == types.ClusterStateInitialized {
 			tfMap["cluster_certificate"] = aws.ToString(apiObject.ClusterCertificate)
 		}
 	}
@@ -371,3 +426,39 @@ func flattenCertificates(apiObject *types.Cluster) []map[string]interface{} {
 
 	return []map[string]interface{}{}
 }
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *types.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BackupRetentionPolicy{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.BackupRetentionType(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *types.BackupRetentionPolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: string(apiObject.Type),
+	}
+
+	if v := aws.ToString(apiObject.Value); v != "" {
+		if v, err := strconv.Atoi(v); err == nil {
+			tfMap[names.AttrValue] = v
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudhsm_v2_cluster_initialization", name="Cluster Initialization")
+func resourceClusterInitialization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterInitializationCreate,
+		ReadWithoutTimeout:   resourceClusterInitializationRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signed_cert": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"trust_anchor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceClusterInitializationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	clusterID := d.Get("cluster_id").(string)
+	input := &cloudhsmv2.InitializeClusterInput{
+		ClusterId:   aws.String(clusterID),
+		SignedCert:  aws.String(d.Get("signed_cert").(string)),
+		TrustAnchor: aws.String(d.Get("trust_anchor").(string)),
+	}
+
+	_, err := conn.InitializeCluster(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "initializing CloudHSMv2 Cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	if _, err := waitClusterInitialized(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudHSMv2 Cluster (%s) initialize: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterInitializationRead(ctx, d, meta)...)
+}
+
+func resourceClusterInitializationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	cluster, err := findClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("cluster_state", cluster.State)
+
+	return diags
+}
+
+func waitClusterInitialized(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.ClusterStateUninitialized, types.ClusterStateInitializeInProgress),
+		Target:     enum.Slice(types.ClusterStateInitialized, types.ClusterStateActive),
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Cluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccClusterInitialization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudhsm_v2_cluster_initialization.test"
+	clusterResourceName := "aws_cloudhsm_v2_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudHSMV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {
+				Source:            "hashicorp/tls",
+				VersionConstraint: "4.0.4",
+			},
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInitializationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, clusterResourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", clusterResourceName, "cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_state", string(types.ClusterStateInitialized)),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterInitializationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_hsm" "test" {
+  cluster_id = aws_cloudhsm_v2_cluster.test.cluster_id
+  subnet_id  = aws_subnet.test[0].id
+}
+
+resource "tls_private_key" "ca" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "ca" {
+  private_key_pem = tls_private_key.ca.private_key_pem
+
+  subject {
+    common_name  = %[1]q
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+  is_ca_certificate     = true
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "tls_locally_signed_cert" "cluster" {
+  cert_request_pem   = aws_cloudhsm_v2_cluster.test.cluster_certificates[0].cluster_csr
+  ca_private_key_pem = tls_private_key.ca.private_key_pem
+  ca_cert_pem        = tls_self_signed_cert.ca.cert_pem
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+  ]
+}
+
+resource "aws_cloudhsm_v2_cluster_initialization" "test" {
+  cluster_id   = aws_cloudhsm_v2_hsm.test.cluster_id
+  signed_cert  = tls_locally_signed_cert.cluster.cert_pem
+  trust_anchor = tls_self_signed_cert.ca.cert_pem
+}
+`, rName))
+}
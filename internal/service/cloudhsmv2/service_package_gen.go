@@ -40,6 +40,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceClusterInitialization,
+			TypeName: "aws_cloudhsm_v2_cluster_initialization",
+			Name:     "Cluster Initialization",
+		},
 		{
 			Factory:  resourceHSM,
 			TypeName: "aws_cloudhsm_v2_hsm",
@@ -17,6 +17,7 @@ func TestAccDetective_serial(t *testing.T) {
 		"Graph": {
 			acctest.CtBasic:      testAccGraph_basic,
 			acctest.CtDisappears: testAccGraph_disappears,
+			"datasourcePackages": testAccGraph_datasourcePackages,
 			"tags":               testAccGraph_tags,
 		},
 		"InvitationAccepter": {
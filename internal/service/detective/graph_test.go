@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -114,6 +115,45 @@ func testAccGraph_tags(t *testing.T) {
 	})
 }
 
+func testAccGraph_datasourcePackages(t *testing.T) {
+	ctx := acctest.Context(t)
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"DETECTIVE_CORE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "DETECTIVE_CORE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"DETECTIVE_CORE", "EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "DETECTIVE_CORE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "EKS_AUDIT"),
+				),
+			},
+			{
+				Config:      testAccGraphConfig_datasourcePackages(`"DETECTIVE_CORE"`),
+				ExpectError: regexache.MustCompile(`data source packages cannot be stopped`),
+			},
+		},
+	})
+}
+
 func testAccCheckGraphDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn(ctx)
@@ -187,3 +227,11 @@ resource "aws_detective_graph" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccGraphConfig_datasourcePackages(packages string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  datasource_packages = [%[1]s]
+}
+`, packages)
+}
@@ -44,6 +44,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DefaultPolicyTypeValues](),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,6 +138,31 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -174,6 +205,41 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceTypeValues](),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -210,6 +276,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"policy_language": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PolicyLanguageValues](),
+						},
 						"policy_type": {
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -223,6 +295,48 @@ func resourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		names.AttrInterval: {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ValidateDiagFunc: enum.Validate[awstypes.RetentionIntervalUnitValues](),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -461,6 +575,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						"target_tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -492,14 +612,19 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DLMClient(ctx)
 
+	defaultPolicy := d.Get("default_policy").(string)
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get(names.AttrDescription).(string)),
 		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
-		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{})),
+		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{}), defaultPolicy),
 		State:            awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string)),
 		Tags:             getTagsIn(ctx),
 	}
 
+	if defaultPolicy != "" {
+		input.DefaultPolicy = awstypes.DefaultPolicyTypeValues(defaultPolicy)
+	}
+
 	out, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, createRetryTimeout, func() (interface{}, error) {
 		return conn.CreateLifecyclePolicy(ctx, &input)
 	})
@@ -531,6 +656,11 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrARN, out.Policy.PolicyArn)
+	if aws.ToBool(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set(names.AttrDescription, out.Policy.Description)
 	d.Set(names.AttrExecutionRoleARN, out.Policy.ExecutionRoleArn)
 	d.Set(names.AttrState, out.Policy.State)
@@ -562,7 +692,7 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 			input.State = awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string))
 		}
 		if d.HasChange("policy_details") {
-			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}))
+			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}), d.Get("default_policy").(string))
 		}
 
 		log.Printf("[INFO] Updating lifecycle policy %s", d.Id())
@@ -620,7 +750,7 @@ func findLifecyclePolicyByID(ctx context.Context, conn *dlm.Client, id string) (
 	return output, nil
 }
 
-func expandPolicyDetails(cfg []interface{}) *awstypes.PolicyDetails {
+func expandPolicyDetails(cfg []interface{}, defaultPolicy string) *awstypes.PolicyDetails {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
@@ -630,6 +760,33 @@ func expandPolicyDetails(cfg []interface{}) *awstypes.PolicyDetails {
 	policyDetails := &awstypes.PolicyDetails{
 		PolicyType: awstypes.PolicyTypeValues(policyType),
 	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValues(v)
+	}
+	if v, ok := m["resource_type"].(string); ok && v != "" {
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(v)
+	}
+	// The following arguments are only valid for policies using the simplified policy language, e.g. default policies.
+	if defaultPolicy != "" || policyDetails.PolicyLanguage == awstypes.PolicyLanguageValuesSimplified {
+		if v, ok := m["copy_tags"].(bool); ok {
+			policyDetails.CopyTags = aws.Bool(v)
+		}
+		if v, ok := m["create_interval"].(int); ok && v > 0 {
+			policyDetails.CreateInterval = aws.Int32(int32(v))
+		}
+		if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+			policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+		}
+		if v, ok := m["exclusions"].([]interface{}); ok && len(v) > 0 {
+			policyDetails.Exclusions = expandExclusions(v)
+		}
+		if v, ok := m["extend_deletion"].(bool); ok {
+			policyDetails.ExtendDeletion = aws.Bool(v)
+		}
+		if v, ok := m["retain_interval"].(int); ok && v > 0 {
+			policyDetails.RetainInterval = aws.Int32(int32(v))
+		}
+	}
 	if v, ok := m["resource_types"].([]interface{}); ok && len(v) > 0 {
 		policyDetails.ResourceTypes = flex.ExpandStringyValueList[awstypes.ResourceTypeValues](v)
 	}
@@ -664,6 +821,14 @@ func flattenPolicyDetails(policyDetails *awstypes.PolicyDetails) []map[string]in
 	result[names.AttrSchedule] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = string(policyDetails.PolicyType)
+	result["policy_language"] = string(policyDetails.PolicyLanguage)
+	result["resource_type"] = string(policyDetails.ResourceType)
+	result["copy_tags"] = aws.ToBool(policyDetails.CopyTags)
+	result["create_interval"] = aws.ToInt32(policyDetails.CreateInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	result["extend_deletion"] = aws.ToBool(policyDetails.ExtendDeletion)
+	result["retain_interval"] = aws.ToInt32(policyDetails.RetainInterval)
 
 	if policyDetails.Parameters != nil {
 		result[names.AttrParameters] = flattenParameters(policyDetails.Parameters)
@@ -677,6 +842,9 @@ func expandSchedules(cfg []interface{}) []awstypes.Schedule {
 	for i, c := range cfg {
 		schedule := awstypes.Schedule{}
 		m := c.(map[string]interface{})
+		if v, ok := m["archive_rule"].([]interface{}); ok && len(v) > 0 {
+			schedule.ArchiveRule = expandArchiveRule(v)
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -726,6 +894,10 @@ func flattenSchedules(schedules []awstypes.Schedule) []map[string]interface{} {
 		m["tags_to_add"] = flattenTags(s.TagsToAdd)
 		m["variable_tags"] = flattenTags(s.VariableTags)
 
+		if s.ArchiveRule != nil {
+			m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
+		}
+
 		if s.DeprecateRule != nil {
 			m["deprecate_rule"] = flattenDeprecateRule(s.DeprecateRule)
 		}
@@ -1152,6 +1324,71 @@ func flattenDeprecateRule(rule *awstypes.DeprecateRule) []map[string]interface{}
 	return []map[string]interface{}{result}
 }
 
+func expandArchiveRule(cfg []interface{}) *awstypes.ArchiveRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	rule := &awstypes.ArchiveRule{}
+
+	if v, ok := m["archive_retain_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rule.RetainRule = &awstypes.ArchiveRetainRule{}
+
+		if v, ok := v[0].(map[string]interface{})["retention_archive_tier"].([]interface{}); ok && len(v) > 0 {
+			rule.RetainRule.RetentionArchiveTier = expandRetentionArchiveTier(v)
+		}
+	}
+
+	return rule
+}
+
+func expandRetentionArchiveTier(cfg []interface{}) *awstypes.RetentionArchiveTier {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	tier := &awstypes.RetentionArchiveTier{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		tier.Count = aws.Int32(int32(v))
+	}
+
+	if v, ok := m[names.AttrInterval].(int); ok && v > 0 {
+		tier.Interval = aws.Int32(int32(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		tier.IntervalUnit = awstypes.RetentionIntervalUnitValues(v)
+	}
+
+	return tier
+}
+
+func flattenArchiveRule(rule *awstypes.ArchiveRule) []map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if rule.RetainRule != nil {
+		result["archive_retain_rule"] = []map[string]interface{}{{
+			"retention_archive_tier": flattenRetentionArchiveTier(rule.RetainRule.RetentionArchiveTier),
+		}}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func flattenRetentionArchiveTier(tier *awstypes.RetentionArchiveTier) []map[string]interface{} {
+	if tier == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["count"] = aws.ToInt32(tier.Count)
+	result["interval_unit"] = string(tier.IntervalUnit)
+	result[names.AttrInterval] = aws.ToInt32(tier.Interval)
+
+	return []map[string]interface{}{result}
+}
+
 func expandFastRestoreRule(cfg []interface{}) *awstypes.FastRestoreRule {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
@@ -1236,6 +1473,71 @@ func flattenShareRule(rules []awstypes.ShareRule) []map[string]interface{} {
 	return values
 }
 
+func expandCrossRegionCopyTargets(l []interface{}) []awstypes.CrossRegionCopyTarget {
+	var targets []awstypes.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, awstypes.CrossRegionCopyTarget{
+			TargetRegion: aws.String(m["target_region"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []awstypes.CrossRegionCopyTarget) []interface{} {
+	var result []interface{}
+
+	for _, target := range targets {
+		result = append(result, map[string]interface{}{
+			"target_region": aws.ToString(target.TargetRegion),
+		})
+	}
+
+	return result
+}
+
+func expandExclusions(cfg []interface{}) *awstypes.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	exclusions := &awstypes.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringValueList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *awstypes.Exclusions) []map[string]interface{} {
+	if exclusions == nil || (!aws.ToBool(exclusions.ExcludeBootVolumes) && len(exclusions.ExcludeTags) == 0 && len(exclusions.ExcludeVolumeTypes) == 0) {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["exclude_boot_volumes"] = aws.ToBool(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = flex.FlattenStringValueList(exclusions.ExcludeVolumeTypes)
+
+	return []map[string]interface{}{result}
+}
+
 func expandTags(m map[string]interface{}) []awstypes.Tag {
 	var result []awstypes.Tag
 	for k, v := range m {
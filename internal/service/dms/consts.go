@@ -159,6 +159,15 @@ const (
 	replicationStatusReplicationStarting  = "replication_starting"
 )
 
+const (
+	replicationTaskAssessmentRunStatusDeleting     = "deleting"
+	replicationTaskAssessmentRunStatusFailed       = "failed"
+	replicationTaskAssessmentRunStatusPassed       = "passed"
+	replicationTaskAssessmentRunStatusProvisioning = "provisioning"
+	replicationTaskAssessmentRunStatusRunning      = "running"
+	replicationTaskAssessmentRunStatusStarting     = "starting"
+)
+
 const (
 	replicationTypeValueStartReplication = "creating"
 	replicationTypeValueResumeProcessing = "resume-processing"
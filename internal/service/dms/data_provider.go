@@ -0,0 +1,549 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var dataProviderSettingsKeys = []string{
+	"docdb_settings",
+	"mariadb_settings",
+	"microsoft_sql_server_settings",
+	"mongodb_settings",
+	"mysql_settings",
+	"oracle_settings",
+	"postgres_settings",
+	"redshift_settings",
+}
+
+// @SDKResource("aws_dms_data_provider", name="Data Provider")
+// @Tags(identifierAttribute="id")
+func resourceDataProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataProviderCreate,
+		ReadWithoutTimeout:   resourceDataProviderRead,
+		UpdateWithoutTimeout: resourceDataProviderUpdate,
+		DeleteWithoutTimeout: resourceDataProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"docdb_settings": dataProviderSettingsSchema("certificate_arn", names.AttrDatabaseName, names.AttrPort, "server_name", "ssl_mode"),
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mariadb_settings":              dataProviderSettingsSchema("certificate_arn", names.AttrPort, "server_name", "ssl_mode"),
+			"microsoft_sql_server_settings": dataProviderSettingsSchema("certificate_arn", names.AttrDatabaseName, names.AttrPort, "server_name", "ssl_mode"),
+			"mongodb_settings":              dataProviderSettingsSchema("auth_mechanism", "auth_source", "auth_type", "certificate_arn", names.AttrDatabaseName, names.AttrPort, "server_name", "ssl_mode"),
+			"mysql_settings":                dataProviderSettingsSchema("certificate_arn", names.AttrPort, "server_name", "ssl_mode"),
+			"oracle_settings": dataProviderSettingsSchema(
+				"asm_server",
+				"certificate_arn",
+				names.AttrDatabaseName,
+				names.AttrPort,
+				"secrets_manager_oracle_asm_access_role_arn",
+				"secrets_manager_oracle_asm_secret_id",
+				"secrets_manager_security_db_encryption_access_role_arn",
+				"secrets_manager_security_db_encryption_secret_id",
+				"server_name",
+				"ssl_mode",
+			),
+			"postgres_settings": dataProviderSettingsSchema("certificate_arn", names.AttrDatabaseName, names.AttrPort, "server_name", "ssl_mode"),
+			"redshift_settings": dataProviderSettingsSchema(names.AttrDatabaseName, names.AttrPort, "server_name"),
+			names.AttrTags:      tftags.TagsSchema(),
+			names.AttrTagsAll:   tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func dataProviderSettingsSchema(keys ...string) *schema.Schema {
+	attributes := map[string]*schema.Schema{
+		"asm_server": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"auth_mechanism": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(dms.AuthMechanismValue_Values(), false),
+		},
+		"auth_source": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"auth_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(dms.AuthTypeValue_Values(), false),
+		},
+		"certificate_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		names.AttrDatabaseName: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		names.AttrPort: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"secrets_manager_oracle_asm_access_role_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"secrets_manager_oracle_asm_secret_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"secrets_manager_security_db_encryption_access_role_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"secrets_manager_security_db_encryption_secret_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"server_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ssl_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(dms.DmsSslModeValue_Values(), false),
+		},
+	}
+
+	elem := &schema.Resource{
+		Schema: make(map[string]*schema.Schema, len(keys)),
+	}
+	for _, k := range keys {
+		elem.Schema[k] = attributes[k]
+	}
+
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Elem:         elem,
+		ExactlyOneOf: dataProviderSettingsKeys,
+	}
+}
+
+func resourceDataProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	input := &dms.CreateDataProviderInput{
+		Engine:   aws.String(d.Get(names.AttrEngine).(string)),
+		Settings: expandDataProviderSettings(d),
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_provider_name"); ok {
+		input.DataProviderName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDataProviderWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DMS Data Provider: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DataProvider.DataProviderArn))
+
+	return append(diags, resourceDataProviderRead(ctx, d, meta)...)
+}
+
+func resourceDataProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	dataProvider, err := findDataProviderByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Data Provider (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Data Provider (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, dataProvider.DataProviderArn)
+	d.Set("data_provider_name", dataProvider.DataProviderName)
+	d.Set(names.AttrDescription, dataProvider.Description)
+	d.Set(names.AttrEngine, dataProvider.Engine)
+	if err := flattenDataProviderSettings(d, dataProvider.Settings); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceDataProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &dms.ModifyDataProviderInput{
+			DataProviderIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_provider_name") {
+			input.DataProviderName = aws.String(d.Get("data_provider_name").(string))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges(append([]string{names.AttrEngine}, dataProviderSettingsKeys...)...) {
+			// The engine and its settings are always replaced together.
+			input.Engine = aws.String(d.Get(names.AttrEngine).(string))
+			input.ExactSettings = aws.Bool(true)
+			input.Settings = expandDataProviderSettings(d)
+		}
+
+		_, err := conn.ModifyDataProviderWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DMS Data Provider (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataProviderRead(ctx, d, meta)...)
+}
+
+func resourceDataProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting DMS Data Provider: %s", d.Id())
+	_, err := conn.DeleteDataProviderWithContext(ctx, &dms.DeleteDataProviderInput{
+		DataProviderIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Data Provider (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDataProviderByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.DataProvider, error) {
+	input := &dms.DescribeDataProvidersInput{
+		Filters: []*dms.Filter{{
+			Name:   aws.String("data-provider-identifier"),
+			Values: aws.StringSlice([]string{arn}),
+		}},
+	}
+
+	return findDataProvider(ctx, conn, input)
+}
+
+func findDataProvider(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeDataProvidersInput) (*dms.DataProvider, error) {
+	output, err := findDataProviders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findDataProviders(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeDataProvidersInput) ([]*dms.DataProvider, error) {
+	var output []*dms.DataProvider
+
+	err := conn.DescribeDataProvidersPagesWithContext(ctx, input, func(page *dms.DescribeDataProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataProviders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandDataProviderSettings(d *schema.ResourceData) *dms.DataProviderSettings {
+	apiObject := &dms.DataProviderSettings{}
+
+	if tfMap := dataProviderSettingsMap(d, "docdb_settings"); tfMap != nil {
+		apiObject.DocDbSettings = &dms.DocDbDataProviderSettings{
+			CertificateArn: dataProviderStringValue(tfMap, "certificate_arn"),
+			DatabaseName:   dataProviderStringValue(tfMap, names.AttrDatabaseName),
+			Port:           dataProviderPortValue(tfMap),
+			ServerName:     dataProviderStringValue(tfMap, "server_name"),
+			SslMode:        dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "mariadb_settings"); tfMap != nil {
+		apiObject.MariaDbSettings = &dms.MariaDbDataProviderSettings{
+			CertificateArn: dataProviderStringValue(tfMap, "certificate_arn"),
+			Port:           dataProviderPortValue(tfMap),
+			ServerName:     dataProviderStringValue(tfMap, "server_name"),
+			SslMode:        dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "microsoft_sql_server_settings"); tfMap != nil {
+		apiObject.MicrosoftSqlServerSettings = &dms.MicrosoftSqlServerDataProviderSettings{
+			CertificateArn: dataProviderStringValue(tfMap, "certificate_arn"),
+			DatabaseName:   dataProviderStringValue(tfMap, names.AttrDatabaseName),
+			Port:           dataProviderPortValue(tfMap),
+			ServerName:     dataProviderStringValue(tfMap, "server_name"),
+			SslMode:        dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "mongodb_settings"); tfMap != nil {
+		apiObject.MongoDbSettings = &dms.MongoDbDataProviderSettings{
+			AuthMechanism:  dataProviderStringValue(tfMap, "auth_mechanism"),
+			AuthSource:     dataProviderStringValue(tfMap, "auth_source"),
+			AuthType:       dataProviderStringValue(tfMap, "auth_type"),
+			CertificateArn: dataProviderStringValue(tfMap, "certificate_arn"),
+			DatabaseName:   dataProviderStringValue(tfMap, names.AttrDatabaseName),
+			Port:           dataProviderPortValue(tfMap),
+			ServerName:     dataProviderStringValue(tfMap, "server_name"),
+			SslMode:        dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "mysql_settings"); tfMap != nil {
+		apiObject.MySqlSettings = &dms.MySqlDataProviderSettings{
+			CertificateArn: dataProviderStringValue(tfMap, "certificate_arn"),
+			Port:           dataProviderPortValue(tfMap),
+			ServerName:     dataProviderStringValue(tfMap, "server_name"),
+			SslMode:        dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "oracle_settings"); tfMap != nil {
+		apiObject.OracleSettings = &dms.OracleDataProviderSettings{
+			AsmServer:                            dataProviderStringValue(tfMap, "asm_server"),
+			CertificateArn:                       dataProviderStringValue(tfMap, "certificate_arn"),
+			DatabaseName:                         dataProviderStringValue(tfMap, names.AttrDatabaseName),
+			Port:                                 dataProviderPortValue(tfMap),
+			SecretsManagerOracleAsmAccessRoleArn: dataProviderStringValue(tfMap, "secrets_manager_oracle_asm_access_role_arn"),
+			SecretsManagerOracleAsmSecretId:      dataProviderStringValue(tfMap, "secrets_manager_oracle_asm_secret_id"),
+			SecretsManagerSecurityDbEncryptionAccessRoleArn: dataProviderStringValue(tfMap, "secrets_manager_security_db_encryption_access_role_arn"),
+			SecretsManagerSecurityDbEncryptionSecretId:      dataProviderStringValue(tfMap, "secrets_manager_security_db_encryption_secret_id"),
+			ServerName: dataProviderStringValue(tfMap, "server_name"),
+			SslMode:    dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "postgres_settings"); tfMap != nil {
+		apiObject.PostgreSqlSettings = &dms.PostgreSqlDataProviderSettings{
+			CertificateArn: dataProviderStringValue(tfMap, "certificate_arn"),
+			DatabaseName:   dataProviderStringValue(tfMap, names.AttrDatabaseName),
+			Port:           dataProviderPortValue(tfMap),
+			ServerName:     dataProviderStringValue(tfMap, "server_name"),
+			SslMode:        dataProviderStringValue(tfMap, "ssl_mode"),
+		}
+	}
+
+	if tfMap := dataProviderSettingsMap(d, "redshift_settings"); tfMap != nil {
+		apiObject.RedshiftSettings = &dms.RedshiftDataProviderSettings{
+			DatabaseName: dataProviderStringValue(tfMap, names.AttrDatabaseName),
+			Port:         dataProviderPortValue(tfMap),
+			ServerName:   dataProviderStringValue(tfMap, "server_name"),
+		}
+	}
+
+	return apiObject
+}
+
+func dataProviderSettingsMap(d *schema.ResourceData, key string) map[string]interface{} {
+	if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return v.([]interface{})[0].(map[string]interface{})
+	}
+
+	return nil
+}
+
+func dataProviderStringValue(tfMap map[string]interface{}, key string) *string {
+	if v, ok := tfMap[key].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func dataProviderPortValue(tfMap map[string]interface{}) *int64 {
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
+		return aws.Int64(int64(v))
+	}
+
+	return nil
+}
+
+func flattenDataProviderSettings(d *schema.ResourceData, apiObject *dms.DataProviderSettings) error {
+	settings := make(map[string][]interface{}, len(dataProviderSettingsKeys))
+
+	if apiObject != nil {
+		if v := apiObject.DocDbSettings; v != nil {
+			settings["docdb_settings"] = []interface{}{map[string]interface{}{
+				"certificate_arn":      aws.StringValue(v.CertificateArn),
+				names.AttrDatabaseName: aws.StringValue(v.DatabaseName),
+				names.AttrPort:         aws.Int64Value(v.Port),
+				"server_name":          aws.StringValue(v.ServerName),
+				"ssl_mode":             aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.MariaDbSettings; v != nil {
+			settings["mariadb_settings"] = []interface{}{map[string]interface{}{
+				"certificate_arn": aws.StringValue(v.CertificateArn),
+				names.AttrPort:    aws.Int64Value(v.Port),
+				"server_name":     aws.StringValue(v.ServerName),
+				"ssl_mode":        aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.MicrosoftSqlServerSettings; v != nil {
+			settings["microsoft_sql_server_settings"] = []interface{}{map[string]interface{}{
+				"certificate_arn":      aws.StringValue(v.CertificateArn),
+				names.AttrDatabaseName: aws.StringValue(v.DatabaseName),
+				names.AttrPort:         aws.Int64Value(v.Port),
+				"server_name":          aws.StringValue(v.ServerName),
+				"ssl_mode":             aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.MongoDbSettings; v != nil {
+			settings["mongodb_settings"] = []interface{}{map[string]interface{}{
+				"auth_mechanism":       aws.StringValue(v.AuthMechanism),
+				"auth_source":          aws.StringValue(v.AuthSource),
+				"auth_type":            aws.StringValue(v.AuthType),
+				"certificate_arn":      aws.StringValue(v.CertificateArn),
+				names.AttrDatabaseName: aws.StringValue(v.DatabaseName),
+				names.AttrPort:         aws.Int64Value(v.Port),
+				"server_name":          aws.StringValue(v.ServerName),
+				"ssl_mode":             aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.MySqlSettings; v != nil {
+			settings["mysql_settings"] = []interface{}{map[string]interface{}{
+				"certificate_arn": aws.StringValue(v.CertificateArn),
+				names.AttrPort:    aws.Int64Value(v.Port),
+				"server_name":     aws.StringValue(v.ServerName),
+				"ssl_mode":        aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.OracleSettings; v != nil {
+			settings["oracle_settings"] = []interface{}{map[string]interface{}{
+				"asm_server":           aws.StringValue(v.AsmServer),
+				"certificate_arn":      aws.StringValue(v.CertificateArn),
+				names.AttrDatabaseName: aws.StringValue(v.DatabaseName),
+				names.AttrPort:         aws.Int64Value(v.Port),
+				"secrets_manager_oracle_asm_access_role_arn":             aws.StringValue(v.SecretsManagerOracleAsmAccessRoleArn),
+				"secrets_manager_oracle_asm_secret_id":                   aws.StringValue(v.SecretsManagerOracleAsmSecretId),
+				"secrets_manager_security_db_encryption_access_role_arn": aws.StringValue(v.SecretsManagerSecurityDbEncryptionAccessRoleArn),
+				"secrets_manager_security_db_encryption_secret_id":       aws.StringValue(v.SecretsManagerSecurityDbEncryptionSecretId),
+				"server_name": aws.StringValue(v.ServerName),
+				"ssl_mode":    aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.PostgreSqlSettings; v != nil {
+			settings["postgres_settings"] = []interface{}{map[string]interface{}{
+				"certificate_arn":      aws.StringValue(v.CertificateArn),
+				names.AttrDatabaseName: aws.StringValue(v.DatabaseName),
+				names.AttrPort:         aws.Int64Value(v.Port),
+				"server_name":          aws.StringValue(v.ServerName),
+				"ssl_mode":             aws.StringValue(v.SslMode),
+			}}
+		}
+
+		if v := apiObject.RedshiftSettings; v != nil {
+			settings["redshift_settings"] = []interface{}{map[string]interface{}{
+				names.AttrDatabaseName: aws.StringValue(v.DatabaseName),
+				names.AttrPort:         aws.Int64Value(v.Port),
+				"server_name":          aws.StringValue(v.ServerName),
+			}}
+		}
+	}
+
+	for _, k := range dataProviderSettingsKeys {
+		if err := d.Set(k, settings[k]); err != nil {
+			return fmt.Errorf("setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
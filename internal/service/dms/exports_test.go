@@ -5,9 +5,18 @@ package dms
 
 // Exports for use in tests only.
 var (
-	TaskSettingsEqual             = taskSettingsEqual
-	ValidEndpointID               = validEndpointID
-	ValidReplicationInstanceID    = validReplicationInstanceID
-	ValidReplicationSubnetGroupID = validReplicationSubnetGroupID
-	ValidReplicationTaskID        = validReplicationTaskID
+	ResourceDataProvider                 = resourceDataProvider
+	ResourceInstanceProfile              = resourceInstanceProfile
+	ResourceMigrationProject             = resourceMigrationProject
+	ResourceReplicationTaskAssessmentRun = resourceReplicationTaskAssessmentRun
+
+	FindDataProviderByARN                 = findDataProviderByARN
+	FindInstanceProfileByARN              = findInstanceProfileByARN
+	FindMigrationProjectByARN             = findMigrationProjectByARN
+	FindReplicationTaskAssessmentRunByARN = findReplicationTaskAssessmentRunByARN
+	TaskSettingsEqual                     = taskSettingsEqual
+	ValidEndpointID                       = validEndpointID
+	ValidReplicationInstanceID            = validReplicationInstanceID
+	ValidReplicationSubnetGroupID         = validReplicationSubnetGroupID
+	ValidReplicationTaskID                = validReplicationTaskID
 )
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_migration_project", name="Migration Project")
+// @Tags(identifierAttribute="id")
+func resourceMigrationProject() *schema.Resource {
+	dataProviderDescriptorSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"data_provider_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secrets_manager_access_role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secrets_manager_secret_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMigrationProjectCreate,
+		ReadWithoutTimeout:   resourceMigrationProjectRead,
+		UpdateWithoutTimeout: resourceMigrationProjectUpdate,
+		DeleteWithoutTimeout: resourceMigrationProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_profile_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"migration_project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"schema_conversion_application_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"s3_bucket_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"source_data_provider_descriptors": dataProviderDescriptorSchema,
+			names.AttrTags:                     tftags.TagsSchema(),
+			names.AttrTagsAll:                  tftags.TagsSchemaComputed(),
+			"target_data_provider_descriptors": dataProviderDescriptorSchema,
+			"transformation_rules": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMigrationProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	input := &dms.CreateMigrationProjectInput{
+		InstanceProfileIdentifier:     aws.String(d.Get("instance_profile_arn").(string)),
+		SourceDataProviderDescriptors: expandDataProviderDescriptorDefinitions(d.Get("source_data_provider_descriptors").([]interface{})),
+		Tags:                          getTagsIn(ctx),
+		TargetDataProviderDescriptors: expandDataProviderDescriptorDefinitions(d.Get("target_data_provider_descriptors").([]interface{})),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("migration_project_name"); ok {
+		input.MigrationProjectName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schema_conversion_application_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SchemaConversionApplicationAttributes = expandSCApplicationAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("transformation_rules"); ok {
+		input.TransformationRules = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateMigrationProjectWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DMS Migration Project: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.MigrationProject.MigrationProjectArn))
+
+	return append(diags, resourceMigrationProjectRead(ctx, d, meta)...)
+}
+
+func resourceMigrationProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	project, err := findMigrationProjectByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Migration Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Migration Project (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, project.MigrationProjectArn)
+	d.Set(names.AttrDescription, project.Description)
+	d.Set("instance_profile_arn", project.InstanceProfileArn)
+	d.Set("migration_project_name", project.MigrationProjectName)
+	if err := d.Set("schema_conversion_application_attributes", flattenSCApplicationAttributes(project.SchemaConversionApplicationAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schema_conversion_application_attributes: %s", err)
+	}
+	if err := d.Set("source_data_provider_descriptors", flattenDataProviderDescriptors(project.SourceDataProviderDescriptors)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_data_provider_descriptors: %s", err)
+	}
+	if err := d.Set("target_data_provider_descriptors", flattenDataProviderDescriptors(project.TargetDataProviderDescriptors)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_data_provider_descriptors: %s", err)
+	}
+	d.Set("transformation_rules", project.TransformationRules)
+
+	return diags
+}
+
+func resourceMigrationProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &dms.ModifyMigrationProjectInput{
+			MigrationProjectIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("instance_profile_arn") {
+			input.InstanceProfileIdentifier = aws.String(d.Get("instance_profile_arn").(string))
+		}
+
+		if d.HasChange("migration_project_name") {
+			input.MigrationProjectName = aws.String(d.Get("migration_project_name").(string))
+		}
+
+		if d.HasChange("schema_conversion_application_attributes") {
+			if v, ok := d.GetOk("schema_conversion_application_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SchemaConversionApplicationAttributes = expandSCApplicationAttributes(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.SchemaConversionApplicationAttributes = &dms.SCApplicationAttributes{}
+			}
+		}
+
+		if d.HasChange("source_data_provider_descriptors") {
+			input.SourceDataProviderDescriptors = expandDataProviderDescriptorDefinitions(d.Get("source_data_provider_descriptors").([]interface{}))
+		}
+
+		if d.HasChange("target_data_provider_descriptors") {
+			input.TargetDataProviderDescriptors = expandDataProviderDescriptorDefinitions(d.Get("target_data_provider_descriptors").([]interface{}))
+		}
+
+		if d.HasChange("transformation_rules") {
+			input.TransformationRules = aws.String(d.Get("transformation_rules").(string))
+		}
+
+		_, err := conn.ModifyMigrationProjectWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DMS Migration Project (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMigrationProjectRead(ctx, d, meta)...)
+}
+
+func resourceMigrationProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting DMS Migration Project: %s", d.Id())
+	_, err := conn.DeleteMigrationProjectWithContext(ctx, &dms.DeleteMigrationProjectInput{
+		MigrationProjectIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Migration Project (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMigrationProjectByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.MigrationProject, error) {
+	input := &dms.DescribeMigrationProjectsInput{
+		Filters: []*dms.Filter{{
+			Name:   aws.String("migration-project-identifier"),
+			Values: aws.StringSlice([]string{arn}),
+		}},
+	}
+
+	return findMigrationProject(ctx, conn, input)
+}
+
+func findMigrationProject(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeMigrationProjectsInput) (*dms.MigrationProject, error) {
+	output, err := findMigrationProjects(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findMigrationProjects(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeMigrationProjectsInput) ([]*dms.MigrationProject, error) {
+	var output []*dms.MigrationProject
+
+	err := conn.DescribeMigrationProjectsPagesWithContext(ctx, input, func(page *dms.DescribeMigrationProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MigrationProjects {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandDataProviderDescriptorDefinitions(tfList []interface{}) []*dms.DataProviderDescriptorDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*dms.DataProviderDescriptorDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &dms.DataProviderDescriptorDefinition{
+			DataProviderIdentifier: aws.String(tfMap["data_provider_arn"].(string)),
+		}
+
+		if v, ok := tfMap["secrets_manager_access_role_arn"].(string); ok && v != "" {
+			apiObject.SecretsManagerAccessRoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["secrets_manager_secret_id"].(string); ok && v != "" {
+			apiObject.SecretsManagerSecretId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDataProviderDescriptors(apiObjects []*dms.DataProviderDescriptor) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"data_provider_arn":               aws.StringValue(apiObject.DataProviderArn),
+			"secrets_manager_access_role_arn": aws.StringValue(apiObject.SecretsManagerAccessRoleArn),
+			"secrets_manager_secret_id":       aws.StringValue(apiObject.SecretsManagerSecretId),
+		})
+	}
+
+	return tfList
+}
+
+func expandSCApplicationAttributes(tfMap map[string]interface{}) *dms.SCApplicationAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.SCApplicationAttributes{}
+
+	if v, ok := tfMap["s3_bucket_path"].(string); ok && v != "" {
+		apiObject.S3BucketPath = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_bucket_role_arn"].(string); ok && v != "" {
+		apiObject.S3BucketRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSCApplicationAttributes(apiObject *dms.SCApplicationAttributes) []interface{} {
+	if apiObject == nil || (apiObject.S3BucketPath == nil && apiObject.S3BucketRoleArn == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket_path":     aws.StringValue(apiObject.S3BucketPath),
+		"s3_bucket_role_arn": aws.StringValue(apiObject.S3BucketRoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSMigrationProject_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_migration_project.test"
+	var v dms.MigrationProject
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMigrationProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationProjectConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "dms", regexache.MustCompile(`migration-project:.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_profile_arn", "aws_dms_instance_profile.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "migration_project_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_data_provider_descriptors.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "source_data_provider_descriptors.0.data_provider_arn", "aws_dms_data_provider.source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "target_data_provider_descriptors.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "target_data_provider_descriptors.0.data_provider_arn", "aws_dms_data_provider.target", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMigrationProjectConfig_basic(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSMigrationProject_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_migration_project.test"
+	var v dms.MigrationProject
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMigrationProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationProjectConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdms.ResourceMigrationProject(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMigrationProjectExists(ctx context.Context, n string, v *dms.MigrationProject) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn(ctx)
+
+		output, err := tfdms.FindMigrationProjectByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMigrationProjectDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dms_migration_project" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn(ctx)
+
+			_, err := tfdms.FindMigrationProjectByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DMS Migration Project %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMigrationProjectConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccInstanceProfileConfig_basic(rName, "test"), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_dms_data_provider" "source" {
+  data_provider_name = "%[1]s-source"
+  engine             = "mysql"
+
+  mysql_settings {
+    port        = 3306
+    server_name = "mysql.example.com"
+    ssl_mode    = "none"
+  }
+}
+
+resource "aws_dms_data_provider" "target" {
+  data_provider_name = "%[1]s-target"
+  engine             = "postgres"
+
+  postgres_settings {
+    database_name = "tftest"
+    port          = 5432
+    server_name   = "postgres.example.com"
+    ssl_mode      = "none"
+  }
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "dms.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "secretsmanager:GetSecretValue"
+      Effect   = "Allow"
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+
+resource "aws_dms_migration_project" "test" {
+  description            = %[2]q
+  instance_profile_arn   = aws_dms_instance_profile.test.arn
+  migration_project_name = %[1]q
+
+  source_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.source.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  target_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.target.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
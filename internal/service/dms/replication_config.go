@@ -254,7 +254,28 @@ func resourceReplicationConfigUpdate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_replication") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_replication") && capacityUnitsChangedOnly(d) {
+		// Serverless capacity can be resized while the replication is running.
+		input := &dms.ModifyReplicationConfigInput{
+			ComputeConfig: &dms.ComputeConfig{
+				MaxCapacityUnits: aws.Int64(int64(d.Get("compute_config.0.max_capacity_units").(int))),
+				MinCapacityUnits: aws.Int64(int64(d.Get("compute_config.0.min_capacity_units").(int))),
+			},
+			ReplicationConfigArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.ModifyReplicationConfigWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DMS Replication Config (%s) capacity: %s", d.Id(), err)
+		}
+
+		if d.Get("start_replication").(bool) {
+			if _, err := waitReplicationRunning(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for DMS Serverless Replication (%s) capacity update: %s", d.Id(), err)
+			}
+		}
+	} else if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_replication") {
 		if err := stopReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -349,6 +370,31 @@ func resourceReplicationConfigDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+// capacityUnitsChangedOnly returns whether the only configuration changes are to the serverless capacity units.
+func capacityUnitsChangedOnly(d *schema.ResourceData) bool {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "compute_config", "start_replication") {
+		return false
+	}
+
+	if d.HasChange("start_replication") {
+		return false
+	}
+
+	if !d.HasChanges("compute_config.0.max_capacity_units", "compute_config.0.min_capacity_units") {
+		return false
+	}
+
+	return !d.HasChanges(
+		"compute_config.0."+names.AttrAvailabilityZone,
+		"compute_config.0.dns_name_servers",
+		"compute_config.0."+names.AttrKMSKeyID,
+		"compute_config.0.multi_az",
+		"compute_config.0."+names.AttrPreferredMaintenanceWindow,
+		"compute_config.0.replication_subnet_group_id",
+		"compute_config.0."+names.AttrVPCSecurityGroupIDs,
+	)
+}
+
 func FindReplicationConfigByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationConfig, error) {
 	input := &dms.DescribeReplicationConfigsInput{
 		Filters: []*dms.Filter{{
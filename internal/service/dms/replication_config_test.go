@@ -13,6 +13,7 @@ import (
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -423,6 +424,45 @@ func testAccCheckReplicationConfigDestroy(ctx context.Context) resource.TestChec
 // testAccReplicationConfigConfig_base_DummyDatabase creates Replication Endpoints referencing valid databases.
 // This should only be used in cases where actual replication is started, since it requires approcimately
 // six more minutes for setup and teardown.
+func TestAccDMSReplicationConfig_resizeCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_replication_config.test"
+	var v dms.ReplicationConfig
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigConfig_resizeCapacity(rName, 2, 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.max_capacity_units", "16"),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.min_capacity_units", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "start_replication", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccReplicationConfigConfig_resizeCapacity(rName, 4, 32),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.max_capacity_units", "32"),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.min_capacity_units", acctest.Ct4),
+					resource.TestCheckResourceAttr(resourceName, "start_replication", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccReplicationConfigConfig_base_ValidDatabase(rName string) string {
 	return acctest.ConfigCompose(testAccEndpointConfig_rdsClusterBase(rName), fmt.Sprintf(`
 resource "aws_dms_replication_subnet_group" "test" {
@@ -663,6 +703,32 @@ resource "aws_dms_replication_config" "test" {
 `, rName, start))
 }
 
+func testAccReplicationConfigConfig_resizeCapacity(rName string, minCapacity, maxCapacity int) string {
+	return acctest.ConfigCompose(
+		testAccReplicationConfigConfig_base_ValidDatabase(rName),
+		fmt.Sprintf(`
+resource "aws_dms_replication_config" "test" {
+  replication_config_identifier = %[1]q
+  resource_identifier           = %[1]q
+  replication_type              = "cdc"
+  source_endpoint_arn           = aws_dms_endpoint.source.endpoint_arn
+  target_endpoint_arn           = aws_dms_endpoint.target.endpoint_arn
+  table_mappings                = "{\"rules\":[{\"rule-type\":\"selection\",\"rule-id\":\"1\",\"rule-name\":\"1\",\"object-locator\":{\"schema-name\":\"%%\",\"table-name\":\"%%\"},\"rule-action\":\"include\"}]}"
+
+  start_replication = true
+
+  compute_config {
+    replication_subnet_group_id  = aws_dms_replication_subnet_group.test.replication_subnet_group_id
+    max_capacity_units           = "%[2]d"
+    min_capacity_units           = "%[3]d"
+    preferred_maintenance_window = "sun:23:45-mon:00:30"
+  }
+
+  depends_on = [aws_rds_cluster_instance.source, aws_rds_cluster_instance.target]
+}
+`, rName, maxCapacity, minCapacity))
+}
+
 func testAccReplicationConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccReplicationConfigConfig_base_DummyDatabase(rName),
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_replication_task_assessment_run", name="Replication Task Assessment Run")
+func resourceReplicationTaskAssessmentRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationTaskAssessmentRunCreate,
+		ReadWithoutTimeout:   resourceReplicationTaskAssessmentRunRead,
+		DeleteWithoutTimeout: resourceReplicationTaskAssessmentRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_run_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exclude": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"include_only"},
+			},
+			"include_only": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"exclude"},
+			},
+			"last_failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(encryptionMode_Values(), false),
+			},
+			"result_kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_location_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"result_location_folder": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"service_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReplicationTaskAssessmentRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	name := d.Get("assessment_run_name").(string)
+	input := &dms.StartReplicationTaskAssessmentRunInput{
+		AssessmentRunName:    aws.String(name),
+		ReplicationTaskArn:   aws.String(d.Get("replication_task_arn").(string)),
+		ResultLocationBucket: aws.String(d.Get("result_location_bucket").(string)),
+		ServiceAccessRoleArn: aws.String(d.Get("service_access_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("exclude"); ok && v.(*schema.Set).Len() > 0 {
+		input.Exclude = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("include_only"); ok && v.(*schema.Set).Len() > 0 {
+		input.IncludeOnly = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("result_encryption_mode"); ok {
+		input.ResultEncryptionMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_kms_key_arn"); ok {
+		input.ResultKmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_location_folder"); ok {
+		input.ResultLocationFolder = aws.String(v.(string))
+	}
+
+	output, err := conn.StartReplicationTaskAssessmentRunWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting DMS Replication Task Assessment Run (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationTaskAssessmentRun.ReplicationTaskAssessmentRunArn))
+
+	// A run whose individual assessments don't all pass fails creation, so dependent resources aren't started.
+	if _, err := waitReplicationTaskAssessmentRunPassed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReplicationTaskAssessmentRunRead(ctx, d, meta)...)
+}
+
+func resourceReplicationTaskAssessmentRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	run, err := findReplicationTaskAssessmentRunByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Replication Task Assessment Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, run.ReplicationTaskAssessmentRunArn)
+	d.Set("assessment_run_name", run.AssessmentRunName)
+	d.Set("last_failure_message", run.LastFailureMessage)
+	d.Set("replication_task_arn", run.ReplicationTaskArn)
+	d.Set("result_encryption_mode", run.ResultEncryptionMode)
+	d.Set("result_kms_key_arn", run.ResultKmsKeyArn)
+	d.Set("result_location_bucket", run.ResultLocationBucket)
+	d.Set("result_location_folder", run.ResultLocationFolder)
+	d.Set("service_access_role_arn", run.ServiceAccessRoleArn)
+	d.Set(names.AttrStatus, run.Status)
+
+	return diags
+}
+
+func resourceReplicationTaskAssessmentRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting DMS Replication Task Assessment Run: %s", d.Id())
+	_, err := conn.DeleteReplicationTaskAssessmentRunWithContext(ctx, &dms.DeleteReplicationTaskAssessmentRunInput{
+		ReplicationTaskAssessmentRunArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicationTaskAssessmentRunDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findReplicationTaskAssessmentRunByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationTaskAssessmentRun, error) {
+	input := &dms.DescribeReplicationTaskAssessmentRunsInput{
+		Filters: []*dms.Filter{{
+			Name:   aws.String("replication-task-assessment-run-arn"),
+			Values: aws.StringSlice([]string{arn}),
+		}},
+	}
+
+	return findReplicationTaskAssessmentRun(ctx, conn, input)
+}
+
+func findReplicationTaskAssessmentRun(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeReplicationTaskAssessmentRunsInput) (*dms.ReplicationTaskAssessmentRun, error) {
+	output, err := findReplicationTaskAssessmentRuns(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findReplicationTaskAssessmentRuns(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeReplicationTaskAssessmentRunsInput) ([]*dms.ReplicationTaskAssessmentRun, error) {
+	var output []*dms.ReplicationTaskAssessmentRun
+
+	err := conn.DescribeReplicationTaskAssessmentRunsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationTaskAssessmentRunsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationTaskAssessmentRuns {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusReplicationTaskAssessmentRun(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplicationTaskAssessmentRunByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitReplicationTaskAssessmentRunPassed(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationTaskAssessmentRunStatusProvisioning,
+			replicationTaskAssessmentRunStatusRunning,
+			replicationTaskAssessmentRunStatusStarting,
+		},
+		Target:     []string{replicationTaskAssessmentRunStatusPassed},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		if v := aws.StringValue(output.LastFailureMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		} else if aws.StringValue(output.Status) == replicationTaskAssessmentRunStatusFailed {
+			tfresource.SetLastError(err, errors.New("at least one individual assessment failed; see the assessment results in the result location bucket"))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationTaskAssessmentRunDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{replicationTaskAssessmentRunStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		return output, err
+	}
+
+	return nil, err
+}
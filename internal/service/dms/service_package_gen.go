@@ -60,6 +60,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrCertificateARN,
 			},
 		},
+		{
+			Factory:  resourceDataProvider,
+			TypeName: "aws_dms_data_provider",
+			Name:     "Data Provider",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  ResourceEndpoint,
 			TypeName: "aws_dms_endpoint",
@@ -76,6 +84,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceInstanceProfile,
+			TypeName: "aws_dms_instance_profile",
+			Name:     "Instance Profile",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory:  resourceMigrationProject,
+			TypeName: "aws_dms_migration_project",
+			Name:     "Migration Project",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  ResourceReplicationConfig,
 			TypeName: "aws_dms_replication_config",
@@ -108,6 +132,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "replication_task_arn",
 			},
 		},
+		{
+			Factory:  resourceReplicationTaskAssessmentRun,
+			TypeName: "aws_dms_replication_task_assessment_run",
+			Name:     "Replication Task Assessment Run",
+		},
 		{
 			Factory:  ResourceS3Endpoint,
 			TypeName: "aws_dms_s3_endpoint",
@@ -193,6 +193,91 @@ func FindRegion(ctx context.Context, conn *directoryservice.DirectoryService, di
 	return region, nil
 }
 
+func FindSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) (*directoryservice.SchemaExtensionInfo, error) {
+	input := &directoryservice.ListSchemaExtensionsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []*directoryservice.SchemaExtensionInfo
+
+	err := conn.ListSchemaExtensionsPagesWithContext(ctx, input, func(page *directoryservice.ListSchemaExtensionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchemaExtensionsInfo {
+			if v != nil && aws.StringValue(v.SchemaExtensionId) == schemaExtensionID {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	schemaExtension := output[0]
+
+	if status := aws.StringValue(schemaExtension.SchemaExtensionStatus); status == directoryservice.SchemaExtensionStatusCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return schemaExtension, nil
+}
+
+func FindSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) ([]*directoryservice.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []*directoryservice.SettingEntry
+
+	err := describeSettingsPages(ctx, conn, input, func(page *directoryservice.DescribeSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SettingEntries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindSharedDirectory(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string) (*directoryservice.SharedDirectory, error) { // nosemgrep:ci.ds-in-func-name
 	input := &directoryservice.DescribeSharedDirectoriesInput{
 		OwnerDirectoryId:   aws.String(ownerDirectoryID),
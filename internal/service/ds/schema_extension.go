@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_directory_service_schema_extension", name="Schema Extension")
+func ResourceSchemaExtension() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaExtensionCreate,
+		ReadWithoutTimeout:   resourceSchemaExtensionRead,
+		DeleteWithoutTimeout: resourceSchemaExtensionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_snapshot_before_schema_extension": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ldif_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500000),
+			},
+			"schema_extension_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	input := &directoryservice.StartSchemaExtensionInput{
+		CreateSnapshotBeforeSchemaExtension: aws.Bool(d.Get("create_snapshot_before_schema_extension").(bool)),
+		Description:                         aws.String(d.Get(names.AttrDescription).(string)),
+		DirectoryId:                         aws.String(directoryID),
+		LdifContent:                         aws.String(d.Get("ldif_content").(string)),
+	}
+
+	output, err := conn.StartSchemaExtensionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Directory Service Directory (%s) schema extension: %s", directoryID, err)
+	}
+
+	schemaExtensionID := aws.StringValue(output.SchemaExtensionId)
+	d.SetId(SchemaExtensionCreateResourceID(directoryID, schemaExtensionID))
+
+	if _, err := waitSchemaExtensionCompleted(ctx, conn, directoryID, schemaExtensionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Directory Service Schema Extension (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSchemaExtensionRead(ctx, d, meta)...)
+}
+
+func resourceSchemaExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, schemaExtensionID, err := SchemaExtensionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Schema Extension (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("directory_id", output.DirectoryId)
+	d.Set("schema_extension_id", output.SchemaExtensionId)
+
+	return diags
+}
+
+func resourceSchemaExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Completed schema extensions cannot be reverted, so just remove the resource from state.
+	log.Printf("[WARN] Directory Service Schema Extension (%s) cannot be reverted, removing from state only", d.Id())
+
+	return diags
+}
+
+const schemaExtensionIDSeparator = "," // nosemgrep:ci.ds-in-const-name,ci.ds-in-var-name
+
+func SchemaExtensionCreateResourceID(directoryID, schemaExtensionID string) string {
+	parts := []string{directoryID, schemaExtensionID}
+	id := strings.Join(parts, schemaExtensionIDSeparator)
+
+	return id
+}
+
+func SchemaExtensionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, schemaExtensionIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DirectoryID%[2]sSchemaExtensionID", id, schemaExtensionIDSeparator)
+}
@@ -68,6 +68,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Region",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceSchemaExtension,
+			TypeName: "aws_directory_service_schema_extension",
+			Name:     "Schema Extension",
+		},
+		{
+			Factory:  ResourceSettings,
+			TypeName: "aws_directory_service_settings",
+			Name:     "Settings",
+		},
 		{
 			Factory:  ResourceSharedDirectory,
 			TypeName: "aws_directory_service_shared_directory",
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_directory_service_settings", name="Settings")
+func ResourceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSettingsCreate,
+		ReadWithoutTimeout:   resourceSettingsRead,
+		UpdateWithoutTimeout: resourceSettingsUpdate,
+		DeleteWithoutTimeout: resourceSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+
+	if err := updateSettings(ctx, conn, directoryID, d.Get("setting").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(directoryID)
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	output, err := FindSettings(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory (%s) settings not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) settings: %s", d.Id(), err)
+	}
+
+	// Only track the settings under management, or any non-default settings on import.
+	configured := make(map[string]bool)
+	for _, tfMapRaw := range d.Get("setting").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			configured[tfMap[names.AttrName].(string)] = true
+		}
+	}
+
+	var settings []*directoryservice.SettingEntry
+	for _, v := range output {
+		if name := aws.StringValue(v.Name); configured[name] || (len(configured) == 0 && aws.StringValue(v.RequestStatus) != directoryservice.DirectoryConfigurationStatusDefault) {
+			settings = append(settings, v)
+		}
+	}
+
+	d.Set("directory_id", d.Id())
+	if err := d.Set("setting", flattenSettingEntries(settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	if d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := updateSettings(ctx, conn, d.Id(), ns.Difference(os).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// There is no API to reset settings to their defaults, so just remove the resource from state.
+	log.Printf("[WARN] Directory Service Directory (%s) settings cannot be reset, removing from state only", d.Id())
+
+	return diags
+}
+
+func updateSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, tfList []interface{}, timeout time.Duration) error {
+	settings := expandSettings(tfList)
+
+	if len(settings) == 0 {
+		return nil
+	}
+
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	_, err := conn.UpdateSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating Directory Service Directory (%s) settings: %w", directoryID, err)
+	}
+
+	var settingNames []string
+	for _, v := range settings {
+		settingNames = append(settingNames, aws.StringValue(v.Name))
+	}
+
+	if _, err := waitSettingsUpdated(ctx, conn, directoryID, settingNames, timeout); err != nil {
+		return fmt.Errorf("waiting for Directory Service Directory (%s) settings update: %w", directoryID, err)
+	}
+
+	return nil
+}
+
+func expandSettings(tfList []interface{}) []*directoryservice.Setting {
+	var apiObjects []*directoryservice.Setting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &directoryservice.Setting{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSettingEntries(apiObjects []*directoryservice.SettingEntry) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:  aws.StringValue(apiObject.Name),
+			names.AttrValue: aws.StringValue(apiObject.AppliedValue),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*directoryservice.SettingEntry
+	resourceName := "aws_directory_service_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Disable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						names.AttrName:  "TLS_1_0",
+						names.AttrValue: "Disable",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Enable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						names.AttrName:  "TLS_1_0",
+						names.AttrValue: "Enable",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsExists(ctx context.Context, n string, v *[]*directoryservice.SettingEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		output, err := tfds.FindSettings(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccSettingsConfig_basic(rName, domain, tls10 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_settings" "test" {
+  directory_id = aws_directory_service_directory.test.id
+
+  setting {
+    name  = "TLS_1_0"
+    value = %[2]q
+  }
+}
+`, domain, tls10))
+}
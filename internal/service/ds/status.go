@@ -5,6 +5,7 @@ package ds
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -92,6 +93,54 @@ func statusRegion(ctx context.Context, conn *directoryservice.DirectoryService,
 	}
 }
 
+func statusSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SchemaExtensionStatus), nil
+	}
+}
+
+// statusSettings returns the aggregate request status of the named directory settings.
+func statusSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, settingNames []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSettings(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := directoryservice.DirectoryConfigurationStatusUpdated
+
+		for _, v := range output {
+			if !slices.Contains(settingNames, aws.StringValue(v.Name)) {
+				continue
+			}
+
+			switch s := aws.StringValue(v.RequestStatus); s {
+			case directoryservice.DirectoryConfigurationStatusFailed:
+				return output, s, nil
+			case directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating:
+				status = directoryservice.DirectoryConfigurationStatusUpdating
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
 func statusSharedDirectory(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindSharedDirectory(ctx, conn, ownerDirectoryID, sharedDirectoryID)
@@ -6,6 +6,8 @@ package ds
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -103,6 +105,58 @@ func waitRegionDeleted(ctx context.Context, conn *directoryservice.DirectoryServ
 	return nil, err
 }
 
+func waitSchemaExtensionCompleted(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusReplicating,
+		},
+		Target:     []string{directoryservice.SchemaExtensionStatusCompleted},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, settingNames []string, timeout time.Duration) ([]*directoryservice.SettingEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusUpdated},
+		Refresh: statusSettings(ctx, conn, directoryID, settingNames),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*directoryservice.SettingEntry); ok {
+		var errs []error
+
+		for _, v := range output {
+			if slices.Contains(settingNames, aws.StringValue(v.Name)) && aws.StringValue(v.RequestStatus) == directoryservice.DirectoryConfigurationStatusFailed {
+				errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Name), aws.StringValue(v.RequestStatusMessage)))
+			}
+		}
+
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string, timeout time.Duration) (*directoryservice.SharedDirectory, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eip_transfer", name="EIP Transfer")
+func newEIPTransferResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &eipTransferResource{}
+
+	return r, nil
+}
+
+type eipTransferResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[eipTransferResourceModel]
+}
+
+func (*eipTransferResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_eip_transfer"
+}
+
+func (r *eipTransferResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"address_transfer_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"allocation_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"public_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transfer_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"transfer_offer_accepted_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"transfer_offer_expiration_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *eipTransferResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eipTransferResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.EnableAddressTransferInput{
+		AllocationId:      fwflex.StringFromFramework(ctx, data.AllocationID),
+		TransferAccountId: fwflex.StringFromFramework(ctx, data.TransferAccountID),
+	}
+
+	output, err := conn.EnableAddressTransfer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("enabling EC2 EIP (%s) transfer", data.AllocationID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.AddressTransfer.AllocationId)
+	data.setFromAddressTransfer(ctx, output.AddressTransfer)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eipTransferResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eipTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPTransferByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// An offer that was not accepted before it expired can no longer be accepted.
+	// Remove it from state so that a new offer is made.
+	if output.AddressTransferStatus == awstypes.AddressTransferStatusPending {
+		if v := output.TransferOfferExpirationTimestamp; v != nil && time.Now().After(aws.ToTime(v)) {
+			response.Diagnostics.AddWarning(
+				"EC2 EIP Transfer offer expired",
+				fmt.Sprintf("The transfer offer for EC2 EIP (%s) expired at %s without being accepted. Removing from state.", data.ID.ValueString(), aws.ToTime(v).Format(time.RFC3339)),
+			)
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+	}
+
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+	data.TransferAccountID = fwflex.StringToFramework(ctx, output.TransferAccountId)
+	data.setFromAddressTransfer(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *eipTransferResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data eipTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPTransferByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// An accepted transfer cannot be undone.
+	if output.AddressTransferStatus != awstypes.AddressTransferStatusPending {
+		return
+	}
+
+	_, err = conn.DisableAddressTransfer(ctx, &ec2.DisableAddressTransferInput{
+		AllocationId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("disabling EC2 EIP (%s) transfer", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type eipTransferResourceModel struct {
+	AddressTransferStatus            types.String      `tfsdk:"address_transfer_status"`
+	AllocationID                     types.String      `tfsdk:"allocation_id"`
+	ID                               types.String      `tfsdk:"id"`
+	PublicIP                         types.String      `tfsdk:"public_ip"`
+	TransferAccountID                types.String      `tfsdk:"transfer_account_id"`
+	TransferOfferAcceptedTimestamp   timetypes.RFC3339 `tfsdk:"transfer_offer_accepted_timestamp"`
+	TransferOfferExpirationTimestamp timetypes.RFC3339 `tfsdk:"transfer_offer_expiration_timestamp"`
+}
+
+func (data *eipTransferResourceModel) setFromAddressTransfer(ctx context.Context, apiObject *awstypes.AddressTransfer) {
+	data.AddressTransferStatus = fwflex.StringValueToFramework(ctx, apiObject.AddressTransferStatus)
+	data.PublicIP = fwflex.StringToFramework(ctx, apiObject.PublicIp)
+	data.TransferOfferAcceptedTimestamp = timetypes.NewRFC3339TimePointerValue(apiObject.TransferOfferAcceptedTimestamp)
+	data.TransferOfferExpirationTimestamp = timetypes.NewRFC3339TimePointerValue(apiObject.TransferOfferExpirationTimestamp)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EIPTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", string(awstypes.AddressTransferStatusPending)),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_account_id", "data.aws_caller_identity.alternate", names.AttrAccountID),
+					resource.TestCheckNoResourceAttr(resourceName, "transfer_offer_accepted_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_offer_expiration_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransfer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEIPTransfer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransfer_accept(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_transfer.test"
+	accepterResourceName := "aws_eip_transfer_accepter.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	providers := make(map[string]*schema.Provider)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckEIPTransferAccepterReleaseAddress(ctx, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers)),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_accept(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(accepterResourceName, "address", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrSet(accepterResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(accepterResourceName, names.AttrID, accepterResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+				),
+				// The source account no longer owns the address.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEIPTransferExists(ctx context.Context, n string, v *awstypes.AddressTransfer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindEIPTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEIPTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_transfer" {
+				continue
+			}
+
+			_, err := tfec2.FindEIPTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 EIP Transfer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckEIPTransferAccepterReleaseAddress releases the transferred address,
+// which aws_eip_transfer_accepter leaves allocated to the accepting account on destroy.
+func testAccCheckEIPTransferAccepterReleaseAddress(ctx context.Context, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := providerF().Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_transfer_accepter" {
+				continue
+			}
+
+			_, err := conn.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
+				AllocationId: aws.String(rs.Primary.ID),
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccEIPTransferConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_eip_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.alternate.account_id
+}
+`, rName))
+}
+
+func testAccEIPTransferConfig_accept(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_basic(rName), `
+resource "aws_eip_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  address = aws_eip_transfer.test.public_ip
+}
+`)
+}
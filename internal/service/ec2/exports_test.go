@@ -28,6 +28,7 @@ var (
 	ResourceEIP                                      = resourceEIP
 	ResourceEIPAssociation                           = resourceEIPAssociation
 	ResourceEIPDomainName                            = newEIPDomainNameResource
+	ResourceEIPTransfer                              = newEIPTransferResource
 	ResourceFleet                                    = resourceFleet
 	ResourceHost                                     = resourceHost
 	ResourceIPAM                                     = resourceIPAM
@@ -78,6 +79,7 @@ var (
 	ResourceTransitGatewayPrefixListReference        = resourceTransitGatewayPrefixListReference
 	ResourceTransitGatewayRoute                      = resourceTransitGatewayRoute
 	ResourceTransitGatewayRouteTable                 = resourceTransitGatewayRouteTable
+	ResourceTransitGatewayRoutes                     = resourceTransitGatewayRoutes
 	ResourceTransitGatewayRouteTableAssociation      = resourceTransitGatewayRouteTableAssociation
 	ResourceTransitGatewayRouteTablePropagation      = resourceTransitGatewayRouteTablePropagation
 	ResourceTransitGatewayVPCAttachment              = resourceTransitGatewayVPCAttachment
@@ -107,6 +109,7 @@ var (
 	FindEIPByAllocationID                                      = findEIPByAllocationID
 	FindEIPByAssociationID                                     = findEIPByAssociationID
 	FindEIPDomainNameAttributeByAllocationID                   = findEIPDomainNameAttributeByAllocationID
+	FindEIPTransferByAllocationID                              = findEIPTransferByAllocationID
 	FindFastSnapshotRestoreByTwoPartKey                        = findFastSnapshotRestoreByTwoPartKey
 	FindFleetByID                                              = findFleetByID
 	FindHostByID                                               = findHostByID
@@ -163,6 +166,7 @@ var (
 	FindTransitGatewayRouteTableByID                           = findTransitGatewayRouteTableByID
 	FindTransitGatewayRouteTablePropagationByTwoPartKey        = findTransitGatewayRouteTablePropagationByTwoPartKey
 	FindTransitGatewayStaticRoute                              = findTransitGatewayStaticRoute
+	FindTransitGatewayStaticRoutes                             = findTransitGatewayStaticRoutes
 	FindTransitGatewayVPCAttachmentByID                        = findTransitGatewayVPCAttachmentByID
 	FindVPCEndpointConnectionByServiceIDAndVPCEndpointID       = findVPCEndpointConnectionByServiceIDAndVPCEndpointID
 	FindVPCEndpointConnectionNotificationByID                  = findVPCEndpointConnectionNotificationByID
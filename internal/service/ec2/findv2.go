@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -2456,6 +2457,32 @@ func findVPNConnectionRouteByTwoPartKey(ctx context.Context, conn *ec2.Client, v
 	}
 }
 
+func findVPNTunnelMaintenanceDetailsByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnConnectionID, outsideIPAddress string) (*awstypes.MaintenanceDetails, error) {
+	input := &ec2.GetVpnTunnelReplacementStatusInput{
+		VpnConnectionId:           aws.String(vpnConnectionID),
+		VpnTunnelOutsideIpAddress: aws.String(outsideIPAddress),
+	}
+
+	output, err := conn.GetVpnTunnelReplacementStatus(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPNConnectionIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MaintenanceDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.MaintenanceDetails, nil
+}
+
 func findVPNGatewayVPCAttachmentByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnGatewayID, vpcID string) (*awstypes.VpcAttachment, error) {
 	vpnGateway, err := findVPNGatewayByID(ctx, conn, vpnGatewayID)
 
@@ -3846,6 +3873,112 @@ func findTransitGatewayRoutes(ctx context.Context, conn *ec2.Client, input *ec2.
 	return output.Routes, err
 }
 
+func findTransitGatewayStaticRoutes(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string) ([]awstypes.TransitGatewayRoute, error) {
+	routes := make(map[string]awstypes.TransitGatewayRoute)
+
+	additionalRoutesAvailable, err := searchTransitGatewayStaticRoutes(ctx, conn, transitGatewayRouteTableID, nil, routes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// SearchTransitGatewayRoutes is not paginated.
+	// If not all routes were returned, partition the address space until each search is complete.
+	if additionalRoutesAvailable {
+		for _, cidrBlock := range []string{"0.0.0.0/0", "::/0"} {
+			if err := searchTransitGatewayStaticRoutesBySubnet(ctx, conn, transitGatewayRouteTableID, cidrBlock, routes); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return tfmaps.Values(routes), nil
+}
+
+func searchTransitGatewayStaticRoutesBySubnet(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID, cidrBlock string, routes map[string]awstypes.TransitGatewayRoute) error {
+	additionalRoutesAvailable, err := searchTransitGatewayStaticRoutes(ctx, conn, transitGatewayRouteTableID, map[string]string{
+		"route-search.subnet-of-match": cidrBlock,
+	}, routes)
+
+	if err != nil {
+		return err
+	}
+
+	if !additionalRoutesAvailable {
+		return nil
+	}
+
+	// Pick up any route to the CIDR block itself before searching each half.
+	if _, err := searchTransitGatewayStaticRoutes(ctx, conn, transitGatewayRouteTableID, map[string]string{
+		"route-search.exact-match": cidrBlock,
+	}, routes); err != nil {
+		return err
+	}
+
+	lower, upper, err := splitCIDRBlock(cidrBlock)
+
+	if err != nil {
+		return err
+	}
+
+	for _, cidrBlock := range []string{lower, upper} {
+		if err := searchTransitGatewayStaticRoutesBySubnet(ctx, conn, transitGatewayRouteTableID, cidrBlock, routes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// searchTransitGatewayStaticRoutes adds the static routes matching the specified filters to routes, keyed by destination CIDR block.
+// Routes to prefix lists have no destination CIDR block and are ignored.
+// Returns whether or not additional routes are available.
+func searchTransitGatewayStaticRoutes(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, filters map[string]string, routes map[string]awstypes.TransitGatewayRoute) (bool, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: newAttributeFilterListV2(map[string]string{
+			names.AttrType: string(awstypes.TransitGatewayRouteTypeStatic),
+		}),
+		MaxResults:                 aws.Int32(1000),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+	input.Filters = append(input.Filters, newAttributeFilterListV2(filters)...)
+
+	output, err := conn.SearchTransitGatewayRoutes(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+		return false, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if output == nil {
+		return false, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, route := range output.Routes {
+		switch route.State {
+		case awstypes.TransitGatewayRouteStateDeleted, awstypes.TransitGatewayRouteStateDeleting:
+			continue
+		}
+
+		destination := aws.ToString(route.DestinationCidrBlock)
+		if destination == "" {
+			continue
+		}
+
+		destination = types.CanonicalCIDRBlock(destination)
+		route.DestinationCidrBlock = aws.String(destination)
+		routes[destination] = route
+	}
+
+	return aws.ToBool(output.AdditionalRoutesAvailable), nil
+}
+
 func findTransitGatewayPolicyTable(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTransitGatewayPolicyTablesInput) (*awstypes.TransitGatewayPolicyTable, error) {
 	output, err := findTransitGatewayPolicyTables(ctx, conn, input)
 
@@ -4300,6 +4433,68 @@ func findEIPByAssociationID(ctx context.Context, conn *ec2.Client, id string) (*
 	return output, nil
 }
 
+func findEIPTransfers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) ([]awstypes.AddressTransfer, error) {
+	var output []awstypes.AddressTransfer
+
+	pages := ec2.NewDescribeAddressTransfersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AddressTransfers...)
+	}
+
+	return output, nil
+}
+
+func findEIPTransfer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) (*awstypes.AddressTransfer, error) {
+	output, err := findEIPTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findEIPTransferByAllocationID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: []string{id},
+	}
+
+	output, err := findEIPTransfer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.AddressTransferStatus; status == awstypes.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findEIPAttributes(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressesAttributeInput) ([]awstypes.AddressAttribute, error) {
 	var output []awstypes.AddressAttribute
 
@@ -39,6 +39,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newEIPDomainNameResource,
 			Name:    "EIP Domain Name",
 		},
+		{
+			Factory: newEIPTransferResource,
+			Name:    "EIP Transfer",
+		},
+		{
+			Factory: newEIPTransferAccepterResource,
+			Name:    "EIP Transfer Accepter",
+		},
 		{
 			Factory: newInstanceConnectEndpointResource,
 			Name:    "Instance Connect Endpoint",
@@ -880,6 +888,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_transit_gateway_route_table_propagation",
 			Name:     "Transit Gateway Route Table Propagation",
 		},
+		{
+			Factory:  resourceTransitGatewayRoutes,
+			TypeName: "aws_ec2_transit_gateway_routes",
+			Name:     "Transit Gateway Routes",
+		},
 		{
 			Factory:  resourceTransitGatewayVPCAttachment,
 			TypeName: "aws_ec2_transit_gateway_vpc_attachment",
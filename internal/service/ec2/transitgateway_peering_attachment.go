@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -38,6 +39,22 @@ func resourceTransitGatewayPeeringAttachment() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Schema: map[string]*schema.Schema{
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_routing": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DynamicRoutingValue](),
+						},
+					},
+				},
+			},
 			"peer_account_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -86,6 +103,10 @@ func resourceTransitGatewayPeeringAttachmentCreate(ctx context.Context, d *schem
 		TransitGatewayId:     aws.String(d.Get(names.AttrTransitGatewayID).(string)),
 	}
 
+	if v, ok := d.GetOk("options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Options = expandCreateTransitGatewayPeeringAttachmentRequestOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway Peering Attachment: %+v", input)
 	output, err := conn.CreateTransitGatewayPeeringAttachment(ctx, input)
 
@@ -118,6 +139,9 @@ func resourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schema.
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("options", flattenTransitGatewayPeeringAttachmentOptions(transitGatewayPeeringAttachment.Options)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting options: %s", err)
+	}
 	d.Set("peer_account_id", transitGatewayPeeringAttachment.AccepterTgwInfo.OwnerId)
 	d.Set("peer_region", transitGatewayPeeringAttachment.AccepterTgwInfo.Region)
 	d.Set("peer_transit_gateway_id", transitGatewayPeeringAttachment.AccepterTgwInfo.TransitGatewayId)
@@ -160,3 +184,29 @@ func resourceTransitGatewayPeeringAttachmentDelete(ctx context.Context, d *schem
 
 	return diags
 }
+
+func expandCreateTransitGatewayPeeringAttachmentRequestOptions(tfMap map[string]interface{}) *awstypes.CreateTransitGatewayPeeringAttachmentRequestOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CreateTransitGatewayPeeringAttachmentRequestOptions{}
+
+	if v, ok := tfMap["dynamic_routing"].(string); ok && v != "" {
+		apiObject.DynamicRouting = awstypes.DynamicRoutingValue(v)
+	}
+
+	return apiObject
+}
+
+func flattenTransitGatewayPeeringAttachmentOptions(apiObject *awstypes.TransitGatewayPeeringAttachmentOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dynamic_routing": apiObject.DynamicRouting,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// The resource is authoritative for the static CIDR block routes in a transit gateway route table
+// and must not be used alongside aws_ec2_transit_gateway_route resources for the same route table.
+// @SDKResource("aws_ec2_transit_gateway_routes", name="Transit Gateway Routes")
+func resourceTransitGatewayRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayRoutesCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayRoutesRead,
+		UpdateWithoutTimeout: resourceTransitGatewayRoutesUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayRoutesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blackhole_routes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"routes": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: verify.MapKeysAre(validation.ToDiagFunc(verify.ValidCIDRNetworkAddress)),
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+
+	routes, err := expandTransitGatewayRoutes(d.Get("routes").(map[string]interface{}), d.Get("blackhole_routes").(*schema.Set))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := updateTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, nil, routes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Routes (%s): %s", transitGatewayRouteTableID, err)
+	}
+
+	d.SetId(transitGatewayRouteTableID)
+
+	return append(diags, resourceTransitGatewayRoutesRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routes, err := findTransitGatewayStaticRoutes(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Routes %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Routes (%s): %s", d.Id(), err)
+	}
+
+	tfMap, tfList := flattenTransitGatewayRoutes(routes)
+
+	d.Set("blackhole_routes", tfList)
+	d.Set("routes", tfMap)
+	d.Set("transit_gateway_route_table_id", d.Id())
+
+	return diags
+}
+
+func resourceTransitGatewayRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChanges("blackhole_routes", "routes") {
+		oRoutes, nRoutes := d.GetChange("routes")
+		oBlackholes, nBlackholes := d.GetChange("blackhole_routes")
+
+		old, err := expandTransitGatewayRoutes(oRoutes.(map[string]interface{}), oBlackholes.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		new, err := expandTransitGatewayRoutes(nRoutes.(map[string]interface{}), nBlackholes.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := updateTransitGatewayRoutes(ctx, conn, d.Id(), old, new); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Routes (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTransitGatewayRoutesRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	routes, err := expandTransitGatewayRoutes(d.Get("routes").(map[string]interface{}), d.Get("blackhole_routes").(*schema.Set))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Routes: %s", d.Id())
+	if err := updateTransitGatewayRoutes(ctx, conn, d.Id(), routes, nil); err != nil {
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Routes (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateTransitGatewayRoutes reconciles the static routes in a transit gateway route table.
+// Routes are keyed by destination CIDR block; an empty value denotes a blackhole route.
+func updateTransitGatewayRoutes(ctx context.Context, conn *ec2.Client, transitGatewayRouteTableID string, old, new map[string]string) error {
+	for destination := range old {
+		if _, ok := new[destination]; ok {
+			continue
+		}
+
+		_, err := conn.DeleteTransitGatewayRoute(ctx, &ec2.DeleteTransitGatewayRouteInput{
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting route (%s): %w", destination, err)
+		}
+	}
+
+	for destination, attachmentID := range new {
+		oldAttachmentID, ok := old[destination]
+
+		if ok && oldAttachmentID == attachmentID {
+			continue
+		}
+
+		if ok {
+			input := &ec2.ReplaceTransitGatewayRouteInput{
+				Blackhole:                  aws.Bool(attachmentID == ""),
+				DestinationCidrBlock:       aws.String(destination),
+				TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+			}
+
+			if attachmentID != "" {
+				input.TransitGatewayAttachmentId = aws.String(attachmentID)
+			}
+
+			if _, err := conn.ReplaceTransitGatewayRoute(ctx, input); err != nil {
+				return fmt.Errorf("replacing route (%s): %w", destination, err)
+			}
+
+			continue
+		}
+
+		input := &ec2.CreateTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(attachmentID == ""),
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		if attachmentID != "" {
+			input.TransitGatewayAttachmentId = aws.String(attachmentID)
+		}
+
+		if _, err := conn.CreateTransitGatewayRoute(ctx, input); err != nil {
+			return fmt.Errorf("creating route (%s): %w", destination, err)
+		}
+	}
+
+	return nil
+}
+
+func expandTransitGatewayRoutes(routes map[string]interface{}, blackholes *schema.Set) (map[string]string, error) {
+	apiObject := make(map[string]string)
+
+	for k, v := range routes {
+		apiObject[types.CanonicalCIDRBlock(k)] = v.(string)
+	}
+
+	for _, v := range flex.ExpandStringValueSet(blackholes) {
+		destination := types.CanonicalCIDRBlock(v)
+
+		if _, ok := apiObject[destination]; ok {
+			return nil, fmt.Errorf("destination CIDR block (%s) is configured as both a route and a blackhole route", v)
+		}
+
+		apiObject[destination] = ""
+	}
+
+	return apiObject, nil
+}
+
+func flattenTransitGatewayRoutes(apiObjects []awstypes.TransitGatewayRoute) (map[string]interface{}, []interface{}) {
+	tfMap := make(map[string]interface{})
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		destination := aws.ToString(apiObject.DestinationCidrBlock)
+
+		if destination == "" {
+			continue
+		}
+
+		if len(apiObject.TransitGatewayAttachments) > 0 {
+			tfMap[destination] = aws.ToString(apiObject.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+		} else {
+			tfList = append(tfList, destination)
+		}
+	}
+
+	return tfMap, tfList
+}
+
+// splitCIDRBlock returns the two halves of the specified CIDR block.
+func splitCIDRBlock(cidrBlock string) (string, string, error) {
+	prefix, err := netip.ParsePrefix(cidrBlock)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	prefix = prefix.Masked()
+	bits := prefix.Bits()
+
+	if bits >= prefix.Addr().BitLen() {
+		return "", "", fmt.Errorf("CIDR block (%s) cannot be split", cidrBlock)
+	}
+
+	b := prefix.Addr().AsSlice()
+	b[bits/8] |= 0x80 >> (bits % 8)
+	upper, _ := netip.AddrFromSlice(b)
+
+	return netip.PrefixFrom(prefix.Addr(), bits+1).String(), netip.PrefixFrom(upper, bits+1).String(), nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+)
+
+func TestSplitCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		cidrBlock     string
+		expectedLower string
+		expectedUpper string
+		expectedErr   bool
+	}{
+		{
+			cidrBlock:     "0.0.0.0/0",
+			expectedLower: "0.0.0.0/1",
+			expectedUpper: "128.0.0.0/1",
+		},
+		{
+			cidrBlock:     "10.0.0.0/8",
+			expectedLower: "10.0.0.0/9",
+			expectedUpper: "10.128.0.0/9",
+		},
+		{
+			cidrBlock:     "10.1.2.0/23",
+			expectedLower: "10.1.2.0/24",
+			expectedUpper: "10.1.3.0/24",
+		},
+		{
+			cidrBlock:     "192.168.1.64/31",
+			expectedLower: "192.168.1.64/32",
+			expectedUpper: "192.168.1.65/32",
+		},
+		{
+			cidrBlock:     "::/0",
+			expectedLower: "::/1",
+			expectedUpper: "8000::/1",
+		},
+		{
+			cidrBlock:     "2001:db8::/32",
+			expectedLower: "2001:db8::/33",
+			expectedUpper: "2001:db8:8000::/33",
+		},
+		{
+			cidrBlock:   "10.0.0.1/32",
+			expectedErr: true,
+		},
+		{
+			cidrBlock:   "10.0.0.0",
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		lower, upper, err := splitCIDRBlock(testCase.cidrBlock)
+
+		if got, want := err != nil, testCase.expectedErr; got != want {
+			t.Errorf("splitCIDRBlock(%q) err %t, want %t", testCase.cidrBlock, got, want)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if got, want := lower, testCase.expectedLower; got != want {
+			t.Errorf("splitCIDRBlock(%q) lower = %q, want %q", testCase.cidrBlock, got, want)
+		}
+
+		if got, want := upper, testCase.expectedUpper; got != want {
+			t.Errorf("splitCIDRBlock(%q) upper = %q, want %q", testCase.cidrBlock, got, want)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayRoutes_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v []awstypes.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_routes.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRoutesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blackhole_routes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "blackhole_routes.*", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "routes.%", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "routes.0.0.0.0/0", transitGatewayVpcAttachmentResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayResourceName, "association_default_route_table_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRoutesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRoutesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blackhole_routes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "blackhole_routes.*", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "routes.%", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "routes.10.1.0.0/16", transitGatewayVpcAttachmentResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "routes.10.2.0.0/16", transitGatewayVpcAttachmentResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRoutes_outOfBandRoute(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v []awstypes.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_routes.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRoutesExists(ctx, resourceName, &v),
+					testAccCheckTransitGatewayRoutesCreateBlackholeRoute(ctx, resourceName, "10.3.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTransitGatewayRoutesConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRoutesExists(ctx, resourceName, &v),
+					testAccCheckTransitGatewayRoutesCount(&v, 2),
+					resource.TestCheckResourceAttr(resourceName, "blackhole_routes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "blackhole_routes.*", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "routes.%", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRoutesExists(ctx context.Context, n string, v *[]awstypes.TransitGatewayRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindTransitGatewayStaticRoutes(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRoutesCreateBlackholeRoute(ctx context.Context, n, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := conn.CreateTransitGatewayRoute(ctx, &ec2.CreateTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(true),
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckTransitGatewayRoutesCount(v *[]awstypes.TransitGatewayRoute, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(*v); got != expected {
+			return fmt.Errorf("EC2 Transit Gateway static route count is %d; want %d", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRoutesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_routes" {
+				continue
+			}
+
+			output, err := tfec2.FindTransitGatewayStaticRoutes(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EC2 Transit Gateway Routes %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayRoutesConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRoutesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRoutesConfig_base(rName), `
+resource "aws_ec2_transit_gateway_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+
+  routes = {
+    "0.0.0.0/0" = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  blackhole_routes = ["10.1.0.0/16"]
+}
+`)
+}
+
+func testAccTransitGatewayRoutesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRoutesConfig_base(rName), `
+resource "aws_ec2_transit_gateway_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+
+  routes = {
+    "10.1.0.0/16" = aws_ec2_transit_gateway_vpc_attachment.test.id
+    "10.2.0.0/16" = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  blackhole_routes = ["0.0.0.0/0"]
+}
+`)
+}
@@ -88,6 +88,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			acctest.CtDisappears: testAccTransitGatewayPeeringAttachment_disappears,
 			"tags":               testAccTransitGatewayPeeringAttachment_tags,
 			"DifferentAccount":   testAccTransitGatewayPeeringAttachment_differentAccount,
+			"options":            testAccTransitGatewayPeeringAttachment_options,
 		},
 		"PeeringAttachmentAccepter": {
 			acctest.CtBasic:    testAccTransitGatewayPeeringAttachmentAccepter_basic,
@@ -117,6 +118,10 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			acctest.CtDisappears:                 testAccTransitGatewayRoute_disappears,
 			"disappearsTransitGatewayAttachment": testAccTransitGatewayRoute_disappears_TransitGatewayAttachment,
 		},
+		"Routes": {
+			acctest.CtBasic:  testAccTransitGatewayRoutes_basic,
+			"outOfBandRoute": testAccTransitGatewayRoutes_outOfBandRoute,
+		},
 		"RouteTable": {
 			acctest.CtBasic:            testAccTransitGatewayRouteTable_basic,
 			acctest.CtDisappears:       testAccTransitGatewayRouteTable_disappears,
@@ -40,7 +40,7 @@ func ResourceManagedPrefixList() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ComputedIf(names.AttrVersion, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("entry")
+				return diff.HasChange(names.AttrEntry)
 			}),
 			verify.SetTagsDiff,
 		),
@@ -56,7 +56,7 @@ func ResourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"entry": {
+			names.AttrEntry: {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
@@ -113,7 +113,7 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypePrefixList),
 	}
 
-	if v, ok := d.GetOk("entry"); ok && v.(*schema.Set).Len() > 0 {
+	if v, ok := d.GetOk(names.AttrEntry); ok && v.(*schema.Set).Len() > 0 {
 		input.Entries = expandAddPrefixListEntries(v.(*schema.Set).List())
 	}
 
@@ -157,7 +157,7 @@ func resourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("address_family", pl.AddressFamily)
 	d.Set(names.AttrARN, pl.PrefixListArn)
-	if err := d.Set("entry", flattenPrefixListEntries(prefixListEntries)); err != nil {
+	if err := d.Set(names.AttrEntry, flattenPrefixListEntries(prefixListEntries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
 	}
 	d.Set("max_entries", pl.MaxEntries)
@@ -204,7 +204,7 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 		currentVersion := int64(d.Get(names.AttrVersion).(int))
 		wait := false
 
-		oldAttr, newAttr := d.GetChange("entry")
+		oldAttr, newAttr := d.GetChange(names.AttrEntry)
 		os := oldAttr.(*schema.Set)
 		ns := newAttr.(*schema.Set)
 
@@ -11,6 +11,7 @@ import (
 	"net"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -43,7 +44,13 @@ func resourceVPNConnection() *schema.Resource {
 		DeleteWithoutTimeout: resourceVPNConnectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_tunnel_replacement", false)
+				d.Set("tunnel1_apply_pending_maintenance", false)
+				d.Set("tunnel2_apply_pending_maintenance", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -125,6 +132,11 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"skip_tunnel_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"static_routes_only": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -157,6 +169,11 @@ func resourceVPNConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tunnel1_apply_pending_maintenance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tunnel1_bgp_asn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -217,6 +234,10 @@ func resourceVPNConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 			},
+			"tunnel1_last_maintenance_applied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel1_log_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -250,6 +271,14 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel1_maintenance_auto_applied_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel1_pending_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel1_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -373,6 +402,11 @@ func resourceVPNConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tunnel2_apply_pending_maintenance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tunnel2_bgp_asn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -433,6 +467,10 @@ func resourceVPNConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 			},
+			"tunnel2_last_maintenance_applied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel2_log_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -466,6 +504,14 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel2_maintenance_auto_applied_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel2_pending_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel2_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -632,6 +678,7 @@ func resourceVPNConnection() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffValidateOutsideIPAddressType,
+			customizeDiffApplyPendingMaintenance,
 			verify.SetTagsDiff,
 		),
 	}
@@ -846,6 +893,21 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("tunnel2_vgw_inside_address", nil)
 	}
 
+	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		// Maintenance details are only meaningful when tunnel endpoint lifecycle control is enabled.
+		if address := d.Get(prefix + names.AttrAddress).(string); address != "" && d.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			maintenanceDetails, err := findVPNTunnelMaintenanceDetailsByTwoPartKey(ctx, conn, d.Id(), address)
+
+			if err != nil && !tfresource.NotFound(err) {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) tunnel (%d) maintenance details: %s", d.Id(), i+1, err)
+			}
+
+			flattenVPNTunnelMaintenanceDetails(d, prefix, maintenanceDetails)
+		} else {
+			flattenVPNTunnelMaintenanceDetails(d, prefix, nil)
+		}
+	}
+
 	return diags
 }
 
@@ -916,6 +978,7 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
 		if options, address := expandModifyVPNTunnelOptionsSpecification(d, prefix), d.Get(prefix+names.AttrAddress).(string); options != nil && address != "" {
 			input := &ec2.ModifyVpnTunnelOptionsInput{
+				SkipTunnelReplacement:     aws.Bool(d.Get("skip_tunnel_replacement").(bool)),
 				TunnelOptions:             options,
 				VpnConnectionId:           aws.String(d.Id()),
 				VpnTunnelOutsideIpAddress: aws.String(address),
@@ -933,6 +996,36 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		if address := d.Get(prefix + names.AttrAddress).(string); address != "" && d.Get(prefix+"apply_pending_maintenance").(bool) && d.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			maintenanceDetails, err := findVPNTunnelMaintenanceDetailsByTwoPartKey(ctx, conn, d.Id(), address)
+
+			if err != nil && !tfresource.NotFound(err) {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) tunnel (%d) maintenance details: %s", d.Id(), i+1, err)
+			}
+
+			if maintenanceDetails == nil || !vpnTunnelHasPendingMaintenance(aws.ToString(maintenanceDetails.PendingMaintenance)) {
+				continue
+			}
+
+			input := &ec2.ReplaceVpnTunnelInput{
+				ApplyPendingMaintenance:   aws.Bool(true),
+				VpnConnectionId:           aws.String(d.Id()),
+				VpnTunnelOutsideIpAddress: aws.String(address),
+			}
+
+			_, err = conn.ReplaceVpnTunnel(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "replacing EC2 VPN Connection (%s) tunnel (%d): %s", d.Id(), i+1, err)
+			}
+
+			if _, err := waitVPNConnectionUpdated(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPN Connection (%s) tunnel (%d) replacement: %s", d.Id(), i+1, err)
+			}
+		}
+	}
+
 	return append(diags, resourceVPNConnectionRead(ctx, d, meta)...)
 }
 
@@ -1428,6 +1521,27 @@ func flattenTunnelOption(d *schema.ResourceData, prefix string, apiObject awstyp
 	return nil
 }
 
+func flattenVPNTunnelMaintenanceDetails(d *schema.ResourceData, prefix string, apiObject *awstypes.MaintenanceDetails) {
+	if apiObject == nil {
+		d.Set(prefix+"last_maintenance_applied", nil)
+		d.Set(prefix+"maintenance_auto_applied_after", nil)
+		d.Set(prefix+"pending_maintenance", nil)
+		return
+	}
+
+	if v := apiObject.LastMaintenanceApplied; v != nil {
+		d.Set(prefix+"last_maintenance_applied", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set(prefix+"last_maintenance_applied", nil)
+	}
+	if v := apiObject.MaintenanceAutoAppliedAfter; v != nil {
+		d.Set(prefix+"maintenance_auto_applied_after", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set(prefix+"maintenance_auto_applied_after", nil)
+	}
+	d.Set(prefix+"pending_maintenance", apiObject.PendingMaintenance)
+}
+
 func flattenVPNStaticRoute(apiObject awstypes.VpnStaticRoute) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -1683,3 +1797,29 @@ func customizeDiffValidateOutsideIPAddressType(_ context.Context, diff *schema.R
 	}
 	return fmt.Errorf("`transport_transit_gateway_attachment_id` must be provided if `outside_ip_address_type` is `PrivateIpv4`")
 }
+
+// vpnTunnelHasPendingMaintenance returns whether the specified GetVpnTunnelReplacementStatus pending maintenance value indicates an available update.
+func vpnTunnelHasPendingMaintenance(v string) bool {
+	return v != "" && !strings.EqualFold(v, "none")
+}
+
+// customizeDiffApplyPendingMaintenance plans an update for tunnels that opt in to applying pending maintenance.
+func customizeDiffApplyPendingMaintenance(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		if !diff.Get(prefix+"apply_pending_maintenance").(bool) || !diff.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			continue
+		}
+
+		if key := prefix + "pending_maintenance"; vpnTunnelHasPendingMaintenance(diff.Get(key).(string)) {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
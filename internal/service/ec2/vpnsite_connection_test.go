@@ -1341,6 +1341,49 @@ func TestAccSiteVPNConnection_enableAcceleration(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_tunnelMaintenance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn1, vpn2 awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelMaintenance(rName, rBgpAsn, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
+					resource.TestCheckResourceAttr(resourceName, "skip_tunnel_replacement", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_apply_pending_maintenance", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_enable_tunnel_lifecycle_control", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_apply_pending_maintenance", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_enable_tunnel_lifecycle_control", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_pending_maintenance", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_tunnel_replacement", "tunnel1_apply_pending_maintenance", "vgw_telemetry"},
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelMaintenance(rName, rBgpAsn, 45),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_dpd_timeout_seconds", "45"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_ipv6(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2177,6 +2220,39 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn)
 }
 
+func testAccSiteVPNConnectionConfig_tunnelMaintenance(rName string, rBgpAsn, dpdTimeoutSeconds int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  description = %[1]q
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id     = aws_customer_gateway.test.id
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  type                    = "ipsec.1"
+  skip_tunnel_replacement = true
+
+  tunnel1_apply_pending_maintenance       = true
+  tunnel1_dpd_timeout_seconds             = %[3]d
+  tunnel1_enable_tunnel_lifecycle_control = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, dpdTimeoutSeconds)
+}
+
 func testAccSiteVPNConnectionConfig_ipv6(rName string, rBgpAsn int, localIpv6NetworkCidr string, remoteIpv6NetworkCidr string, tunnel1InsideIpv6Cidr string, tunnel2InsideIpv6Cidr string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
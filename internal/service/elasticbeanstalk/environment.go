@@ -19,6 +19,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	sdktypes "github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -179,12 +181,66 @@ func ResourceEnvironment() *schema.Resource {
 				Elem:     settingSchema(),
 				Set:      optionSettingValueHash,
 			},
+			"shared_load_balancer": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"load_balancer_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rule_priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+					},
+				},
+			},
 			"solution_stack_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"platform_arn", "template_name"},
 			},
+			"spot_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 40,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_price": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"on_demand_above_base_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"on_demand_base_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_name": {
@@ -231,6 +287,14 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("shared_load_balancer"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OptionSettings = append(input.OptionSettings, expandSharedLoadBalancerOptionSettings(v.([]interface{})[0].(map[string]interface{}))...)
+	}
+
+	if v, ok := d.GetOk("spot_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OptionSettings = append(input.OptionSettings, expandSpotOptionSettings(v.([]interface{})[0].(map[string]interface{}), spotOptionsRawConfig(d))...)
+	}
+
 	if v := d.Get(names.AttrDescription); v.(string) != "" {
 		input.Description = aws.String(v.(string))
 	}
@@ -374,7 +438,13 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err := d.Set("queues", flattenQueues(resources.EnvironmentResources.Queues)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting queues: %s", err)
 	}
+	if err := d.Set("shared_load_balancer", flattenSharedLoadBalancerOptionSettings(configurationSettings.OptionSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting shared_load_balancer: %s", err)
+	}
 	d.Set("solution_stack_name", env.SolutionStackName)
+	if err := d.Set("spot_options", flattenSpotOptionSettings(configurationSettings.OptionSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting spot_options: %s", err)
+	}
 	d.Set("tier", env.Tier.Name)
 	if err := d.Set(names.AttrTriggers, flattenTriggers(resources.EnvironmentResources.Triggers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting triggers: %s", err)
@@ -539,6 +609,24 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.OptionSettings = add
 		}
 
+		if d.HasChange("shared_load_balancer") {
+			if v, ok := d.GetOk("shared_load_balancer"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OptionSettings = append(input.OptionSettings, expandSharedLoadBalancerOptionSettings(v.([]interface{})[0].(map[string]interface{}))...)
+			}
+		}
+
+		if d.HasChange("spot_options") {
+			if v, ok := d.GetOk("spot_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OptionSettings = append(input.OptionSettings, expandSpotOptionSettings(v.([]interface{})[0].(map[string]interface{}), spotOptionsRawConfig(d))...)
+			} else {
+				input.OptionSettings = append(input.OptionSettings, awstypes.ConfigurationOptionSetting{
+					Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+					OptionName: aws.String(optionSettingNameEnableSpot),
+					Value:      flex.BoolValueToString(false),
+				})
+			}
+		}
+
 		if d.HasChange("solution_stack_name") {
 			if v, ok := d.GetOk("solution_stack_name"); ok {
 				input.SolutionStackName = aws.String(v.(string))
@@ -701,6 +789,24 @@ func findEnvironmentErrorsByID(ctx context.Context, conn *elasticbeanstalk.Clien
 	return errors.Join(errs...)
 }
 
+// findEnvironmentHealthCausesByID returns the causes of an environment's current health status.
+// Causes are only available for environments with enhanced health reporting, so errors are ignored.
+func findEnvironmentHealthCausesByID(ctx context.Context, conn *elasticbeanstalk.Client, id string) []string {
+	input := &elasticbeanstalk.DescribeEnvironmentHealthInput{
+		AttributeNames: []awstypes.EnvironmentHealthAttribute{awstypes.EnvironmentHealthAttributeCauses},
+		EnvironmentId:  aws.String(id),
+	}
+
+	output, err := conn.DescribeEnvironmentHealth(ctx, input)
+
+	if err != nil {
+		log.Printf("[DEBUG] Reading Elastic Beanstalk Environment (%s) health: %s", id, err)
+		return nil
+	}
+
+	return output.Causes
+}
+
 func findConfigurationSettingsByTwoPartKey(ctx context.Context, conn *elasticbeanstalk.Client, applicationName, environmentName string) (*awstypes.ConfigurationSettingsDescription, error) {
 	input := &elasticbeanstalk.DescribeConfigurationSettingsInput{
 		ApplicationName: aws.String(applicationName),
@@ -754,6 +860,12 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.EnvironmentDescription); ok {
+		if err != nil {
+			if causes := findEnvironmentHealthCausesByID(ctx, conn, id); len(causes) > 0 {
+				tfresource.SetLastError(err, fmt.Errorf("health %s: %s", output.Health, strings.Join(causes, "; ")))
+			}
+		}
+
 		return output, err
 	}
 
@@ -838,6 +950,162 @@ func extractOptionSettings(s *schema.Set) []awstypes.ConfigurationOptionSetting
 	return settings
 }
 
+const (
+	optionSettingNamespaceEC2Instances             = "aws:ec2:instances"
+	optionSettingNamespaceELBV2DefaultListenerRule = "aws:elbv2:listenerrule:default"
+	optionSettingNamespaceELBV2LoadBalancer        = "aws:elbv2:loadbalancer"
+	optionSettingNamespaceEnvironment              = "aws:elasticbeanstalk:environment"
+
+	optionSettingNameEnableSpot                           = "EnableSpot"
+	optionSettingNameInstanceTypes                        = "InstanceTypes"
+	optionSettingNameLoadBalancerIsShared                 = "LoadBalancerIsShared"
+	optionSettingNameLoadBalancerType                     = "LoadBalancerType"
+	optionSettingNamePriority                             = "Priority"
+	optionSettingNameSharedLoadBalancer                   = "SharedLoadBalancer"
+	optionSettingNameSpotFleetOnDemandAboveBasePercentage = "SpotFleetOnDemandAboveBasePercentage"
+	optionSettingNameSpotFleetOnDemandBase                = "SpotFleetOnDemandBase"
+	optionSettingNameSpotMaxPrice                         = "SpotMaxPrice"
+)
+
+func expandSharedLoadBalancerOptionSettings(tfMap map[string]interface{}) []awstypes.ConfigurationOptionSetting {
+	apiObjects := []awstypes.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionSettingNamespaceEnvironment),
+			OptionName: aws.String(optionSettingNameLoadBalancerType),
+			Value:      aws.String("application"),
+		},
+		{
+			Namespace:  aws.String(optionSettingNamespaceEnvironment),
+			OptionName: aws.String(optionSettingNameLoadBalancerIsShared),
+			Value:      flex.BoolValueToString(true),
+		},
+		{
+			Namespace:  aws.String(optionSettingNamespaceELBV2LoadBalancer),
+			OptionName: aws.String(optionSettingNameSharedLoadBalancer),
+			Value:      aws.String(tfMap["load_balancer_arn"].(string)),
+		},
+	}
+
+	if v, ok := tfMap["rule_priority"].(int); ok && v != 0 {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceELBV2DefaultListenerRule),
+			OptionName: aws.String(optionSettingNamePriority),
+			Value:      flex.IntValueToString(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// spotOptionsRawConfig returns the configured spot_options block, or a null value.
+// It is used to distinguish on-demand capacity values explicitly set to 0 from unset values.
+func spotOptionsRawConfig(d *schema.ResourceData) cty.Value {
+	if v := d.GetRawConfig().GetAttr("spot_options"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return v.Index(cty.NumberIntVal(0))
+	}
+
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func expandSpotOptionSettings(tfMap map[string]interface{}, rawConfig cty.Value) []awstypes.ConfigurationOptionSetting {
+	configured := func(k string) bool {
+		return rawConfig.IsKnown() && !rawConfig.IsNull() && !rawConfig.GetAttr(k).IsNull()
+	}
+
+	apiObjects := []awstypes.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameEnableSpot),
+			Value:      flex.BoolValueToString(true),
+		},
+	}
+
+	if v, ok := tfMap["instance_types"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameInstanceTypes),
+			Value:      aws.String(strings.Join(flex.ExpandStringValueList(v), ",")),
+		})
+	}
+
+	if v, ok := tfMap["max_price"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameSpotMaxPrice),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["on_demand_above_base_percentage"].(int); ok && configured("on_demand_above_base_percentage") {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameSpotFleetOnDemandAboveBasePercentage),
+			Value:      flex.IntValueToString(v),
+		})
+	}
+
+	if v, ok := tfMap["on_demand_base_capacity"].(int); ok && configured("on_demand_base_capacity") {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceEC2Instances),
+			OptionName: aws.String(optionSettingNameSpotFleetOnDemandBase),
+			Value:      flex.IntValueToString(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// optionSettingValue returns the value of the specified option setting, or "" if it is not set.
+func optionSettingValue(apiObjects []awstypes.ConfigurationOptionSetting, namespace, optionName string) string {
+	for _, apiObject := range apiObjects {
+		if aws.ToString(apiObject.Namespace) == namespace && aws.ToString(apiObject.OptionName) == optionName {
+			return aws.ToString(apiObject.Value)
+		}
+	}
+
+	return ""
+}
+
+func flattenSharedLoadBalancerOptionSettings(apiObjects []awstypes.ConfigurationOptionSetting) []interface{} {
+	if !strings.EqualFold(optionSettingValue(apiObjects, optionSettingNamespaceEnvironment, optionSettingNameLoadBalancerIsShared), "true") {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"load_balancer_arn": optionSettingValue(apiObjects, optionSettingNamespaceELBV2LoadBalancer, optionSettingNameSharedLoadBalancer),
+	}
+
+	if v := optionSettingValue(apiObjects, optionSettingNamespaceELBV2DefaultListenerRule, optionSettingNamePriority); v != "" {
+		tfMap["rule_priority"] = flex.StringToIntValue(aws.String(v))
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSpotOptionSettings(apiObjects []awstypes.ConfigurationOptionSetting) []interface{} {
+	if !strings.EqualFold(optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameEnableSpot), "true") {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"max_price": optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameSpotMaxPrice),
+	}
+
+	if v := optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameInstanceTypes); v != "" {
+		tfMap["instance_types"] = strings.Split(v, ",")
+	}
+
+	if v := optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameSpotFleetOnDemandAboveBasePercentage); v != "" {
+		tfMap["on_demand_above_base_percentage"] = flex.StringToIntValue(aws.String(v))
+	}
+
+	if v := optionSettingValue(apiObjects, optionSettingNamespaceEC2Instances, optionSettingNameSpotFleetOnDemandBase); v != "" {
+		tfMap["on_demand_base_capacity"] = flex.StringToIntValue(aws.String(v))
+	}
+
+	return []interface{}{tfMap}
+}
+
 func dropGeneratedSecurityGroup(ctx context.Context, conn *ec2.EC2, settingValue string) string {
 	input := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: aws.StringSlice(strings.Split(settingValue, ",")),
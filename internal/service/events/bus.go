@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -40,12 +41,36 @@ func resourceBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dead_letter_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
 			"event_source_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validSourceName,
 			},
+			"kms_key_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -70,10 +95,22 @@ func resourceBusCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		Tags: getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("event_source_name"); ok {
 		input.EventSourceName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("kms_key_identifier"); ok {
+		input.KmsKeyIdentifier = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateEventBus(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -123,6 +160,15 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	d.Set(names.AttrARN, output.Arn)
+	if output.DeadLetterConfig != nil && output.DeadLetterConfig.Arn != nil {
+		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(output.DeadLetterConfig)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting dead_letter_config: %s", err)
+		}
+	} else {
+		d.Set("dead_letter_config", nil)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("kms_key_identifier", output.KmsKeyIdentifier)
 	d.Set(names.AttrName, output.Name)
 
 	return diags
@@ -130,8 +176,31 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta interface
 
 func resourceBusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	if d.HasChanges("dead_letter_config", names.AttrDescription, "kms_key_identifier") {
+		// UpdateEventBus replaces all settings, so unchanged values are sent too.
+		input := &eventbridge.UpdateEventBusInput{
+			// Removing the dead-letter queue requires an empty configuration.
+			DeadLetterConfig: &types.DeadLetterConfig{},
+			Description:      aws.String(d.Get(names.AttrDescription).(string)),
+			Name:             aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+		}
 
-	// Tags only.
+		if v, ok := d.GetOk("kms_key_identifier"); ok {
+			input.KmsKeyIdentifier = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateEventBus(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EventBridge Event Bus (%s): %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceBusRead(ctx, d, meta)...)
 }
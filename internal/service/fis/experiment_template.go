@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -129,6 +130,29 @@ func ResourceExperimentTemplate() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+			"experiment_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_targeting": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AccountTargeting](),
+						},
+						"empty_target_resolution_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.EmptyTargetResolutionMode](),
+						},
+					},
+				},
+			},
 			"log_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -289,13 +313,14 @@ func resourceExperimentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).FISClient(ctx)
 
 	input := &fis.CreateExperimentTemplateInput{
-		Actions:          expandExperimentTemplateActions(d.Get(names.AttrAction).(*schema.Set)),
-		ClientToken:      aws.String(id.UniqueId()),
-		Description:      aws.String(d.Get(names.AttrDescription).(string)),
-		LogConfiguration: expandExperimentTemplateLogConfiguration(d.Get("log_configuration").([]interface{})),
-		RoleArn:          aws.String(d.Get(names.AttrRoleARN).(string)),
-		StopConditions:   expandExperimentTemplateStopConditions(d.Get("stop_condition").(*schema.Set)),
-		Tags:             getTagsIn(ctx),
+		Actions:           expandExperimentTemplateActions(d.Get(names.AttrAction).(*schema.Set)),
+		ClientToken:       aws.String(id.UniqueId()),
+		Description:       aws.String(d.Get(names.AttrDescription).(string)),
+		ExperimentOptions: expandExperimentTemplateExperimentOptions(d.Get("experiment_options").([]interface{})),
+		LogConfiguration:  expandExperimentTemplateLogConfiguration(d.Get("log_configuration").([]interface{})),
+		RoleArn:           aws.String(d.Get(names.AttrRoleARN).(string)),
+		StopConditions:    expandExperimentTemplateStopConditions(d.Get("stop_condition").(*schema.Set)),
+		Tags:              getTagsIn(ctx),
 	}
 
 	targets, err := expandExperimentTemplateTargets(d.Get(names.AttrTarget).(*schema.Set))
@@ -352,6 +377,10 @@ func resourceExperimentTemplateRead(ctx context.Context, d *schema.ResourceData,
 		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), names.AttrAction, err)
 	}
 
+	if err := d.Set("experiment_options", flattenExperimentTemplateExperimentOptions(experimentTemplate.ExperimentOptions)); err != nil {
+		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), "experiment_options", err)
+	}
+
 	if err := d.Set("log_configuration", flattenExperimentTemplateLogConfiguration(experimentTemplate.LogConfiguration)); err != nil {
 		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), "log_configuration", err)
 	}
@@ -387,6 +416,10 @@ func resourceExperimentTemplateUpdate(ctx context.Context, d *schema.ResourceDat
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if d.HasChange("experiment_options") {
+			input.ExperimentOptions = expandExperimentTemplateExperimentOptionsForUpdate(d.Get("experiment_options").([]interface{}))
+		}
+
 		if d.HasChange("log_configuration") {
 			config := expandExperimentTemplateLogConfigurationForUpdate(d.Get("log_configuration").([]interface{}))
 			input.LogConfiguration = config
@@ -544,6 +577,42 @@ func expandExperimentTemplateStopConditions(l *schema.Set) []types.CreateExperim
 	return items
 }
 
+func expandExperimentTemplateExperimentOptions(l []interface{}) *types.CreateExperimentTemplateExperimentOptionsInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+
+	config := types.CreateExperimentTemplateExperimentOptionsInput{}
+
+	if v, ok := raw["account_targeting"].(string); ok && v != "" {
+		config.AccountTargeting = types.AccountTargeting(v)
+	}
+
+	if v, ok := raw["empty_target_resolution_mode"].(string); ok && v != "" {
+		config.EmptyTargetResolutionMode = types.EmptyTargetResolutionMode(v)
+	}
+
+	return &config
+}
+
+func expandExperimentTemplateExperimentOptionsForUpdate(l []interface{}) *types.UpdateExperimentTemplateExperimentOptionsInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+
+	config := types.UpdateExperimentTemplateExperimentOptionsInput{}
+
+	if v, ok := raw["empty_target_resolution_mode"].(string); ok && v != "" {
+		config.EmptyTargetResolutionMode = types.EmptyTargetResolutionMode(v)
+	}
+
+	return &config
+}
+
 func expandExperimentTemplateLogConfiguration(l []interface{}) *types.CreateExperimentTemplateLogConfigurationInput {
 	if len(l) == 0 {
 		return nil
@@ -874,6 +943,19 @@ func flattenExperimentTemplateTargets(configured map[string]types.ExperimentTemp
 	return dataResources
 }
 
+func flattenExperimentTemplateExperimentOptions(configured *types.ExperimentTemplateExperimentOptions) []map[string]interface{} {
+	if configured == nil {
+		return make([]map[string]interface{}, 0)
+	}
+
+	dataResources := make([]map[string]interface{}, 1)
+	dataResources[0] = make(map[string]interface{})
+	dataResources[0]["account_targeting"] = configured.AccountTargeting
+	dataResources[0]["empty_target_resolution_mode"] = configured.EmptyTargetResolutionMode
+
+	return dataResources
+}
+
 func flattenExperimentTemplateLogConfiguration(configured *types.ExperimentTemplateLogConfiguration) []map[string]interface{} {
 	if configured == nil {
 		return make([]map[string]interface{}, 0)
@@ -419,6 +419,45 @@ func TestAccFISExperimentTemplate_loggingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccFISExperimentTemplate_experimentOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_experimentOptions(rName, "single-account", "fail"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "fail"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateConfig_experimentOptions(rName, "single-account", "skip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "skip"),
+				),
+			},
+		},
+	})
+}
+
 func testAccExperimentTemplateExists(ctx context.Context, resourceName string, config *types.ExperimentTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -1067,3 +1106,65 @@ resource "aws_fis_experiment_template" "test" {
 }
 `, rName, desc, actionName, actionDesc, actionID, actionTargetK, actionTargetV, targetResType, targetSelectMode, targetResTagK, targetResTagV)
 }
+
+func testAccExperimentTemplateConfig_experimentOptions(rName, accountTargeting, emptyTargetResolutionMode string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "fis.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = "An experiment template for testing"
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "test-action-1"
+    action_id = "aws:ec2:terminate-instances"
+
+    target {
+      key   = "Instances"
+      value = "to-terminate-1"
+    }
+  }
+
+  target {
+    name           = "to-terminate-1"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "COUNT(1)"
+
+    resource_tag {
+      key   = "env"
+      value = "test"
+    }
+  }
+
+  experiment_options {
+    account_targeting            = %[2]q
+    empty_target_resolution_mode = %[3]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, accountTargeting, emptyTargetResolutionMode)
+}
@@ -31,6 +31,76 @@ import (
 // @SDKResource("aws_fms_policy", name="Policy")
 // @Tags(identifierAttribute="arn")
 func resourcePolicy() *schema.Resource {
+	networkACLEntrySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cidr_block": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+					},
+					"egress": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"icmp_type_code": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"code": {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+								names.AttrType: {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"ipv6_cidr_block": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+					},
+					"port_range": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"from": {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IsPortNumberOrZero,
+								},
+								"to": {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IsPortNumberOrZero,
+								},
+							},
+						},
+					},
+					names.AttrProtocol: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"rule_action": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.NetworkAclRuleAction](),
+					},
+				},
+			},
+		}
+	}
+
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyCreate,
 		ReadWithoutTimeout:   resourcePolicyRead,
@@ -126,6 +196,13 @@ func resourcePolicy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"resource_set_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			names.AttrResourceTags: tftags.TagsSchema(),
 			names.AttrResourceType: {
 				Type:          schema.TypeString,
@@ -167,6 +244,34 @@ func resourcePolicy() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"network_acl_common_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"network_acl_entry_set": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"first_entry": networkACLEntrySchema(),
+															"force_remediate_for_first_entries": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+															"force_remediate_for_last_entries": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+															"last_entry": networkACLEntrySchema(),
+														},
+													},
+												},
+											},
+										},
+									},
 									"network_firewall_policy": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -273,6 +378,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err := d.Set(names.AttrResourceTags, flattenResourceTags(policy.ResourceTags)); err != nil {
 		sdkdiag.AppendErrorf(diags, "setting resource_tags: %s", err)
 	}
+	d.Set("resource_set_ids", policy.ResourceSetIds)
 	d.Set(names.AttrResourceType, policy.ResourceType)
 	d.Set("resource_type_list", policy.ResourceTypeList)
 	securityServicePolicy := []map[string]interface{}{{
@@ -374,6 +480,7 @@ func expandPolicy(d *schema.ResourceData) *awstypes.Policy {
 		PolicyDescription:              aws.String(d.Get(names.AttrDescription).(string)),
 		PolicyName:                     aws.String(d.Get(names.AttrName).(string)),
 		RemediationEnabled:             d.Get("remediation_enabled").(bool),
+		ResourceSetIds:                 flex.ExpandStringValueSet(d.Get("resource_set_ids").(*schema.Set)),
 		ResourceType:                   resourceType,
 		ResourceTypeList:               flex.ExpandStringValueSet(d.Get("resource_type_list").(*schema.Set)),
 	}
@@ -412,6 +519,10 @@ func expandPolicyOption(tfMap map[string]interface{}) *awstypes.PolicyOption {
 
 	apiObject := &awstypes.PolicyOption{}
 
+	if v, ok := tfMap["network_acl_common_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkAclCommonPolicy = expandPolicyOptionNetworkACLCommon(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["network_firewall_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.NetworkFirewallPolicy = expandPolicyOptionNetworkFirewall(v[0].(map[string]interface{}))
 	}
@@ -423,6 +534,86 @@ func expandPolicyOption(tfMap map[string]interface{}) *awstypes.PolicyOption {
 	return apiObject
 }
 
+func expandPolicyOptionNetworkACLCommon(tfMap map[string]interface{}) *awstypes.NetworkAclCommonPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkAclCommonPolicy{}
+
+	if v, ok := tfMap["network_acl_entry_set"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkAclEntrySet = expandNetworkACLEntrySet(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandNetworkACLEntrySet(tfMap map[string]interface{}) *awstypes.NetworkAclEntrySet {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkAclEntrySet{
+		ForceRemediateForFirstEntries: aws.Bool(tfMap["force_remediate_for_first_entries"].(bool)),
+		ForceRemediateForLastEntries:  aws.Bool(tfMap["force_remediate_for_last_entries"].(bool)),
+	}
+
+	if v, ok := tfMap["first_entry"].([]interface{}); ok && len(v) > 0 {
+		apiObject.FirstEntries = expandNetworkACLEntries(v)
+	}
+
+	if v, ok := tfMap["last_entry"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LastEntries = expandNetworkACLEntries(v)
+	}
+
+	return apiObject
+}
+
+func expandNetworkACLEntries(tfList []interface{}) []awstypes.NetworkAclEntry {
+	var apiObjects []awstypes.NetworkAclEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.NetworkAclEntry{
+			Egress:     aws.Bool(tfMap["egress"].(bool)),
+			Protocol:   aws.String(tfMap[names.AttrProtocol].(string)),
+			RuleAction: awstypes.NetworkAclRuleAction(tfMap["rule_action"].(string)),
+		}
+
+		if v, ok := tfMap["cidr_block"].(string); ok && v != "" {
+			apiObject.CidrBlock = aws.String(v)
+		}
+
+		if v, ok := tfMap["icmp_type_code"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.IcmpTypeCode = &awstypes.NetworkAclIcmpTypeCode{
+				Code: aws.Int32(int32(tfMap["code"].(int))),
+				Type: aws.Int32(int32(tfMap[names.AttrType].(int))),
+			}
+		}
+
+		if v, ok := tfMap["ipv6_cidr_block"].(string); ok && v != "" {
+			apiObject.Ipv6CidrBlock = aws.String(v)
+		}
+
+		if v, ok := tfMap["port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.PortRange = &awstypes.NetworkAclPortRange{
+				From: aws.Int32(int32(tfMap["from"].(int))),
+				To:   aws.Int32(int32(tfMap["to"].(int))),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandPolicyOptionNetworkFirewall(tfMap map[string]interface{}) *awstypes.NetworkFirewallPolicy {
 	if tfMap == nil {
 		return nil
@@ -498,6 +689,10 @@ func flattenPolicyOption(fmsPolicyOption *awstypes.PolicyOption) []interface{} {
 
 	tfMap := map[string]interface{}{}
 
+	if v := fmsPolicyOption.NetworkAclCommonPolicy; v != nil {
+		tfMap["network_acl_common_policy"] = flattenPolicyOptionNetworkACLCommon(v)
+	}
+
 	if v := fmsPolicyOption.NetworkFirewallPolicy; v != nil {
 		tfMap["network_firewall_policy"] = flattenPolicyOptionNetworkFirewall(fmsPolicyOption.NetworkFirewallPolicy)
 	}
@@ -509,6 +704,61 @@ func flattenPolicyOption(fmsPolicyOption *awstypes.PolicyOption) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenPolicyOptionNetworkACLCommon(apiObject *awstypes.NetworkAclCommonPolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NetworkAclEntrySet; v != nil {
+		tfMap["network_acl_entry_set"] = []interface{}{map[string]interface{}{
+			"first_entry":                       flattenNetworkACLEntries(v.FirstEntries),
+			"force_remediate_for_first_entries": aws.ToBool(v.ForceRemediateForFirstEntries),
+			"force_remediate_for_last_entries":  aws.ToBool(v.ForceRemediateForLastEntries),
+			"last_entry":                        flattenNetworkACLEntries(v.LastEntries),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNetworkACLEntries(apiObjects []awstypes.NetworkAclEntry) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"cidr_block":       aws.ToString(apiObject.CidrBlock),
+			"egress":           aws.ToBool(apiObject.Egress),
+			"ipv6_cidr_block":  aws.ToString(apiObject.Ipv6CidrBlock),
+			names.AttrProtocol: aws.ToString(apiObject.Protocol),
+			"rule_action":      string(apiObject.RuleAction),
+		}
+
+		if v := apiObject.IcmpTypeCode; v != nil {
+			tfMap["icmp_type_code"] = []interface{}{map[string]interface{}{
+				"code":         aws.ToInt32(v.Code),
+				names.AttrType: aws.ToInt32(v.Type),
+			}}
+		}
+
+		if v := apiObject.PortRange; v != nil {
+			tfMap["port_range"] = []interface{}{map[string]interface{}{
+				"from": aws.ToInt32(v.From),
+				"to":   aws.ToInt32(v.To),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenPolicyOptionNetworkFirewall(fmsNetworkFirewallPolicy *awstypes.NetworkFirewallPolicy) []interface{} {
 	if fmsNetworkFirewallPolicy == nil {
 		return nil
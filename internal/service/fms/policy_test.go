@@ -365,6 +365,78 @@ func testAccPolicy_securityGroup(t *testing.T) {
 	})
 }
 
+func testAccPolicy_networkACLCommon(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_networkACLCommon(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "AWS::EC2::Subnet"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "NETWORK_ACL_COMMON"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.0.cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.0.port_range.0.from", "443"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.0.rule_action", "allow"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_first_entries", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
+func testAccPolicy_resourceSetIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_resourceSetIDs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_set_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_set_ids.*", "aws_fms_resource_set.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).FMSClient(ctx)
@@ -845,3 +917,96 @@ resource "aws_fms_policy" "test" {
 }
 `, rName))
 }
+
+func testAccPolicyConfig_networkACLCommon(rName string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                        = %[1]q
+  delete_all_policy_resources = false
+  exclude_resource_tags       = false
+  remediation_enabled         = false
+  resource_type               = "AWS::EC2::Subnet"
+
+  security_service_policy_data {
+    type = "NETWORK_ACL_COMMON"
+
+    managed_service_data = jsonencode({
+      type               = "NETWORK_ACL_COMMON"
+      networkAclEntrySet = {
+        firstEntries                  = [{
+          cidrBlock  = "10.0.0.0/8"
+          egress     = false
+          portRange  = { from = 443, to = 443 }
+          protocol   = "6"
+          ruleAction = "allow"
+        }]
+        forceRemediateForFirstEntries = false
+        lastEntries                   = []
+        forceRemediateForLastEntries  = false
+      }
+    })
+
+    policy_option {
+      network_acl_common_policy {
+        network_acl_entry_set {
+          force_remediate_for_first_entries = false
+          force_remediate_for_last_entries  = false
+
+          first_entry {
+            cidr_block  = "10.0.0.0/8"
+            egress      = false
+            protocol    = "6"
+            rule_action = "allow"
+
+            port_range {
+              from = 443
+              to   = 443
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
+
+func testAccPolicyConfig_resourceSetIDs(rName string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_fms_resource_set" "test" {
+  resource_set {
+    name               = %[1]q
+    resource_type_list = ["AWS::NetworkFirewall::Firewall"]
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  remediation_enabled   = false
+  resource_type         = "AWS::NetworkFirewall::Firewall"
+  resource_set_ids      = [aws_fms_resource_set.test.id]
+
+  security_service_policy_data {
+    type = "NETWORK_FIREWALL"
+
+    managed_service_data = jsonencode({
+      type                                           = "NETWORK_FIREWALL"
+      networkFirewallStatelessRuleGroupReferences    = []
+      networkFirewallStatelessDefaultActions         = ["aws:forward_to_sfe"]
+      networkFirewallStatelessFragmentDefaultActions = ["aws:drop"]
+      networkFirewallStatelessCustomActions          = []
+      networkFirewallStatefulRuleGroupReferences     = []
+      networkFirewallOrchestrationConfig             = {
+        singleFirewallEndpointPerVPC = false
+        allowedIPV4CidrList          = []
+      }
+    })
+  }
+}
+`, rName))
+}
@@ -122,3 +122,84 @@ func FindRoleAssociationsByRoleAndWorkspaceID(ctx context.Context, conn *managed
 
 	return output, nil
 }
+
+func FindWorkspaceServiceAccountByTwoPartKey(ctx context.Context, conn *managedgrafana.ManagedGrafana, workspaceID, serviceAccountID string) (*managedgrafana.ServiceAccountSummary, error) {
+	input := &managedgrafana.ListWorkspaceServiceAccountsInput{
+		WorkspaceId: aws.String(workspaceID),
+	}
+	var output *managedgrafana.ServiceAccountSummary
+
+	err := conn.ListWorkspaceServiceAccountsPagesWithContext(ctx, input, func(page *managedgrafana.ListWorkspaceServiceAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceAccounts {
+			if aws.StringValue(v.Id) == serviceAccountID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindWorkspaceServiceAccountTokenByThreePartKey(ctx context.Context, conn *managedgrafana.ManagedGrafana, workspaceID, serviceAccountID, tokenID string) (*managedgrafana.ServiceAccountTokenSummary, error) {
+	input := &managedgrafana.ListWorkspaceServiceAccountTokensInput{
+		ServiceAccountId: aws.String(serviceAccountID),
+		WorkspaceId:      aws.String(workspaceID),
+	}
+	var output *managedgrafana.ServiceAccountTokenSummary
+
+	err := conn.ListWorkspaceServiceAccountTokensPagesWithContext(ctx, input, func(page *managedgrafana.ListWorkspaceServiceAccountTokensOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceAccountTokens {
+			if aws.StringValue(v.Id) == tokenID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -53,6 +53,14 @@ func TestAccGrafana_serial(t *testing.T) {
 			"loginValidity": testAccWorkspaceSAMLConfiguration_loginValidity,
 			"assertions":    testAccWorkspaceSAMLConfiguration_assertions,
 		},
+		"ServiceAccount": {
+			acctest.CtBasic:      testAccWorkspaceServiceAccount_basic,
+			acctest.CtDisappears: testAccWorkspaceServiceAccount_disappears,
+		},
+		"ServiceAccountToken": {
+			acctest.CtBasic:      testAccWorkspaceServiceAccountToken_basic,
+			acctest.CtDisappears: testAccWorkspaceServiceAccountToken_disappears,
+		},
 		"RoleAssociation": {
 			"usersAdmin":           testAccRoleAssociation_usersAdmin,
 			"usersEditor":          testAccRoleAssociation_usersEditor,
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grafana
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	workspaceServiceAccountTokenResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_grafana_workspace_service_account_token", name="Workspace Service Account Token")
+func ResourceWorkspaceServiceAccountToken() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceServiceAccountTokenCreate,
+		ReadWithoutTimeout:   resourceWorkspaceServiceAccountTokenRead,
+		DeleteWithoutTimeout: resourceWorkspaceServiceAccountTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKey: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"seconds_to_live": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 2592000),
+			},
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_account_token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	serviceAccountID := d.Get("service_account_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	input := &managedgrafana.CreateWorkspaceServiceAccountTokenInput{
+		Name:             aws.String(name),
+		SecondsToLive:    aws.Int64(int64(d.Get("seconds_to_live").(int))),
+		ServiceAccountId: aws.String(serviceAccountID),
+		WorkspaceId:      aws.String(workspaceID),
+	}
+
+	output, err := conn.CreateWorkspaceServiceAccountTokenWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Grafana Workspace (%s) Service Account (%s) Token (%s): %s", workspaceID, serviceAccountID, name, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{workspaceID, serviceAccountID, aws.StringValue(output.ServiceAccountToken.Id)}, workspaceServiceAccountTokenResourceIDPartCount, false)))
+	// The token's key is only returned on creation.
+	d.Set(names.AttrKey, output.ServiceAccountToken.Key)
+
+	return append(diags, resourceWorkspaceServiceAccountTokenRead(ctx, d, meta)...)
+}
+
+func resourceWorkspaceServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workspaceServiceAccountTokenResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	workspaceID, serviceAccountID, tokenID := parts[0], parts[1], parts[2]
+
+	token, err := FindWorkspaceServiceAccountTokenByThreePartKey(ctx, conn, workspaceID, serviceAccountID, tokenID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace Service Account Token (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace Service Account Token (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCreatedAt, aws.TimeValue(token.CreatedAt).Format(time.RFC3339))
+	d.Set("expires_at", aws.TimeValue(token.ExpiresAt).Format(time.RFC3339))
+	d.Set(names.AttrName, token.Name)
+	d.Set("service_account_id", serviceAccountID)
+	d.Set("service_account_token_id", token.Id)
+	d.Set("workspace_id", workspaceID)
+
+	return diags
+}
+
+func resourceWorkspaceServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workspaceServiceAccountTokenResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	workspaceID, serviceAccountID, tokenID := parts[0], parts[1], parts[2]
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace Service Account Token: %s", d.Id())
+	_, err = conn.DeleteWorkspaceServiceAccountTokenWithContext(ctx, &managedgrafana.DeleteWorkspaceServiceAccountTokenInput{
+		ServiceAccountId: aws.String(serviceAccountID),
+		TokenId:          aws.String(tokenID),
+		WorkspaceId:      aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Grafana Workspace Service Account Token (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
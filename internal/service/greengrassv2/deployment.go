@@ -0,0 +1,866 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_deployment", name="Deployment")
+// @Tags(identifierAttribute="arn")
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"component_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"configuration_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"merge": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"reset": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"run_with": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"posix_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"system_resource_limits": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpus": {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatAtLeast(0),
+												},
+												"memory": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"windows_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"deployment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_update_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAction: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.DeploymentComponentUpdatePolicyAction](),
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"configuration_validation_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"failure_handling_policy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentFailureHandlingPolicy](),
+						},
+					},
+				},
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iot_job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iot_job_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"criteria": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAction: {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.IoTJobAbortAction](),
+												},
+												"failure_type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.IoTJobExecutionFailureType](),
+												},
+												"min_number_of_executed_things": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"threshold_percentage": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatBetween(0, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"job_executions_rollout_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exponential_rate": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"base_rate_per_minute": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 1000),
+												},
+												"increment_factor": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatBetween(1.1, 5),
+												},
+												"rate_increase_criteria": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"number_of_notified_things": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"number_of_succeeded_things": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"maximum_per_minute": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+						"timeout_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"in_progress_timeout_in_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"iot_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_latest_for_target": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"parent_target_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrTargetARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	input := &greengrassv2.CreateDeploymentInput{
+		Components: expandComponentDeploymentSpecifications(d.Get("component").(*schema.Set).List()),
+		Tags:       getTagsIn(ctx),
+		TargetArn:  aws.String(d.Get(names.AttrTargetARN).(string)),
+	}
+
+	if v, ok := d.GetOk("deployment_name"); ok {
+		input.DeploymentName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_policies"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeploymentPolicies = expandDeploymentPolicies(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("iot_job_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IotJobConfiguration = expandDeploymentIoTJobConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("parent_target_arn"); ok {
+		input.ParentTargetArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Deployment: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DeploymentId))
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	output, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "greengrass",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "deployments:" + d.Id(),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	if err := d.Set("component", flattenComponentDeploymentSpecifications(output.Components)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting component: %s", err)
+	}
+	d.Set("creation_timestamp", aws.ToTime(output.CreationTimestamp).Format(time.RFC3339))
+	d.Set("deployment_name", output.DeploymentName)
+	if output.DeploymentPolicies != nil {
+		if err := d.Set("deployment_policies", []interface{}{flattenDeploymentPolicies(output.DeploymentPolicies)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_policies: %s", err)
+		}
+	} else {
+		d.Set("deployment_policies", nil)
+	}
+	d.Set("deployment_status", output.DeploymentStatus)
+	d.Set("iot_job_arn", output.IotJobArn)
+	if v := output.IotJobConfiguration; v != nil && (v.AbortConfig != nil || v.JobExecutionsRolloutConfig != nil || v.TimeoutConfig != nil) {
+		if err := d.Set("iot_job_configuration", []interface{}{flattenDeploymentIoTJobConfiguration(output.IotJobConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting iot_job_configuration: %s", err)
+		}
+	} else {
+		d.Set("iot_job_configuration", nil)
+	}
+	d.Set("iot_job_id", output.IotJobId)
+	d.Set("is_latest_for_target", output.IsLatestForTarget)
+	d.Set("parent_target_arn", output.ParentTargetArn)
+	d.Set("revision_id", output.RevisionId)
+	d.Set(names.AttrTargetARN, output.TargetArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	output, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	// Active deployments must be canceled before they can be deleted.
+	if output.DeploymentStatus == awstypes.DeploymentStatusActive {
+		_, err := conn.CancelDeployment(ctx, &greengrassv2.CancelDeploymentInput{
+			DeploymentId: aws.String(d.Id()),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "canceling Greengrass V2 Deployment (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDeploymentCanceled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Deployment (%s) cancel: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Deployment: %s", d.Id())
+	_, err = conn.DeleteDeployment(ctx, &greengrassv2.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeploymentByID(ctx context.Context, conn *greengrassv2.Client, id string) (*greengrassv2.GetDeploymentOutput, error) {
+	input := &greengrassv2.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *greengrassv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DeploymentStatus), nil
+	}
+}
+
+func waitDeploymentCanceled(ctx context.Context, conn *greengrassv2.Client, id string, timeout time.Duration) (*greengrassv2.GetDeploymentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeploymentStatusActive),
+		Target:  enum.Slice(awstypes.DeploymentStatusCanceled, awstypes.DeploymentStatusCompleted, awstypes.DeploymentStatusFailed, awstypes.DeploymentStatusInactive),
+		Refresh: statusDeployment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.GetDeploymentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandComponentDeploymentSpecifications(tfList []interface{}) map[string]awstypes.ComponentDeploymentSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]awstypes.ComponentDeploymentSpecification)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ComponentDeploymentSpecification{
+			ComponentVersion: aws.String(tfMap["component_version"].(string)),
+		}
+
+		if v, ok := tfMap["configuration_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ConfigurationUpdate = expandComponentConfigurationUpdate(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["run_with"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RunWith = expandComponentRunWith(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["component_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandComponentConfigurationUpdate(tfMap map[string]interface{}) *awstypes.ComponentConfigurationUpdate {
+	apiObject := &awstypes.ComponentConfigurationUpdate{}
+
+	if v, ok := tfMap["merge"].(string); ok && v != "" {
+		apiObject.Merge = aws.String(v)
+	}
+
+	if v, ok := tfMap["reset"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Reset = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
+
+func expandComponentRunWith(tfMap map[string]interface{}) *awstypes.ComponentRunWith {
+	apiObject := &awstypes.ComponentRunWith{}
+
+	if v, ok := tfMap["posix_user"].(string); ok && v != "" {
+		apiObject.PosixUser = aws.String(v)
+	}
+
+	if v, ok := tfMap["system_resource_limits"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SystemResourceLimits = &awstypes.SystemResourceLimits{}
+
+		if v, ok := tfMap["cpus"].(float64); ok && v != 0 {
+			apiObject.SystemResourceLimits.Cpus = v
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.SystemResourceLimits.Memory = int64(v)
+		}
+	}
+
+	if v, ok := tfMap["windows_user"].(string); ok && v != "" {
+		apiObject.WindowsUser = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentPolicies(tfMap map[string]interface{}) *awstypes.DeploymentPolicies {
+	apiObject := &awstypes.DeploymentPolicies{}
+
+	if v, ok := tfMap["component_update_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ComponentUpdatePolicy = &awstypes.DeploymentComponentUpdatePolicy{}
+
+		if v, ok := tfMap[names.AttrAction].(string); ok && v != "" {
+			apiObject.ComponentUpdatePolicy.Action = awstypes.DeploymentComponentUpdatePolicyAction(v)
+		}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			apiObject.ComponentUpdatePolicy.TimeoutInSeconds = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["configuration_validation_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ConfigurationValidationPolicy = &awstypes.DeploymentConfigurationValidationPolicy{}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			apiObject.ConfigurationValidationPolicy.TimeoutInSeconds = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["failure_handling_policy"].(string); ok && v != "" {
+		apiObject.FailureHandlingPolicy = awstypes.DeploymentFailureHandlingPolicy(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentIoTJobConfiguration(tfMap map[string]interface{}) *awstypes.DeploymentIoTJobConfiguration {
+	apiObject := &awstypes.DeploymentIoTJobConfiguration{}
+
+	if v, ok := tfMap["abort_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AbortConfig = &awstypes.IoTJobAbortConfig{}
+
+		for _, tfMapRaw := range tfMap["criteria"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AbortConfig.CriteriaList = append(apiObject.AbortConfig.CriteriaList, awstypes.IoTJobAbortCriteria{
+				Action:                    awstypes.IoTJobAbortAction(tfMap[names.AttrAction].(string)),
+				FailureType:               awstypes.IoTJobExecutionFailureType(tfMap["failure_type"].(string)),
+				MinNumberOfExecutedThings: aws.Int32(int32(tfMap["min_number_of_executed_things"].(int))),
+				ThresholdPercentage:       tfMap["threshold_percentage"].(float64),
+			})
+		}
+	}
+
+	if v, ok := tfMap["job_executions_rollout_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.JobExecutionsRolloutConfig = &awstypes.IoTJobExecutionsRolloutConfig{}
+
+		if v, ok := tfMap["exponential_rate"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.JobExecutionsRolloutConfig.ExponentialRate = &awstypes.IoTJobExponentialRolloutRate{
+				BaseRatePerMinute: aws.Int32(int32(tfMap["base_rate_per_minute"].(int))),
+				IncrementFactor:   aws.Float64(tfMap["increment_factor"].(float64)),
+			}
+
+			if v, ok := tfMap["rate_increase_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				criteria := &awstypes.IoTJobRateIncreaseCriteria{}
+
+				if v, ok := tfMap["number_of_notified_things"].(int); ok && v != 0 {
+					criteria.NumberOfNotifiedThings = aws.Int32(int32(v))
+				}
+
+				if v, ok := tfMap["number_of_succeeded_things"].(int); ok && v != 0 {
+					criteria.NumberOfSucceededThings = aws.Int32(int32(v))
+				}
+
+				apiObject.JobExecutionsRolloutConfig.ExponentialRate.RateIncreaseCriteria = criteria
+			}
+		}
+
+		if v, ok := tfMap["maximum_per_minute"].(int); ok && v != 0 {
+			apiObject.JobExecutionsRolloutConfig.MaximumPerMinute = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["timeout_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TimeoutConfig = &awstypes.IoTJobTimeoutConfig{}
+
+		if v, ok := tfMap["in_progress_timeout_in_minutes"].(int); ok && v != 0 {
+			apiObject.TimeoutConfig.InProgressTimeoutInMinutes = aws.Int64(int64(v))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenComponentDeploymentSpecifications(apiObjects map[string]awstypes.ComponentDeploymentSpecification) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"component_name":    name,
+			"component_version": aws.ToString(apiObject.ComponentVersion),
+		}
+
+		if v := apiObject.ConfigurationUpdate; v != nil {
+			tfMap["configuration_update"] = []interface{}{map[string]interface{}{
+				"merge": aws.ToString(v.Merge),
+				"reset": v.Reset,
+			}}
+		}
+
+		if v := apiObject.RunWith; v != nil {
+			runWith := map[string]interface{}{
+				"posix_user":   aws.ToString(v.PosixUser),
+				"windows_user": aws.ToString(v.WindowsUser),
+			}
+
+			if v := v.SystemResourceLimits; v != nil {
+				runWith["system_resource_limits"] = []interface{}{map[string]interface{}{
+					"cpus":   v.Cpus,
+					"memory": v.Memory,
+				}}
+			}
+
+			tfMap["run_with"] = []interface{}{runWith}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDeploymentPolicies(apiObject *awstypes.DeploymentPolicies) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"failure_handling_policy": string(apiObject.FailureHandlingPolicy),
+	}
+
+	if v := apiObject.ComponentUpdatePolicy; v != nil {
+		tfMap["component_update_policy"] = []interface{}{map[string]interface{}{
+			names.AttrAction:     string(v.Action),
+			"timeout_in_seconds": aws.ToInt32(v.TimeoutInSeconds),
+		}}
+	}
+
+	if v := apiObject.ConfigurationValidationPolicy; v != nil {
+		tfMap["configuration_validation_policy"] = []interface{}{map[string]interface{}{
+			"timeout_in_seconds": aws.ToInt32(v.TimeoutInSeconds),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDeploymentIoTJobConfiguration(apiObject *awstypes.DeploymentIoTJobConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AbortConfig; v != nil {
+		var criteria []interface{}
+
+		for _, v := range v.CriteriaList {
+			criteria = append(criteria, map[string]interface{}{
+				names.AttrAction:                string(v.Action),
+				"failure_type":                  string(v.FailureType),
+				"min_number_of_executed_things": aws.ToInt32(v.MinNumberOfExecutedThings),
+				"threshold_percentage":          v.ThresholdPercentage,
+			})
+		}
+
+		tfMap["abort_config"] = []interface{}{map[string]interface{}{
+			"criteria": criteria,
+		}}
+	}
+
+	if v := apiObject.JobExecutionsRolloutConfig; v != nil {
+		rollout := map[string]interface{}{
+			"maximum_per_minute": aws.ToInt32(v.MaximumPerMinute),
+		}
+
+		if v := v.ExponentialRate; v != nil {
+			rate := map[string]interface{}{
+				"base_rate_per_minute": aws.ToInt32(v.BaseRatePerMinute),
+				"increment_factor":     aws.ToFloat64(v.IncrementFactor),
+			}
+
+			if v := v.RateIncreaseCriteria; v != nil {
+				rate["rate_increase_criteria"] = []interface{}{map[string]interface{}{
+					"number_of_notified_things":  aws.ToInt32(v.NumberOfNotifiedThings),
+					"number_of_succeeded_things": aws.ToInt32(v.NumberOfSucceededThings),
+				}}
+			}
+
+			rollout["exponential_rate"] = []interface{}{rate}
+		}
+
+		tfMap["job_executions_rollout_config"] = []interface{}{rollout}
+	}
+
+	if v := apiObject.TimeoutConfig; v != nil {
+		tfMap["timeout_config"] = []interface{}{map[string]interface{}{
+			"in_progress_timeout_in_minutes": aws.ToInt64(v.InProgressTimeoutInMinutes),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+// Exports for use in tests only.
+var (
+	FindComponentVersionByARN = findComponentVersionByARN
+	FindDeploymentByID        = findDeploymentByID
+
+	ResourceComponentVersion = resourceComponentVersion
+	ResourceDeployment       = resourceDeployment
+)
@@ -10,6 +10,7 @@ import (
 	"log"
 	"net/url"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -216,6 +217,18 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		output, err = retryCreateRole(ctx, conn, input)
 	}
 
+	if errs.IsA[*awstypes.EntityAlreadyExistsException](err) && meta.(*conns.AWSClient).AdoptExistingResources(ctx) {
+		if err := adoptRole(ctx, conn, d, meta.(*conns.AWSClient).IgnoreTagsConfig, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adopting IAM Role (%s): %s", name, err)
+		}
+
+		d.SetId(name)
+
+		diags = create.AppendDiagAdoptedWarning(diags, names.IAM, "Role", name)
+
+		return append(diags, resourceRoleRead(ctx, d, meta)...)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating IAM Role (%s): %s", name, err)
 	}
@@ -582,6 +595,111 @@ func deleteRoleInstanceProfiles(ctx context.Context, conn *iam.Client, roleName
 	return errors.Join(errsList...)
 }
 
+// adoptRole updates an existing role to match the configuration.
+// A role's path cannot be changed, so any difference is shown in the next plan.
+func adoptRole(ctx context.Context, conn *iam.Client, d *schema.ResourceData, ignoreTagsConfig *tftags.IgnoreConfig, input *iam.CreateRoleInput) error {
+	roleName := aws.ToString(input.RoleName)
+
+	role, err := findRoleByName(ctx, conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading role: %w", err)
+	}
+
+	if _, err := conn.UpdateAssumeRolePolicy(ctx, &iam.UpdateAssumeRolePolicyInput{
+		PolicyDocument: input.AssumeRolePolicyDocument,
+		RoleName:       aws.String(roleName),
+	}); err != nil {
+		return fmt.Errorf("updating assume role policy: %w", err)
+	}
+
+	if _, err := conn.UpdateRole(ctx, &iam.UpdateRoleInput{
+		Description:        aws.String(d.Get(names.AttrDescription).(string)),
+		MaxSessionDuration: aws.Int32(int32(d.Get("max_session_duration").(int))),
+		RoleName:           aws.String(roleName),
+	}); err != nil {
+		return fmt.Errorf("updating role: %w", err)
+	}
+
+	switch {
+	case input.PermissionsBoundary != nil:
+		if _, err := conn.PutRolePermissionsBoundary(ctx, &iam.PutRolePermissionsBoundaryInput{
+			PermissionsBoundary: input.PermissionsBoundary,
+			RoleName:            aws.String(roleName),
+		}); err != nil {
+			return fmt.Errorf("updating permissions boundary: %w", err)
+		}
+	case role.PermissionsBoundary != nil:
+		if _, err := conn.DeleteRolePermissionsBoundary(ctx, &iam.DeleteRolePermissionsBoundaryInput{
+			RoleName: aws.String(roleName),
+		}); err != nil {
+			return fmt.Errorf("deleting permissions boundary: %w", err)
+		}
+	}
+
+	// Inline and managed policies are only reconciled if configured.
+	if v := d.GetRawConfig().GetAttr("inline_policy"); v.IsKnown() && !v.IsNull() {
+		policies := expandRoleInlinePolicies(roleName, d.Get("inline_policy").(*schema.Set).List())
+
+		policyNames, err := findRolePolicyNames(ctx, conn, roleName)
+
+		if err != nil {
+			return fmt.Errorf("reading inline policies: %w", err)
+		}
+
+		policyNames = slices.DeleteFunc(policyNames, func(policyName string) bool {
+			return slices.ContainsFunc(policies, func(policy *iam.PutRolePolicyInput) bool {
+				return aws.ToString(policy.PolicyName) == policyName
+			})
+		})
+
+		if err := deleteRoleInlinePolicies(ctx, conn, roleName, policyNames); err != nil {
+			return err
+		}
+
+		if err := addRoleInlinePolicies(ctx, conn, policies); err != nil {
+			return err
+		}
+	}
+
+	if v := d.GetRawConfig().GetAttr("managed_policy_arns"); v.IsKnown() && !v.IsNull() {
+		policyARNs := flex.ExpandStringValueSet(d.Get("managed_policy_arns").(*schema.Set))
+
+		attachedPolicyARNs, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+		if err != nil {
+			return fmt.Errorf("reading attached policies: %w", err)
+		}
+
+		var add, del []string
+		for _, v := range policyARNs {
+			if !slices.Contains(attachedPolicyARNs, v) {
+				add = append(add, v)
+			}
+		}
+		for _, v := range attachedPolicyARNs {
+			if !slices.Contains(policyARNs, v) {
+				del = append(del, v)
+			}
+		}
+
+		if err := deleteRolePolicyAttachments(ctx, conn, roleName, del); err != nil {
+			return err
+		}
+
+		if err := addRoleManagedPolicies(ctx, conn, roleName, aws.StringSlice(add)); err != nil {
+			return err
+		}
+	}
+
+	oldTags := KeyValueTags(ctx, role.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	if err := roleUpdateTags(ctx, conn, roleName, oldTags, KeyValueTags(ctx, getTagsIn(ctx))); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func retryCreateRole(ctx context.Context, conn *iam.Client, input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
 	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
@@ -1025,6 +1025,42 @@ func TestAccIAMRole_ManagedPolicy_outOfBandAdditionRemovedEmpty(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_adoptExisting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+					if err := testAccCreateRoleOutOfBand(ctx, conn, rName, policyName2); err != nil {
+						t.Fatalf("creating IAM Role (%s): %s", rName, err)
+					}
+				},
+				Config: testAccRoleConfig_adoptExisting(rName, policyName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.0.name", policyName1),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "3600"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRoleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -1236,6 +1272,38 @@ func testAccCheckRolePolicyRemoveInlinePolicy(ctx context.Context, role *awstype
 	}
 }
 
+func testAccCreateRoleOutOfBand(ctx context.Context, conn *iam.Client, roleName, policyName string) error {
+	_, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Principal": {"Service": "ec2.%[1]s"},
+    "Effect": "Allow"
+  }]
+}`, acctest.PartitionDNSSuffix())),
+		Description:        aws.String("created out of band"),
+		MaxSessionDuration: aws.Int32(7200),
+		RoleName:           aws.String(roleName),
+		Tags: []awstypes.Tag{{
+			Key:   aws.String("OutOfBand"),
+			Value: aws.String(acctest.CtTrue),
+		}},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		PolicyDocument: aws.String(testAccRolePolicyExtraInlineConfig()),
+		PolicyName:     aws.String(policyName),
+		RoleName:       aws.String(roleName),
+	})
+
+	return err
+}
+
 func testAccRoleConfig_maxSessionDuration(rName string, maxSessionDuration int) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -2370,3 +2438,11 @@ EOF
 }
 `, roleName, policyName)
 }
+
+func testAccRoleConfig_adoptExisting(roleName, policyName string) string {
+	return acctest.ConfigCompose(`
+provider "aws" {
+  adopt_existing_resources = true
+}
+`, testAccRoleConfig_policyInline(roleName, policyName))
+}
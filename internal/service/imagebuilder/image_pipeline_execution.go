@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_imagebuilder_image_pipeline_execution", name="Image Pipeline Execution")
+func ResourceImagePipelineExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImagePipelineExecutionCreate,
+		ReadWithoutTimeout:   resourceImagePipelineExecutionRead,
+		DeleteWithoutTimeout: resourceImagePipelineExecutionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_build_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_pipeline_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^arn:aws[^:]*:imagebuilder:[^:]+:(?:\d{12}|aws):image-pipeline/[0-9a-z_-]+$`), "valid image pipeline ARN must be provided"),
+			},
+			"output_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amis": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAccountID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"containers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image_uris": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceImagePipelineExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	imagePipelineARN := d.Get("image_pipeline_arn").(string)
+	input := &imagebuilder.StartImagePipelineExecutionInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		ImagePipelineArn: aws.String(imagePipelineARN),
+	}
+
+	output, err := conn.StartImagePipelineExecutionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Image Builder Image Pipeline (%s) execution: %s", imagePipelineARN, err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "starting Image Builder Image Pipeline (%s) execution: empty response", imagePipelineARN)
+	}
+
+	d.SetId(aws.StringValue(output.ImageBuildVersionArn))
+
+	if _, err := waitImageStatusAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Image Builder Image Pipeline execution (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceImagePipelineExecutionRead(ctx, d, meta)...)
+}
+
+func resourceImagePipelineExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetImageWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Image Pipeline execution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Image Pipeline execution (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Image == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Image Pipeline execution (%s): empty response", d.Id())
+	}
+
+	image := output.Image
+
+	d.Set("image_build_version_arn", image.Arn)
+	d.Set("image_pipeline_arn", image.SourcePipelineArn)
+	if image.OutputResources != nil {
+		d.Set("output_resources", []interface{}{flattenOutputResources(image.OutputResources)})
+	} else {
+		d.Set("output_resources", nil)
+	}
+	if image.State != nil {
+		d.Set(names.AttrStatus, image.State.Status)
+	} else {
+		d.Set(names.AttrStatus, nil)
+	}
+
+	return diags
+}
+
+func resourceImagePipelineExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	// Deleting the Image Builder image does not remove the distributed AMIs or container images.
+	input := &imagebuilder.DeleteImageInput{
+		ImageBuildVersionArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteImageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Image Builder Image Pipeline execution (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccImageBuilderImagePipelineExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imagePipelineResourceName := "aws_imagebuilder_image_pipeline.test"
+	resourceName := "aws_imagebuilder_image_pipeline_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImagePipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineExecutionConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "image_build_version_arn", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "image_pipeline_arn", imagePipelineResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.0.amis.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, imagebuilder.ImageStatusAvailable),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTriggers},
+			},
+		},
+	})
+}
+
+func testAccImagePipelineExecutionConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(
+		testAccImageBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+}
+
+resource "aws_imagebuilder_image_pipeline_execution" "test" {
+  image_pipeline_arn = aws_imagebuilder_image_pipeline.test.arn
+
+  triggers = {
+    build = %[2]q
+  }
+}
+`, rName, trigger))
+}
@@ -127,6 +127,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceImagePipelineExecution,
+			TypeName: "aws_imagebuilder_image_pipeline_execution",
+			Name:     "Image Pipeline Execution",
+		},
 		{
 			Factory:  ResourceImageRecipe,
 			TypeName: "aws_imagebuilder_image_recipe",
@@ -143,6 +148,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceLifecyclePolicy,
+			TypeName: "aws_imagebuilder_lifecycle_policy",
+			Name:     "Lifecycle Policy",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  ResourceWorkflow,
 			TypeName: "aws_imagebuilder_workflow",
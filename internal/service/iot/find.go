@@ -5,6 +5,7 @@ package iot
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -88,6 +89,24 @@ func FindThingGroupByName(ctx context.Context, conn *iot.IoT, name string) (*iot
 	return output, nil
 }
 
+// FindBillingGroupMembership returns a NotFoundError unless the thing belongs to the billing group.
+// A thing can belong to at most one billing group.
+func FindBillingGroupMembership(ctx context.Context, conn *iot.IoT, billingGroupName, thingName string) error {
+	output, err := FindThingByName(ctx, conn, thingName)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(output.BillingGroupName) != billingGroupName {
+		return &retry.NotFoundError{
+			Message: fmt.Sprintf("IoT Thing (%s) is not in IoT Billing Group (%s)", thingName, billingGroupName),
+		}
+	}
+
+	return nil
+}
+
 func FindThingGroupMembership(ctx context.Context, conn *iot.IoT, thingGroupName, thingName string) error {
 	input := &iot.ListThingGroupsForThingInput{
 		ThingName: aws.String(thingName),
@@ -119,6 +119,25 @@ func ResourceIndexingConfiguration() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"geo_location": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"order": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      iot.TargetFieldOrderLatLon,
+													ValidateFunc: validation.StringInSlice(iot.TargetFieldOrder_Values(), false),
+												},
+											},
+										},
+									},
 									"named_shadow_names": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -299,6 +318,10 @@ func flattenIndexingFilter(apiObject *iot.IndexingFilter) map[string]interface{}
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.GeoLocations; v != nil {
+		tfMap["geo_location"] = flattenGeoLocationTargets(v)
+	}
+
 	if v := apiObject.NamedShadowNames; v != nil {
 		tfMap["named_shadow_names"] = aws.StringValueSlice(v)
 	}
@@ -306,6 +329,27 @@ func flattenIndexingFilter(apiObject *iot.IndexingFilter) map[string]interface{}
 	return tfMap
 }
 
+func flattenGeoLocationTargets(apiObjects []*iot.GeoLocationTarget) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.StringValue(apiObject.Name),
+			"order":        aws.StringValue(apiObject.Order),
+		})
+	}
+
+	return tfList
+}
+
 func flattenField(apiObject *iot.Field) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -409,6 +453,10 @@ func expandIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
 
 	apiObject := &iot.IndexingFilter{}
 
+	if v, ok := tfMap["geo_location"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GeoLocations = expandGeoLocationTargets(v.List())
+	}
+
 	if v, ok := tfMap["named_shadow_names"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.NamedShadowNames = flex.ExpandStringSet(v)
 	}
@@ -416,6 +464,35 @@ func expandIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
 	return apiObject
 }
 
+func expandGeoLocationTargets(tfList []interface{}) []*iot.GeoLocationTarget {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iot.GeoLocationTarget
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iot.GeoLocationTarget{}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["order"].(string); ok && v != "" {
+			apiObject.Order = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandField(tfMap map[string]interface{}) *iot.Field {
 	if tfMap == nil {
 		return nil
@@ -96,6 +96,11 @@ func testAccIndexingConfiguration_allAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.#", acctest.Ct2),
 					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "thing1shadow"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "$package"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.geo_location.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.filter.0.geo_location.*", map[string]string{
+						names.AttrName: "shadow.name.thing1shadow.reported.location",
+						"order":        "LonLat",
+					}),
 				),
 			},
 			{
@@ -133,6 +138,11 @@ resource "aws_iot_indexing_configuration" "test" {
 
     filter {
       named_shadow_names = ["thing1shadow", "$package"]
+
+      geo_location {
+        name  = "shadow.name.thing1shadow.reported.location"
+        order = "LonLat"
+      }
     }
 
     custom_field {
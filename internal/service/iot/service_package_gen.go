@@ -53,6 +53,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceBillingGroupMembership,
+			TypeName: "aws_iot_billing_group_membership",
+			Name:     "Billing Group Membership",
+		},
 		{
 			Factory:  ResourceCACertificate,
 			TypeName: "aws_iot_ca_certificate",
@@ -113,6 +118,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceSoftwarePackage,
+			TypeName: "aws_iot_software_package",
+			Name:     "Software Package",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceSoftwarePackageVersion,
+			TypeName: "aws_iot_software_package_version",
+			Name:     "Software Package Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceThing,
 			TypeName: "aws_iot_thing",
@@ -125,6 +146,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceThingGroupDynamic,
+			TypeName: "aws_iot_thing_group_dynamic",
+			Name:     "Dynamic Thing Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceThingGroupMembership,
 			TypeName: "aws_iot_thing_group_membership",
@@ -133,6 +162,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceThingPrincipalAttachment,
 			TypeName: "aws_iot_thing_principal_attachment",
 		},
+		{
+			Factory:  ResourceThingRegistrationTask,
+			TypeName: "aws_iot_thing_registration_task",
+			Name:     "Thing Registration Task",
+		},
 		{
 			Factory:  ResourceThingType,
 			TypeName: "aws_iot_thing_type",
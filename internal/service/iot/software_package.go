@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_software_package", name="Software Package")
+// @Tags(identifierAttribute="arn")
+func ResourceSoftwarePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSoftwarePackageCreate,
+		ReadWithoutTimeout:   resourceSoftwarePackageRead,
+		UpdateWithoutTimeout: resourceSoftwarePackageUpdate,
+		DeleteWithoutTimeout: resourceSoftwarePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSoftwarePackageName,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSoftwarePackageName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var validateSoftwarePackageName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, underscores, periods, and hyphens"),
+)
+
+func resourceSoftwarePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get("package_name").(string)
+	input := &iot.CreatePackageInput{
+		PackageName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)).Map(); len(tags) > 0 {
+		input.Tags = aws.StringMap(tags)
+	}
+
+	output, err := conn.CreatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Software Package (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PackageName))
+
+	// CreatePackage does not accept a default version.
+	if v, ok := d.GetOk("default_version_name"); ok {
+		_, err := conn.UpdatePackageWithContext(ctx, &iot.UpdatePackageInput{
+			DefaultVersionName: aws.String(v.(string)),
+			PackageName:        aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting IoT Software Package (%s) default version: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	output, err := FindSoftwarePackageByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Software Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Software Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.PackageArn)
+	d.Set(names.AttrCreationDate, aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("default_version_name", output.DefaultVersionName)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	d.Set("package_name", output.PackageName)
+
+	return diags
+}
+
+func resourceSoftwarePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iot.UpdatePackageInput{
+			PackageName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("default_version_name") {
+			if v, ok := d.GetOk("default_version_name"); ok {
+				input.DefaultVersionName = aws.String(v.(string))
+			} else {
+				input.UnsetDefaultVersion = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		_, err := conn.UpdatePackageWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Software Package (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Software Package: %s", d.Id())
+	_, err := conn.DeletePackageWithContext(ctx, &iot.DeletePackageInput{
+		PackageName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Software Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSoftwarePackageByName(ctx context.Context, conn *iot.IoT, name string) (*iot.GetPackageOutput, error) {
+	input := &iot.GetPackageInput{
+		PackageName: aws.String(name),
+	}
+
+	output, err := conn.GetPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_software_package_version", name="Software Package Version")
+// @Tags(identifierAttribute="arn")
+func ResourceSoftwarePackageVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSoftwarePackageVersionCreate,
+		ReadWithoutTimeout:   resourceSoftwarePackageVersionRead,
+		UpdateWithoutTimeout: resourceSoftwarePackageVersionUpdate,
+		DeleteWithoutTimeout: resourceSoftwarePackageVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrAttributes: {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"error_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSoftwarePackageName,
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iot.PackageVersionStatusDraft,
+				ValidateFunc: validation.StringInSlice(iot.PackageVersionStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSoftwarePackageName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSoftwarePackageVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName := d.Get("package_name").(string), d.Get("version_name").(string)
+	id := softwarePackageVersionCreateResourceID(packageName, versionName)
+	input := &iot.CreatePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk(names.AttrAttributes); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)).Map(); len(tags) > 0 {
+		input.Tags = aws.StringMap(tags)
+	}
+
+	_, err := conn.CreatePackageVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Software Package Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// New versions are always created as drafts.
+	if action := softwarePackageVersionAction(iot.PackageVersionStatusDraft, d.Get(names.AttrStatus).(string)); action != "" {
+		if err := updateSoftwarePackageVersionStatus(ctx, conn, packageName, versionName, action); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := softwarePackageVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSoftwarePackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Software Package Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Software Package Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.PackageVersionArn)
+	d.Set(names.AttrAttributes, aws.StringValueMap(output.Attributes))
+	d.Set(names.AttrCreationDate, aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("error_reason", output.ErrorReason)
+	d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	d.Set("package_name", output.PackageName)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("version_name", output.VersionName)
+
+	return diags
+}
+
+func resourceSoftwarePackageVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := softwarePackageVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges(names.AttrAttributes, names.AttrDescription) {
+		input := &iot.UpdatePackageVersionInput{
+			PackageName: aws.String(packageName),
+			VersionName: aws.String(versionName),
+		}
+
+		if d.HasChange(names.AttrAttributes) {
+			input.Attributes = flex.ExpandStringMap(d.Get(names.AttrAttributes).(map[string]interface{}))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		_, err := conn.UpdatePackageVersionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Software Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrStatus) {
+		o, n := d.GetChange(names.AttrStatus)
+		action := softwarePackageVersionAction(o.(string), n.(string))
+
+		if action == "" {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Software Package Version (%s): status cannot change from %s to %s", d.Id(), o, n)
+		}
+
+		if err := updateSoftwarePackageVersionStatus(ctx, conn, packageName, versionName, action); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceSoftwarePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourceSoftwarePackageVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := softwarePackageVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Software Package Version: %s", d.Id())
+	_, err = conn.DeletePackageVersionWithContext(ctx, &iot.DeletePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Software Package Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// softwarePackageVersionAction returns the action that moves a package version between
+// statuses, or "" if no single action does.
+func softwarePackageVersionAction(from, to string) string {
+	switch {
+	case from == to:
+		return ""
+	case to == iot.PackageVersionStatusPublished:
+		return iot.PackageVersionActionPublish
+	case to == iot.PackageVersionStatusDeprecated:
+		return iot.PackageVersionActionDeprecate
+	default:
+		return ""
+	}
+}
+
+func updateSoftwarePackageVersionStatus(ctx context.Context, conn *iot.IoT, packageName, versionName, action string) error {
+	_, err := conn.UpdatePackageVersionWithContext(ctx, &iot.UpdatePackageVersionInput{
+		Action:      aws.String(action),
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating IoT Software Package Version (%s) status (%s): %w", softwarePackageVersionCreateResourceID(packageName, versionName), action, err)
+	}
+
+	return nil
+}
+
+const softwarePackageVersionResourceIDSeparator = ":"
+
+func softwarePackageVersionCreateResourceID(packageName, versionName string) string {
+	parts := []string{packageName, versionName}
+	id := strings.Join(parts, softwarePackageVersionResourceIDSeparator)
+
+	return id
+}
+
+func softwarePackageVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, softwarePackageVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected package-name%[2]sversion-name", id, softwarePackageVersionResourceIDSeparator)
+}
+
+func FindSoftwarePackageVersionByTwoPartKey(ctx context.Context, conn *iot.IoT, packageName, versionName string) (*iot.GetPackageVersionOutput, error) {
+	input := &iot.GetPackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	output, err := conn.GetPackageVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
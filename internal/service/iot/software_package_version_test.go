@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTSoftwarePackageVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iot", regexache.MustCompile(fmt.Sprintf("package/%s/version/1.0.0$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSoftwarePackageVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceSoftwarePackageVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSoftwarePackageVersion_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPackageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_software_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwarePackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSoftwarePackageVersionConfig_status(rName, "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attributes.architecture", "arm64"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test description"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "PUBLISHED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSoftwarePackageVersionConfig_status(rName, "DEPRECATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSoftwarePackageVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPRECATED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSoftwarePackageVersionExists(ctx context.Context, n string, v *iot.GetPackageVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		output, err := tfiot.FindSoftwarePackageVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["package_name"], rs.Primary.Attributes["version_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSoftwarePackageVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_software_package_version" {
+				continue
+			}
+
+			_, err := tfiot.FindSoftwarePackageVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["package_name"], rs.Primary.Attributes["version_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Software Package Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSoftwarePackageVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_software_package" "test" {
+  package_name = %[1]q
+}
+
+resource "aws_iot_software_package_version" "test" {
+  package_name = aws_iot_software_package.test.package_name
+  version_name = "1.0.0"
+}
+`, rName)
+}
+
+func testAccSoftwarePackageVersionConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_software_package" "test" {
+  package_name = %[1]q
+}
+
+resource "aws_iot_software_package_version" "test" {
+  package_name = aws_iot_software_package.test.package_name
+  version_name = "1.0.0"
+  description  = "test description"
+  status       = %[2]q
+
+  attributes = {
+    architecture = "arm64"
+  }
+}
+`, rName, status)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_thing_group_dynamic", name="Dynamic Thing Group")
+// @Tags(identifierAttribute="arn")
+func ResourceThingGroupDynamic() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceThingGroupDynamicCreate,
+		ReadWithoutTimeout:   resourceThingGroupDynamicRead,
+		UpdateWithoutTimeout: resourceThingGroupDynamicUpdate,
+		DeleteWithoutTimeout: resourceThingGroupDynamicDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrProperties: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_payload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceThingGroupDynamicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iot.CreateDynamicThingGroupInput{
+		QueryString:    aws.String(d.Get("query_string").(string)),
+		Tags:           getTagsIn(ctx),
+		ThingGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("index_name"); ok {
+		input.IndexName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDynamicThingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Dynamic Thing Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ThingGroupName))
+
+	return append(diags, resourceThingGroupDynamicRead(ctx, d, meta)...)
+}
+
+func resourceThingGroupDynamicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	output, err := FindThingGroupByName(ctx, conn, d.Id())
+
+	if err == nil && output.QueryString == nil {
+		// A static thing group with the same name.
+		err = &retry.NotFoundError{Message: "not a dynamic thing group"}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Dynamic Thing Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ThingGroupArn)
+	d.Set("index_name", output.IndexName)
+	d.Set(names.AttrName, output.ThingGroupName)
+	if v := flattenThingGroupProperties(output.ThingGroupProperties); len(v) > 0 {
+		if err := d.Set(names.AttrProperties, []interface{}{v}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting properties: %s", err)
+		}
+	} else {
+		d.Set(names.AttrProperties, nil)
+	}
+	d.Set("query_string", output.QueryString)
+	d.Set("query_version", output.QueryVersion)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrVersion, output.Version)
+
+	return diags
+}
+
+func resourceThingGroupDynamicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iot.UpdateDynamicThingGroupInput{
+			ExpectedVersion: aws.Int64(int64(d.Get(names.AttrVersion).(int))),
+			ThingGroupName:  aws.String(d.Id()),
+		}
+
+		if d.HasChange("index_name") {
+			input.IndexName = aws.String(d.Get("index_name").(string))
+		}
+
+		if d.HasChange("query_string") {
+			input.QueryString = aws.String(d.Get("query_string").(string))
+		}
+
+		if d.HasChange("query_version") {
+			input.QueryVersion = aws.String(d.Get("query_version").(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ThingGroupProperties = &iot.ThingGroupProperties{}
+		}
+
+		// https://docs.aws.amazon.com/iot/latest/apireference/API_AttributePayload.html#API_AttributePayload_Contents:
+		// "To remove an attribute, call UpdateThing with an empty attribute value."
+		if input.ThingGroupProperties.AttributePayload == nil {
+			input.ThingGroupProperties.AttributePayload = &iot.AttributePayload{
+				Attributes: map[string]*string{},
+			}
+		}
+
+		log.Printf("[DEBUG] Updating IoT Dynamic Thing Group: %s", input)
+		_, err := conn.UpdateDynamicThingGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceThingGroupDynamicRead(ctx, d, meta)...)
+}
+
+func resourceThingGroupDynamicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Dynamic Thing Group: %s", d.Id())
+	_, err := conn.DeleteDynamicThingGroupWithContext(ctx, &iot.DeleteDynamicThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Dynamic thing groups depend on the account-wide fleet indexing configuration, so these tests are not run in parallel.
+
+func TestAccIoTThingGroupDynamic_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_dynamic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupDynamicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupDynamicConfig_basic(rName, "attributes.env:test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupDynamicExists(ctx, resourceName, &thingGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iot", regexache.MustCompile(fmt.Sprintf("thinggroup/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env:test"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccThingGroupDynamicConfig_basic(rName, "attributes.env:prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupDynamicExists(ctx, resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env:prod"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTThingGroupDynamic_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_dynamic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupDynamicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupDynamicConfig_basic(rName, "attributes.env:test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupDynamicExists(ctx, resourceName, &thingGroup),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceThingGroupDynamic(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTThingGroupDynamic_properties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_dynamic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupDynamicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupDynamicConfig_properties(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupDynamicExists(ctx, resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.description", "test description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccThingGroupDynamicConfig_properties(rName, "test description updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupDynamicExists(ctx, resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.description", "test description updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckThingGroupDynamicExists(ctx context.Context, n string, v *iot.DescribeThingGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Dynamic Thing Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		output, err := tfiot.FindThingGroupByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckThingGroupDynamicDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_thing_group_dynamic" {
+				continue
+			}
+
+			_, err := tfiot.FindThingGroupByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Dynamic Thing Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccThingGroupDynamicConfig_base = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+`
+
+func testAccThingGroupDynamicConfig_basic(rName, queryString string) string {
+	return acctest.ConfigCompose(testAccThingGroupDynamicConfig_base, fmt.Sprintf(`
+resource "aws_iot_thing_group_dynamic" "test" {
+  name         = %[1]q
+  query_string = %[2]q
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, queryString))
+}
+
+func testAccThingGroupDynamicConfig_properties(rName, description string) string {
+	return acctest.ConfigCompose(testAccThingGroupDynamicConfig_base, fmt.Sprintf(`
+resource "aws_iot_thing_group_dynamic" "test" {
+  name         = %[1]q
+  query_string = "attributes.env:test"
+
+  properties {
+    attribute_payload {
+      attributes = {
+        Key1 = "Value1"
+      }
+    }
+
+    description = %[2]q
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, description))
+}
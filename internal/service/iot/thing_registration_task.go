@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_thing_registration_task", name="Thing Registration Task")
+func ResourceThingRegistrationTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceThingRegistrationTaskCreate,
+		ReadWithoutTimeout:   resourceThingRegistrationTaskRead,
+		DeleteWithoutTimeout: resourceThingRegistrationTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_file_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 256),
+			},
+			"input_file_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"percentage_progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"success_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_body": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringIsJSON,
+					validation.StringLenBetween(0, 10240),
+				),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+		},
+	}
+}
+
+func resourceThingRegistrationTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	input := &iot.StartThingRegistrationTaskInput{
+		InputFileBucket: aws.String(d.Get("input_file_bucket").(string)),
+		InputFileKey:    aws.String(d.Get("input_file_key").(string)),
+		RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
+		TemplateBody:    aws.String(d.Get("template_body").(string)),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.StartThingRegistrationTaskWithContext(ctx, input)
+		},
+		iot.ErrCodeInvalidRequestException, "cannot be assumed")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting IoT Thing Registration Task: %s", err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*iot.StartThingRegistrationTaskOutput).TaskId))
+
+	if _, err := waitThingRegistrationTaskCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Thing Registration Task (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceThingRegistrationTaskRead(ctx, d, meta)...)
+}
+
+func resourceThingRegistrationTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	output, err := FindThingRegistrationTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Thing Registration Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Thing Registration Task (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCreationDate, aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("failure_count", output.FailureCount)
+	d.Set("input_file_bucket", output.InputFileBucket)
+	d.Set("input_file_key", output.InputFileKey)
+	d.Set(names.AttrMessage, output.Message)
+	d.Set("percentage_progress", output.PercentageProgress)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("success_count", output.SuccessCount)
+	d.Set("task_id", output.TaskId)
+	d.Set("template_body", output.TemplateBody)
+
+	return diags
+}
+
+func resourceThingRegistrationTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	// Registered things are not removed, only a task that is still running is stopped.
+	if d.Get(names.AttrStatus).(string) != iot.StatusInProgress {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping IoT Thing Registration Task: %s", d.Id())
+	_, err := conn.StopThingRegistrationTaskWithContext(ctx, &iot.StopThingRegistrationTaskInput{
+		TaskId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping IoT Thing Registration Task (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindThingRegistrationTaskByID(ctx context.Context, conn *iot.IoT, id string) (*iot.DescribeThingRegistrationTaskOutput, error) {
+	input := &iot.DescribeThingRegistrationTaskInput{
+		TaskId: aws.String(id),
+	}
+
+	output, err := conn.DescribeThingRegistrationTaskWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusThingRegistrationTask(ctx context.Context, conn *iot.IoT, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindThingRegistrationTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitThingRegistrationTaskCompleted(ctx context.Context, conn *iot.IoT, id string, timeout time.Duration) (*iot.DescribeThingRegistrationTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iot.StatusInProgress},
+		Target:  []string{iot.StatusCompleted},
+		Refresh: statusThingRegistrationTask(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iot.DescribeThingRegistrationTaskOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 	"reflect"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -25,7 +26,16 @@ import (
 // @SDKResource("aws_iot_topic_rule", name="Topic Rule")
 // @Tags(identifierAttribute="arn")
 func ResourceTopicRule() *schema.Resource {
-	return &schema.Resource{
+	actionResources := topicRuleActionResources()
+	errorActionSchema := make(map[string]*schema.Schema, len(actionResources))
+	errorActionExactlyOneOf := make([]string, 0, len(actionResources))
+
+	for k := range actionResources {
+		errorActionExactlyOneOf = append(errorActionExactlyOneOf, "error_action.0."+k)
+	}
+	slices.Sort(errorActionExactlyOneOf)
+
+	r := &schema.Resource{
 		CreateWithoutTimeout: resourceTopicRuleCreate,
 		ReadWithoutTimeout:   resourceTopicRuleRead,
 		UpdateWithoutTimeout: resourceTopicRuleUpdate,
@@ -40,199 +50,26 @@ func ResourceTopicRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"cloudwatch_alarm": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"alarm_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"state_reason": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"state_value": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validTopicRuleCloudWatchAlarmStateValue,
-						},
-					},
-				},
-			},
-			names.AttrCloudWatchLogs: {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrLogGroupName: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
-			},
-			"cloudwatch_metric": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrMetricName: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"metric_namespace": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"metric_timestamp": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: verify.ValidUTCTimestamp,
-						},
-						"metric_unit": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"metric_value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
-			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"dynamodb": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"hash_key_field": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"hash_key_value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"hash_key_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"operation": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"DELETE",
-								"INSERT",
-								"UPDATE",
-							}, false),
-						},
-						"payload_field": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"range_key_field": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"range_key_value": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"range_key_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						names.AttrTableName: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Required: true,
 			},
-			"dynamodbv2": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"put_item": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrTableName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTopicRuleName,
 			},
-			"elasticsearch": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrEndpoint: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validTopicRuleElasticsearchEndpoint,
-						},
-						names.AttrID: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"index": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						names.AttrType: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+			"sql": {
+				Type:     schema.TypeString,
+				Required: true,
 			},
-			names.AttrEnabled: {
-				Type:     schema.TypeBool,
+			"sql_version": {
+				Type:     schema.TypeString,
 				Required: true,
 			},
 			"error_action": {
@@ -240,983 +77,561 @@ func ResourceTopicRule() *schema.Resource {
 				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cloudwatch_alarm": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"alarm_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"state_reason": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"state_value": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validTopicRuleCloudWatchAlarmStateValue,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						names.AttrCloudWatchLogs: {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrLogGroupName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"cloudwatch_metric": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrMetricName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"metric_namespace": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"metric_timestamp": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidUTCTimestamp,
-									},
-									"metric_unit": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"metric_value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"dynamodb": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"hash_key_field": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"hash_key_value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"hash_key_type": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"operation": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											"DELETE",
-											"INSERT",
-											"UPDATE",
-										}, false),
-									},
-									"payload_field": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"range_key_field": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"range_key_value": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"range_key_type": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									names.AttrTableName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"dynamodbv2": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"put_item": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												names.AttrTableName: {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"elasticsearch": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrEndpoint: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validTopicRuleElasticsearchEndpoint,
-									},
-									names.AttrID: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"index": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									names.AttrType: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"firehose": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"batch_mode": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-									"delivery_stream_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"separator": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validTopicRuleFirehoseSeparator,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"http": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"confirmation_url": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsURLWithHTTPS,
-									},
-									"http_header": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												names.AttrKey: {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												names.AttrValue: {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-									names.AttrURL: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.IsURLWithHTTPS,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"iot_analytics": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"batch_mode": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-									"channel_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"iot_events": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"batch_mode": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-									"input_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"message_id": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"kafka": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"client_properties": {
-										Type:     schema.TypeMap,
-										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									names.AttrDestinationARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									names.AttrHeader: {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												names.AttrKey: {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												names.AttrValue: {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-									names.AttrKey: {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"partition": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"topic": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"kinesis": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"partition_key": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"stream_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"lambda": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrFunctionARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"republish": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"qos": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      0,
-										ValidateFunc: validation.IntBetween(0, 1),
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"topic": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"s3": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrBucketName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"canned_acl": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(iot.CannedAccessControlList_Values(), false),
-									},
-									names.AttrKey: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"sns": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"message_format": {
-										Type:     schema.TypeString,
-										Default:  iot.MessageFormatRaw,
-										Optional: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									names.AttrTargetARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"sqs": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"queue_url": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"use_base64": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"step_functions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"execution_name_prefix": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"state_machine_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-						"timestream": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrDatabaseName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"dimension": {
-										Type:     schema.TypeSet,
-										Required: true,
-										Elem:     timestreamDimensionResource,
-									},
-									names.AttrRoleARN: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									names.AttrTableName: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"timestamp": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												names.AttrUnit: {
-													Type:     schema.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														"SECONDS",
-														"MILLISECONDS",
-														"MICROSECONDS",
-														"NANOSECONDS",
-													}, false),
-												},
-												names.AttrValue: {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-								},
-							},
-							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
-						},
-					},
+					Schema: errorActionSchema,
 				},
 			},
-			"firehose": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"batch_mode": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"delivery_stream_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"separator": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validTopicRuleFirehoseSeparator,
-						},
-					},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+
+	// Every action type is available both as a (repeatable) rule action and as the rule's error action.
+	for k, v := range actionResources {
+		r.Schema[k] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     v,
+		}
+	}
+
+	// The error action gets its own copy of each action schema.
+	for k, v := range topicRuleActionResources() {
+		errorActionSchema[k] = &schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			Elem:         v,
+			ExactlyOneOf: errorActionExactlyOneOf,
+		}
+	}
+
+	return r
+}
+
+// topicRuleActionResources returns the schema of each topic rule action type, keyed by attribute name.
+func topicRuleActionResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"cloudwatch_alarm": {
+			Schema: map[string]*schema.Schema{
+				"alarm_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"state_reason": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"state_value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validTopicRuleCloudWatchAlarmStateValue,
 				},
 			},
-			"http": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"confirmation_url": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.IsURLWithHTTPS,
-						},
-						"http_header": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrKey: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrValue: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+		},
+		names.AttrCloudWatchLogs: {
+			Schema: map[string]*schema.Schema{
+				"batch_mode": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				names.AttrLogGroupName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+		"cloudwatch_metric": {
+			Schema: map[string]*schema.Schema{
+				names.AttrMetricName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"metric_namespace": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"metric_timestamp": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"metric_unit": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"metric_value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+		"dynamodb": {
+			Schema: map[string]*schema.Schema{
+				"hash_key_field": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"hash_key_value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"hash_key_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"operation": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"DELETE",
+						"INSERT",
+						"UPDATE",
+					}, false),
+				},
+				"payload_field": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"range_key_field": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"range_key_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"range_key_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrTableName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+		"dynamodbv2": {
+			Schema: map[string]*schema.Schema{
+				"put_item": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrTableName: {
+								Type:     schema.TypeString,
+								Required: true,
 							},
 						},
-						names.AttrURL: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.IsURLWithHTTPS,
-						},
 					},
 				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
 			},
-			"iot_analytics": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"batch_mode": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"channel_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
+		},
+		"elasticsearch": {
+			Schema: map[string]*schema.Schema{
+				names.AttrEndpoint: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validTopicRuleElasticsearchEndpoint,
+				},
+				names.AttrID: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"index": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrType: {
+					Type:     schema.TypeString,
+					Required: true,
 				},
 			},
-			"iot_events": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"batch_mode": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"input_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"message_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
+		},
+		"firehose": {
+			Schema: map[string]*schema.Schema{
+				"batch_mode": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"delivery_stream_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"separator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validTopicRuleFirehoseSeparator,
 				},
 			},
-			"kafka": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"client_properties": {
-							Type:     schema.TypeMap,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						names.AttrDestinationARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						names.AttrHeader: {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrKey: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									names.AttrValue: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+		},
+		"http": {
+			Schema: map[string]*schema.Schema{
+				"confirmation_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+				"http_header": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrKey: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Required: true,
 							},
-						},
-						names.AttrKey: {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"partition": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"topic": {
-							Type:     schema.TypeString,
-							Required: true,
 						},
 					},
 				},
+				names.AttrURL: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
 			},
-			"kinesis": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"partition_key": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"stream_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
+		},
+		"iot_analytics": {
+			Schema: map[string]*schema.Schema{
+				"batch_mode": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"channel_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
 				},
 			},
-			"lambda": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrFunctionARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
+		},
+		"iot_events": {
+			Schema: map[string]*schema.Schema{
+				"batch_mode": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"input_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"message_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+		"kafka": {
+			Schema: map[string]*schema.Schema{
+				"client_properties": {
+					Type:     schema.TypeMap,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrDestinationARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrHeader: {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrKey: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
 						},
 					},
 				},
+				names.AttrKey: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"partition": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"topic": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
 			},
-			names.AttrName: {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validTopicRuleName,
+		},
+		"kinesis": {
+			Schema: map[string]*schema.Schema{
+				"partition_key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"stream_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
 			},
-			"republish": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"qos": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      0,
-							ValidateFunc: validation.IntBetween(0, 1),
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"topic": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
+		},
+		"lambda": {
+			Schema: map[string]*schema.Schema{
+				names.AttrFunctionARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
 				},
 			},
-			"s3": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrBucketName: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"canned_acl": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(iot.CannedAccessControlList_Values(), false),
-						},
-						names.AttrKey: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
+		},
+		names.AttrLocation: {
+			Schema: map[string]*schema.Schema{
+				"device_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"latitude": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"longitude": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"timestamp": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrUnit: {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"SECONDS",
+									"MILLISECONDS",
+									"MICROSECONDS",
+									"NANOSECONDS",
+								}, false),
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
 						},
 					},
 				},
+				"tracker_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
 			},
-			"sns": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"message_format": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  iot.MessageFormatRaw,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						names.AttrTargetARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
+		},
+		"republish": {
+			Schema: map[string]*schema.Schema{
+				"qos": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 1),
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"topic": {
+					Type:     schema.TypeString,
+					Required: true,
 				},
 			},
-			"sql": {
-				Type:     schema.TypeString,
-				Required: true,
+		},
+		"s3": {
+			Schema: map[string]*schema.Schema{
+				names.AttrBucketName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"canned_acl": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(iot.CannedAccessControlList_Values(), false),
+				},
+				names.AttrKey: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
 			},
-			"sql_version": {
-				Type:     schema.TypeString,
-				Required: true,
+		},
+		"sns": {
+			Schema: map[string]*schema.Schema{
+				"message_format": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  iot.MessageFormatRaw,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrTargetARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
 			},
-			"sqs": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"queue_url": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"use_base64": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-					},
+		},
+		"sqs": {
+			Schema: map[string]*schema.Schema{
+				"queue_url": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"use_base64": {
+					Type:     schema.TypeBool,
+					Required: true,
 				},
 			},
-			"step_functions": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"execution_name_prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"state_machine_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
+		},
+		"step_functions": {
+			Schema: map[string]*schema.Schema{
+				"execution_name_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"state_machine_name": {
+					Type:     schema.TypeString,
+					Required: true,
 				},
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
-			"timestream": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						names.AttrDatabaseName: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"dimension": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem:     timestreamDimensionResource,
-						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						names.AttrTableName: {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"timestamp": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrUnit: {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											"SECONDS",
-											"MILLISECONDS",
-											"MICROSECONDS",
-											"NANOSECONDS",
-										}, false),
-									},
-									names.AttrValue: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+		},
+		"timestream": {
+			Schema: map[string]*schema.Schema{
+				names.AttrDatabaseName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"dimension": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     timestreamDimensionResource,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrTableName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"timestamp": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrUnit: {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"SECONDS",
+									"MILLISECONDS",
+									"MICROSECONDS",
+									"NANOSECONDS",
+								}, false),
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Required: true,
 							},
 						},
 					},
 				},
 			},
 		},
-
-		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
-var topicRuleErrorActionExactlyOneOf = []string{
-	"error_action.0.cloudwatch_alarm",
-	"error_action.0.cloudwatch_logs",
-	"error_action.0.cloudwatch_metric",
-	"error_action.0.dynamodb",
-	"error_action.0.dynamodbv2",
-	"error_action.0.elasticsearch",
-	"error_action.0.firehose",
-	"error_action.0.http",
-	"error_action.0.iot_analytics",
-	"error_action.0.iot_events",
-	"error_action.0.kafka",
-	"error_action.0.kinesis",
-	"error_action.0.lambda",
-	"error_action.0.republish",
-	"error_action.0.s3",
-	"error_action.0.sns",
-	"error_action.0.sqs",
-	"error_action.0.step_functions",
-	"error_action.0.timestream",
-}
-
 var timestreamDimensionResource *schema.Resource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		names.AttrName: {
@@ -1331,6 +746,10 @@ func resourceTopicRuleRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting lambda: %s", err)
 	}
 
+	if err := d.Set(names.AttrLocation, flattenLocationActions(output.Rule.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+	}
+
 	if err := d.Set("republish", flattenRepublishActions(output.Rule.Actions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting republish: %s", err)
 	}
@@ -1448,6 +867,10 @@ func expandCloudWatchLogsAction(tfList []interface{}) *iot.CloudwatchLogsAction
 	apiObject := &iot.CloudwatchLogsAction{}
 	tfMap := tfList[0].(map[string]interface{})
 
+	if v, ok := tfMap["batch_mode"].(bool); ok {
+		apiObject.BatchMode = aws.Bool(v)
+	}
+
 	if v, ok := tfMap[names.AttrLogGroupName].(string); ok && v != "" {
 		apiObject.LogGroupName = aws.String(v)
 	}
@@ -1805,6 +1228,60 @@ func expandLambdaAction(tfList []interface{}) *iot.LambdaAction {
 	return apiObject
 }
 
+func expandLocationAction(tfList []interface{}) *iot.LocationAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latitude"].(string); ok && v != "" {
+		apiObject.Latitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["longitude"].(string); ok && v != "" {
+		apiObject.Longitude = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok {
+		apiObject.Timestamp = expandLocationTimestamp(v)
+	}
+
+	if v, ok := tfMap["tracker_name"].(string); ok && v != "" {
+		apiObject.TrackerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLocationTimestamp(tfList []interface{}) *iot.LocationTimestamp {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationTimestamp{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -2145,6 +1622,17 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 		actions = append(actions, &iot.Action{Lambda: action})
 	}
 
+	// Legacy root attribute handling
+	for _, tfMapRaw := range d.Get(names.AttrLocation).(*schema.Set).List() {
+		action := expandLocationAction([]interface{}{tfMapRaw})
+
+		if action == nil {
+			continue
+		}
+
+		actions = append(actions, &iot.Action{Location: action})
+	}
+
 	// Legacy root attribute handling
 	for _, tfMapRaw := range d.Get("republish").(*schema.Set).List() {
 		action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2351,6 +1839,16 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 
 					iotErrorAction = &iot.Action{Lambda: action}
 				}
+			case names.AttrLocation:
+				for _, tfMapRaw := range v.([]interface{}) {
+					action := expandLocationAction([]interface{}{tfMapRaw})
+
+					if action == nil {
+						continue
+					}
+
+					iotErrorAction = &iot.Action{Location: action}
+				}
 			case "republish":
 				for _, tfMapRaw := range v.([]interface{}) {
 					action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2475,6 +1973,10 @@ func flattenCloudWatchLogsAction(apiObject *iot.CloudwatchLogsAction) []interfac
 
 	tfMap := make(map[string]interface{})
 
+	if v := apiObject.BatchMode; v != nil {
+		tfMap["batch_mode"] = aws.BoolValue(v)
+	}
+
 	if v := apiObject.LogGroupName; v != nil {
 		tfMap[names.AttrLogGroupName] = aws.StringValue(v)
 	}
@@ -3033,6 +2535,75 @@ func flattenPutItemInput(apiObject *iot.PutItemInput) []interface{} {
 	return []interface{}{tfMap}
 }
 
+// Legacy root attribute handling
+func flattenLocationActions(actions []*iot.Action) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		if v := action.Location; v != nil {
+			results = append(results, flattenLocationAction(v)...)
+		}
+	}
+
+	return results
+}
+
+func flattenLocationAction(apiObject *iot.LocationAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.DeviceId; v != nil {
+		tfMap["device_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Latitude; v != nil {
+		tfMap["latitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Longitude; v != nil {
+		tfMap["longitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap[names.AttrRoleARN] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = flattenLocationTimestamp(v)
+	}
+
+	if v := apiObject.TrackerName; v != nil {
+		tfMap["tracker_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLocationTimestamp(apiObject *iot.LocationTimestamp) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Unit; v != nil {
+		tfMap[names.AttrUnit] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap[names.AttrValue] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 // Legacy root attribute handling
 func flattenRepublishActions(actions []*iot.Action) []interface{} {
 	results := make([]interface{}, 0)
@@ -3382,6 +2953,10 @@ func flattenErrorAction(errorAction *iot.Action) []map[string]interface{} {
 		results = append(results, map[string]interface{}{"lambda": flattenLambdaActions(input)})
 		return results
 	}
+	if errorAction.Location != nil {
+		results = append(results, map[string]interface{}{names.AttrLocation: flattenLocationActions(input)})
+		return results
+	}
 	if errorAction.Republish != nil {
 		results = append(results, map[string]interface{}{"republish": flattenRepublishActions(input)})
 		return results
@@ -319,6 +319,53 @@ func TestAccIoTTopicRule_cloudWatchLogs(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_cloudWatchLogs_batchMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_cloudWatchLogsBatchMode(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_logs.*", map[string]string{
+						"batch_mode":           acctest.CtTrue,
+						names.AttrLogGroupName: "mylogs1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.batch_mode", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.log_group_name", "mylogs2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicRuleConfig_cloudWatchLogsBatchMode(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_logs.*", map[string]string{
+						"batch_mode":           acctest.CtFalse,
+						names.AttrLogGroupName: "mylogs1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.batch_mode", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_cloudWatchMetric(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -1614,6 +1661,58 @@ func TestAccIoTTopicRule_lambda(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_location(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_location(rName, "deviceId"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						"device_id":         "${deviceId}",
+						"latitude":          "${latitude}",
+						"longitude":         "${longitude}",
+						"timestamp.#":       acctest.Ct1,
+						"timestamp.0.unit":  "MILLISECONDS",
+						"timestamp.0.value": "${timestamp()}",
+						"tracker_name":      rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.device_id", "${deviceId}"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.timestamp.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.tracker_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicRuleConfig_location(rName, "clientid()"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						"device_id": "${clientid()}",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.device_id", "${clientid()}"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_republish(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -2517,6 +2616,73 @@ resource "aws_iot_topic_rule" "test" {
 `, rName, logGroupName))
 }
 
+func testAccTopicRuleConfig_cloudWatchLogsBatchMode(rName string, batchMode bool) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = false
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  cloudwatch_logs {
+    batch_mode     = %[2]t
+    log_group_name = "mylogs1"
+    role_arn       = aws_iam_role.test.arn
+  }
+
+  error_action {
+    cloudwatch_logs {
+      batch_mode     = %[2]t
+      log_group_name = "mylogs2"
+      role_arn       = aws_iam_role.test.arn
+    }
+  }
+}
+`, rName, batchMode))
+}
+
+func testAccTopicRuleConfig_location(rName, deviceIDExpression string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_location_tracker" "test" {
+  tracker_name = %[1]q
+}
+
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  location {
+    device_id    = "$${%[2]s}"
+    latitude     = "$${latitude}"
+    longitude    = "$${longitude}"
+    role_arn     = aws_iam_role.test.arn
+    tracker_name = aws_location_tracker.test.tracker_name
+
+    timestamp {
+      unit  = "MILLISECONDS"
+      value = "$${timestamp()}"
+    }
+  }
+
+  error_action {
+    location {
+      device_id    = "$${%[2]s}"
+      latitude     = "$${latitude}"
+      longitude    = "$${longitude}"
+      role_arn     = aws_iam_role.test.arn
+      tracker_name = aws_location_tracker.test.tracker_name
+    }
+  }
+}
+`, rName, deviceIDExpression))
+}
+
 func testAccTopicRuleConfig_cloudWatchMetric(rName string, metricName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
@@ -69,6 +69,12 @@ func ResourceChannel() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
 			},
+			"playback_restriction_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"playback_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -118,6 +124,10 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta int
 		in.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("playback_restriction_policy_arn"); ok {
+		in.PlaybackRestrictionPolicyArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("recording_configuration_arn"); ok {
 		in.RecordingConfigurationArn = aws.String(v.(string))
 	}
@@ -166,6 +176,7 @@ func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("ingest_endpoint", out.IngestEndpoint)
 	d.Set("latency_mode", out.LatencyMode)
 	d.Set(names.AttrName, out.Name)
+	d.Set("playback_restriction_policy_arn", out.PlaybackRestrictionPolicyArn)
 	d.Set("playback_url", out.PlaybackUrl)
 	d.Set("recording_configuration_arn", out.RecordingConfigurationArn)
 	d.Set(names.AttrType, out.Type)
@@ -200,6 +211,11 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		update = true
 	}
 
+	if d.HasChanges("playback_restriction_policy_arn") {
+		in.PlaybackRestrictionPolicyArn = aws.String(d.Get("playback_restriction_policy_arn").(string))
+		update = true
+	}
+
 	if d.HasChanges("recording_configuration_arn") {
 		in.RecordingConfigurationArn = aws.String(d.Get("recording_configuration_arn").(string))
 		update = true
@@ -59,6 +59,29 @@ func FindRecordingConfigurationByID(ctx context.Context, conn *ivs.IVS, id strin
 	return out.RecordingConfiguration, nil
 }
 
+func FindPlaybackRestrictionPolicyByID(ctx context.Context, conn *ivs.IVS, id string) (*ivs.PlaybackRestrictionPolicy, error) {
+	in := &ivs.GetPlaybackRestrictionPolicyInput{
+		Arn: aws.String(id),
+	}
+	out, err := conn.GetPlaybackRestrictionPolicyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PlaybackRestrictionPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.PlaybackRestrictionPolicy, nil
+}
+
 func FindChannelByID(ctx context.Context, conn *ivs.IVS, arn string) (*ivs.Channel, error) {
 	in := &ivs.GetChannelInput{
 		Arn: aws.String(arn),
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfivs "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSPlaybackRestrictionPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy ivs.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ivs", regexache.MustCompile(`playback-restriction-policy/.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.PlaybackRestrictionPolicy
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_update(rName1, "US", "https://example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName1),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "US"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_update(rName2, "CA", "https://example.org", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &v2),
+					testAccCheckPlaybackRestrictionPolicyNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "CA"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.org"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy ivs.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags1(acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy ivs.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccPlaybackRestrictionPolicyPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfivs.ResourcePlaybackRestrictionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPlaybackRestrictionPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ivs_playback_restriction_policy" {
+				continue
+			}
+
+			_, err := tfivs.FindPlaybackRestrictionPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.IVS, create.ErrActionCheckingDestroyed, tfivs.ResNamePlaybackRestrictionPolicy, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPlaybackRestrictionPolicyExists(ctx context.Context, name string, policy *ivs.PlaybackRestrictionPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
+
+		output, err := tfivs.FindPlaybackRestrictionPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, rs.Primary.ID, err)
+		}
+
+		*policy = *output
+
+		return nil
+	}
+}
+
+func testAccPlaybackRestrictionPolicyPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
+
+	input := &ivs.ListPlaybackRestrictionPoliciesInput{}
+	_, err := conn.ListPlaybackRestrictionPoliciesWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckPlaybackRestrictionPolicyNotRecreated(before, after *ivs.PlaybackRestrictionPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
+			return create.Error(names.IVS, create.ErrActionCheckingNotRecreated, tfivs.ResNamePlaybackRestrictionPolicy, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
+func testAccPlaybackRestrictionPolicyConfig_basic() string {
+	return `
+resource "aws_ivs_playback_restriction_policy" "test" {
+}
+`
+}
+
+func testAccPlaybackRestrictionPolicyConfig_update(rName, country, origin string, enableStrictOriginEnforcement bool) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name                             = %[1]q
+  allowed_countries                = [%[2]q]
+  allowed_origins                  = [%[3]q]
+  enable_strict_origin_enforcement = %[4]t
+}
+`, rName, country, origin, enableStrictOriginEnforcement)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
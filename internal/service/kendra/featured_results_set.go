@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_featured_results_set", name="Featured Results Set")
+// @Tags(identifierAttribute="arn")
+func ResourceFeaturedResultsSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeaturedResultsSetCreate,
+		ReadWithoutTimeout:   resourceFeaturedResultsSetRead,
+		UpdateWithoutTimeout: resourceFeaturedResultsSetUpdate,
+		DeleteWithoutTimeout: resourceFeaturedResultsSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"featured_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+			},
+			"featured_documents_missing": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"featured_results_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_texts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 49,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.FeaturedResultsSetStatusActive),
+				ValidateDiagFunc: enum.Validate[types.FeaturedResultsSetStatus](),
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFeaturedResultsSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &kendra.CreateFeaturedResultsSetInput{
+		ClientToken:            aws.String(id.UniqueId()),
+		FeaturedResultsSetName: aws.String(name),
+		IndexId:                aws.String(d.Get("index_id").(string)),
+		Status:                 types.FeaturedResultsSetStatus(d.Get(names.AttrStatus).(string)),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("featured_documents"); ok && len(v.([]interface{})) > 0 {
+		input.FeaturedDocuments = expandFeaturedDocuments(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_texts"); ok && v.(*schema.Set).Len() > 0 {
+		input.QueryTexts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFeaturedResultsSet(ctx, input)
+		},
+		func(err error) (bool, error) {
+			var validationException *types.ValidationException
+
+			if errors.As(err, &validationException) && strings.Contains(validationException.ErrorMessage(), validationExceptionMessage) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): %s", name, err)
+	}
+
+	output := outputRaw.(*kendra.CreateFeaturedResultsSetOutput)
+
+	if output == nil || output.FeaturedResultsSet == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): empty output", name)
+	}
+
+	id := aws.ToString(output.FeaturedResultsSet.FeaturedResultsSetId)
+	indexId := d.Get("index_id").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", id, indexId))
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Featured Results Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "kendra",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/featured-results-set/%s", indexId, id),
+	}.String()
+
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrCreatedAt, time.UnixMilli(aws.ToInt64(out.CreationTimestamp)).UTC().Format(time.RFC3339))
+	d.Set(names.AttrDescription, out.Description)
+	d.Set("featured_results_set_id", out.FeaturedResultsSetId)
+	d.Set("index_id", indexId)
+	d.Set(names.AttrName, out.FeaturedResultsSetName)
+	d.Set("query_texts", out.QueryTexts)
+	d.Set(names.AttrStatus, out.Status)
+	d.Set("updated_at", time.UnixMilli(aws.ToInt64(out.LastUpdatedTimestamp)).UTC().Format(time.RFC3339))
+
+	if err := d.Set("featured_documents", flattenFeaturedDocuments(d.Get("featured_documents").([]interface{}), out.FeaturedDocumentsWithMetadata, out.FeaturedDocumentsMissing)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting featured_documents: %s", err)
+	}
+
+	if err := d.Set("featured_documents_missing", flattenFeaturedDocumentsMissing(out.FeaturedDocumentsMissing)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting featured_documents_missing: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFeaturedResultsSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// UpdateFeaturedResultsSet replaces the featured documents and query texts, so always send the full set.
+		input := &kendra.UpdateFeaturedResultsSetInput{
+			FeaturedDocuments:    expandFeaturedDocuments(d.Get("featured_documents").([]interface{})),
+			FeaturedResultsSetId: aws.String(id),
+			IndexId:              aws.String(indexId),
+			QueryTexts:           flex.ExpandStringValueSet(d.Get("query_texts").(*schema.Set)),
+		}
+
+		if input.FeaturedDocuments == nil {
+			input.FeaturedDocuments = []types.FeaturedDocument{}
+		}
+
+		if input.QueryTexts == nil {
+			input.QueryTexts = []string{}
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.FeaturedResultsSetName = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange(names.AttrStatus) {
+			input.Status = types.FeaturedResultsSetStatus(d.Get(names.AttrStatus).(string))
+		}
+
+		_, err = conn.UpdateFeaturedResultsSet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kendra Featured Results Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Kendra Featured Results Set %s", d.Id())
+	output, err := conn.BatchDeleteFeaturedResultsSet(ctx, &kendra.BatchDeleteFeaturedResultsSetInput{
+		FeaturedResultsSetIds: []string{id},
+		IndexId:               aws.String(indexId),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Errors) > 0 {
+		v := output.Errors[0]
+
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s: %s", d.Id(), v.ErrorCode, aws.ToString(v.ErrorMessage))
+	}
+
+	return diags
+}
+
+func expandFeaturedDocuments(tfList []interface{}) []types.FeaturedDocument {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.FeaturedDocument, 0, len(tfList))
+
+	for _, v := range tfList {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.FeaturedDocument{
+			Id: aws.String(s),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenFeaturedDocuments returns the IDs of all featured documents. The API splits them
+// between documents that were found in the index and documents that are missing, so the
+// configured order is kept when the same documents are returned.
+func flattenFeaturedDocuments(configured []interface{}, withMetadata []types.FeaturedDocumentWithMetadata, missing []types.FeaturedDocumentMissing) []string {
+	ids := make(map[string]struct{})
+
+	for _, v := range withMetadata {
+		ids[aws.ToString(v.Id)] = struct{}{}
+	}
+
+	for _, v := range missing {
+		ids[aws.ToString(v.Id)] = struct{}{}
+	}
+
+	if len(configured) == len(ids) {
+		result := make([]string, 0, len(configured))
+
+		for _, v := range configured {
+			s, _ := v.(string)
+			if _, ok := ids[s]; !ok {
+				break
+			}
+
+			result = append(result, s)
+		}
+
+		if len(result) == len(ids) {
+			return result
+		}
+	}
+
+	result := make([]string, 0, len(ids))
+
+	for _, v := range withMetadata {
+		result = append(result, aws.ToString(v.Id))
+	}
+
+	for _, v := range missing {
+		result = append(result, aws.ToString(v.Id))
+	}
+
+	return result
+}
+
+func flattenFeaturedDocumentsMissing(apiObjects []types.FeaturedDocumentMissing) []string {
+	result := make([]string, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		result = append(result, aws.ToString(v.Id))
+	}
+
+	return result
+}
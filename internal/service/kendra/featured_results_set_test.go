@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKendraFeaturedResultsSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "kendra", regexache.MustCompile(`index/.+/featured-results-set/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "featured_results_set_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "query_texts.*", "how do I get started"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkendra.ResourceFeaturedResultsSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_update(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.0", "doc-2"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.1", "doc-1"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents_missing.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "INACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFeaturedResultsSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kendra_featured_results_set" {
+				continue
+			}
+
+			id, indexId, err := tfkendra.FeaturedResultsSetParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfkendra.FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kendra Featured Results Set %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFeaturedResultsSetExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Featured Results Set is set")
+		}
+
+		id, indexId, err := tfkendra.FeaturedResultsSetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		_, err = tfkendra.FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+		return err
+	}
+}
+
+func testAccFeaturedResultsSetBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    effect  = "Allow"
+    principals {
+      type        = "Service"
+      identifiers = ["kendra.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect    = "Allow"
+    actions   = ["cloudwatch:PutMetricData"]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_policy" "test" {
+  name        = %[1]q
+  description = "Allow Kendra to publish metrics"
+  policy      = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test.arn
+}
+
+resource "aws_kendra_index" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+}
+`, rName)
+}
+
+func testAccFeaturedResultsSetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  query_texts = ["how do I get started"]
+}
+`, rName))
+}
+
+func testAccFeaturedResultsSetConfig_update(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  description        = "updated"
+  featured_documents = ["doc-2", "doc-1"]
+  index_id           = aws_kendra_index.test.id
+  name               = %[1]q
+  query_texts        = ["how do I get started", "getting started"]
+  status             = "INACTIVE"
+}
+`, rName2))
+}
+
+func testAccFeaturedResultsSetConfig_tags1(rName, tag, value string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  query_texts = ["how do I get started"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tag, value))
+}
+
+func testAccFeaturedResultsSetConfig_tags2(rName, tag1, value1, tag2, value2 string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  query_texts = ["how do I get started"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tag1, value1, tag2, value2))
+}
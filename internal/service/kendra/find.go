@@ -121,3 +121,30 @@ func FindThesaurusByID(ctx context.Context, conn *kendra.Client, id, indexId str
 
 	return out, nil
 }
+
+func FindFeaturedResultsSetByID(ctx context.Context, conn *kendra.Client, id, indexId string) (*kendra.DescribeFeaturedResultsSetOutput, error) {
+	in := &kendra.DescribeFeaturedResultsSetInput{
+		FeaturedResultsSetId: aws.String(id),
+		IndexId:              aws.String(indexId),
+	}
+
+	out, err := conn.DescribeFeaturedResultsSet(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
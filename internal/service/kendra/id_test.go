@@ -227,3 +227,76 @@ func TestThesaurusParseResourceID(t *testing.T) {
 		})
 	}
 }
+
+func TestFeaturedResultsSetParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName        string
+		Input           string
+		ExpectedId      string
+		ExpectedIndexId string
+		Error           bool
+	}{
+		{
+			TestName:        "empty",
+			Input:           "",
+			ExpectedId:      "",
+			ExpectedIndexId: "",
+			Error:           true,
+		},
+		{
+			TestName:        "Invalid ID",
+			Input:           "abcdefg12345678/",
+			ExpectedId:      "",
+			ExpectedIndexId: "",
+			Error:           true,
+		},
+		{
+			TestName:        "Invalid ID separator",
+			Input:           "abcdefg12345678:qwerty09876",
+			ExpectedId:      "",
+			ExpectedIndexId: "",
+			Error:           true,
+		},
+		{
+			TestName:        "Invalid ID with more than 1 separator",
+			Input:           "abcdefg12345678/qwerty09876/zxcvbnm123456",
+			ExpectedId:      "",
+			ExpectedIndexId: "",
+			Error:           true,
+		},
+		{
+			TestName:        "Valid ID",
+			Input:           "abcdefg12345678/qwerty09876",
+			ExpectedId:      "abcdefg12345678",
+			ExpectedIndexId: "qwerty09876",
+			Error:           false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotId, gotIndexId, err := tfkendra.FeaturedResultsSetParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (Id: %s, IndexId: %s) and no error, expected error", gotId, gotIndexId)
+			}
+
+			if gotId != testCase.ExpectedId {
+				t.Errorf("got %s, expected %s", gotId, testCase.ExpectedIndexId)
+			}
+
+			if gotIndexId != testCase.ExpectedIndexId {
+				t.Errorf("got %s, expected %s", gotIndexId, testCase.ExpectedIndexId)
+			}
+		})
+	}
+}
@@ -456,7 +456,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// CreateIndex API does not support document_metadata_configuration_updates but UpdateIndex does
-	if v, ok := d.GetOk("document_metadata_configuration_updates"); ok && v.(*schema.Set).Len() >= 13 {
+	if v, ok := d.GetOk("document_metadata_configuration_updates"); ok && v.(*schema.Set).Len() > 0 {
 		callUpdateIndex = true
 	}
 
@@ -519,7 +519,10 @@ func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if err := d.Set("user_group_resolution_configuration", flattenUserGroupResolutionConfiguration(resp.UserGroupResolutionConfiguration)); err != nil {
+	// Removing the block resets the mode to NONE, which is equivalent to it not being configured.
+	if v := resp.UserGroupResolutionConfiguration; v != nil && v.UserGroupResolutionMode == types.UserGroupResolutionModeNone && len(d.Get("user_group_resolution_configuration").([]interface{})) == 0 {
+		d.Set("user_group_resolution_configuration", nil)
+	} else if err := d.Set("user_group_resolution_configuration", flattenUserGroupResolutionConfiguration(resp.UserGroupResolutionConfiguration)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -560,7 +563,13 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.UserContextPolicy = types.UserContextPolicy(d.Get("user_context_policy").(string))
 		}
 		if d.HasChange("user_group_resolution_configuration") {
-			input.UserGroupResolutionConfiguration = expandUserGroupResolutionConfiguration(d.Get("user_group_resolution_configuration").([]interface{}))
+			if v := expandUserGroupResolutionConfiguration(d.Get("user_group_resolution_configuration").([]interface{})); v != nil {
+				input.UserGroupResolutionConfiguration = v
+			} else {
+				input.UserGroupResolutionConfiguration = &types.UserGroupResolutionConfiguration{
+					UserGroupResolutionMode: types.UserGroupResolutionModeNone,
+				}
+			}
 		}
 		if d.HasChange("user_token_configurations") {
 			input.UserTokenConfigurations = expandUserTokenConfigurations(d.Get("user_token_configurations").([]interface{}))
@@ -436,6 +436,40 @@ func TestAccKendraIndex_updateUserGroupResolutionConfigurationMode(t *testing.T)
 	})
 }
 
+func TestAccKendraIndex_removeUserGroupResolutionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var index kendra.DescribeIndexOutput
+
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_kendra_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_userGroupResolutionMode(rName, rName2, rName3, string(types.UserGroupResolutionModeAwsSso)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &index),
+					resource.TestCheckResourceAttr(resourceName, "user_group_resolution_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "user_group_resolution_configuration.0.user_group_resolution_mode", string(types.UserGroupResolutionModeAwsSso)),
+				),
+			},
+			{
+				Config: testAccIndexConfig_userGroupResolutionModeRemoved(rName, rName2, rName3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &index),
+					resource.TestCheckResourceAttr(resourceName, "user_group_resolution_configuration.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKendraIndex_addDocumentMetadataConfigurationUpdates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var index kendra.DescribeIndexOutput
@@ -1657,6 +1691,17 @@ resource "aws_kendra_index" "test" {
 `, rName3, UserGroupResolutionMode))
 }
 
+func testAccIndexConfig_userGroupResolutionModeRemoved(rName, rName2, rName3 string) string {
+	return acctest.ConfigCompose(
+		testAccIndexConfigBase(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_kendra_index" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.access_cw.arn
+}
+`, rName3))
+}
+
 func testAccIndexConfig_tags(rName, rName2, rName3, description string) string {
 	return acctest.ConfigCompose(
 		testAccIndexConfigBase(rName, rName2),
@@ -0,0 +1,748 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// This value is defined by AWS API
+const batchPermissionsMaxBatchSize = 20
+
+// @SDKResource("aws_lakeformation_batch_permissions", name="Batch Permissions")
+func resourceBatchPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBatchPermissionsCreate,
+		ReadWithoutTimeout:   resourceBatchPermissionsRead,
+		UpdateWithoutTimeout: resourceBatchPermissionsUpdate,
+		DeleteWithoutTimeout: resourceBatchPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCatalogID: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrEntry: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_resource": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"data_cells_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"table_catalog_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrTableName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"data_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						names.AttrDatabase: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"lf_tag": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrKey: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									names.AttrValues: {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateLFTagValues(),
+										},
+									},
+								},
+							},
+						},
+						"lf_tag_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrExpression: {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKey: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												names.AttrValues: {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateLFTagValues(),
+													},
+												},
+											},
+										},
+									},
+									names.AttrResourceType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.ResourceType](),
+									},
+								},
+							},
+						},
+						names.AttrPermissions: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.Permission](),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.Permission](),
+							},
+						},
+						names.AttrPrincipal: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: resourceBatchPermissionsCustomizeDiff,
+	}
+}
+
+// The set of grants is treated declaratively: new or changed entries are granted with BatchGrantPermissions,
+// and permissions of removed entries that no remaining entry still needs are revoked with BatchRevokePermissions.
+// Grants are made before revokes so that a changed entry never loses a permission it keeps.
+// The resource ID is generated, so import is not supported.
+
+func resourceBatchPermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	entries := expandBatchPermissionsRequestEntries(d.Get(names.AttrEntry).(*schema.Set).List())
+
+	if err := batchGrantPermissions(ctx, conn, d.Get(names.AttrCatalogID).(string), entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Batch Permissions: %s", err)
+	}
+
+	d.SetId(sdkid.UniqueId())
+
+	return append(diags, resourceBatchPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceBatchPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	catalogID := d.Get(names.AttrCatalogID).(string)
+
+	// Entries whose permissions are no longer all granted are removed from state so that they are re-granted.
+	var tfList []interface{}
+	for _, tfMapRaw := range d.Get(names.AttrEntry).(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		entry := expandBatchPermissionsRequestEntry(tfMap)
+
+		granted, err := findBatchPermissionsEntryGranted(ctx, conn, catalogID, entry)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+		}
+
+		if !granted {
+			log.Printf("[WARN] Lake Formation Batch Permissions (%s) entry for principal (%s) not found, removing from state", d.Id(), aws.ToString(entry.Principal.DataLakePrincipalIdentifier))
+			continue
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] Lake Formation Batch Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set(names.AttrEntry, tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBatchPermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	if d.HasChange(names.AttrEntry) {
+		catalogID := d.Get(names.AttrCatalogID).(string)
+		o, n := d.GetChange(names.AttrEntry)
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := expandBatchPermissionsRequestEntries(ns.Difference(os).List()); len(add) > 0 {
+			if err := batchGrantPermissions(ctx, conn, catalogID, add); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+			}
+		}
+
+		if del := batchPermissionsRevokeEntries(os.Difference(ns).List(), ns.List()); len(del) > 0 {
+			if err := batchRevokePermissions(ctx, conn, catalogID, del); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceBatchPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceBatchPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	entries := expandBatchPermissionsRequestEntries(d.Get(names.AttrEntry).(*schema.Set).List())
+
+	log.Printf("[DEBUG] Deleting Lake Formation Batch Permissions: %s", d.Id())
+	if err := batchRevokePermissions(ctx, conn, d.Get(names.AttrCatalogID).(string), entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceBatchPermissionsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, tfMapRaw := range d.Get(names.AttrEntry).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entry := expandBatchPermissionsRequestEntry(tfMap)
+		principal := aws.ToString(entry.Principal.DataLakePrincipalIdentifier)
+
+		var n int
+		for _, v := range []bool{
+			entry.Resource.Catalog != nil,
+			entry.Resource.DataCellsFilter != nil,
+			entry.Resource.DataLocation != nil,
+			entry.Resource.Database != nil,
+			entry.Resource.LFTag != nil,
+			entry.Resource.LFTagPolicy != nil,
+			entry.Resource.Table != nil,
+		} {
+			if v {
+				n++
+			}
+		}
+
+		if n != 1 {
+			return fmt.Errorf("entry for principal (%s): exactly one of catalog_resource, data_cells_filter, data_location, database, lf_tag, lf_tag_policy or table must be specified", principal)
+		}
+
+		if v := entry.Resource.Table; v != nil && v.Name == nil && v.TableWildcard == nil {
+			return fmt.Errorf("entry for principal (%s): one of table.name or table.wildcard must be specified", principal)
+		}
+	}
+
+	return nil
+}
+
+func batchGrantPermissions(ctx context.Context, conn *lakeformation.Client, catalogID string, entries []awstypes.BatchPermissionsRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(entries, batchPermissionsMaxBatchSize) {
+		input := &lakeformation.BatchGrantPermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		// Failed entries caused by eventual consistency are retried until they succeed or time out.
+		err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+			output, err := conn.BatchGrantPermissions(ctx, input)
+
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			failures, retryable := batchPermissionsFailures(output.Failures, nil, isRetryableBatchGrantPermissionsFailure)
+
+			if len(failures) == 0 {
+				return nil
+			}
+
+			input.Entries = tfslices.ApplyToAll(failures, func(v awstypes.BatchPermissionsFailureEntry) awstypes.BatchPermissionsRequestEntry {
+				return *v.RequestEntry
+			})
+			err = batchPermissionsFailuresError(failures)
+
+			if retryable {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchGrantPermissionsOutput
+			output, err = conn.BatchGrantPermissions(ctx, input)
+
+			if err == nil {
+				failures, _ := batchPermissionsFailures(output.Failures, nil, isRetryableBatchGrantPermissionsFailure)
+				err = batchPermissionsFailuresError(failures)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("granting permissions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func batchRevokePermissions(ctx context.Context, conn *lakeformation.Client, catalogID string, entries []awstypes.BatchPermissionsRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(entries, batchPermissionsMaxBatchSize) {
+		input := &lakeformation.BatchRevokePermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		err := retry.RetryContext(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
+			output, err := conn.BatchRevokePermissions(ctx, input)
+
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			failures, retryable := batchPermissionsFailures(output.Failures, isIgnorableBatchRevokePermissionsFailure, isRetryableBatchRevokePermissionsFailure)
+
+			if len(failures) == 0 {
+				return nil
+			}
+
+			input.Entries = tfslices.ApplyToAll(failures, func(v awstypes.BatchPermissionsFailureEntry) awstypes.BatchPermissionsRequestEntry {
+				return *v.RequestEntry
+			})
+			err = batchPermissionsFailuresError(failures)
+
+			if retryable {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchRevokePermissionsOutput
+			output, err = conn.BatchRevokePermissions(ctx, input)
+
+			if err == nil {
+				failures, _ := batchPermissionsFailures(output.Failures, isIgnorableBatchRevokePermissionsFailure, isRetryableBatchRevokePermissionsFailure)
+				err = batchPermissionsFailuresError(failures)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("revoking permissions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// batchPermissionsFailures returns the failed entries that should be reported or retried, ignoring
+// those that indicate the desired state has already been reached, and whether all of them are retryable.
+func batchPermissionsFailures(apiObjects []awstypes.BatchPermissionsFailureEntry, isIgnorable, isRetryable func(string) bool) ([]awstypes.BatchPermissionsFailureEntry, bool) {
+	var failures []awstypes.BatchPermissionsFailureEntry
+	retryable := true
+
+	for _, apiObject := range apiObjects {
+		if apiObject.RequestEntry == nil {
+			continue
+		}
+
+		var message string
+		if v := apiObject.Error; v != nil {
+			message = aws.ToString(v.ErrorMessage)
+		}
+
+		if isIgnorable != nil && isIgnorable(message) {
+			continue
+		}
+
+		if !isRetryable(message) {
+			retryable = false
+		}
+
+		failures = append(failures, apiObject)
+	}
+
+	return failures, retryable
+}
+
+func batchPermissionsFailuresError(apiObjects []awstypes.BatchPermissionsFailureEntry) error {
+	var errList []error
+
+	for _, apiObject := range apiObjects {
+		var code, message string
+		if v := apiObject.Error; v != nil {
+			code, message = aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)
+		}
+
+		var principal string
+		if v := apiObject.RequestEntry; v != nil && v.Principal != nil {
+			principal = aws.ToString(v.Principal.DataLakePrincipalIdentifier)
+		}
+
+		errList = append(errList, fmt.Errorf("principal (%s): %s: %s", principal, code, message))
+	}
+
+	return errors.Join(errList...)
+}
+
+func isIgnorableBatchRevokePermissionsFailure(message string) bool {
+	for _, v := range []string{
+		"No permissions revoked. Grantee",
+		"non-existent column",
+	} {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRetryableBatchGrantPermissionsFailure(message string) bool {
+	for _, v := range []string{
+		"Invalid principal",
+		"Grantee has no permissions",
+		"register the S3 path",
+		"is not authorized to access requested permissions",
+	} {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRetryableBatchRevokePermissionsFailure(message string) bool {
+	for _, v := range []string{
+		"register the S3 path",
+		"is not authorized to access requested permissions",
+	} {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findBatchPermissionsEntryGranted returns whether all of the entry's permissions are currently granted.
+func findBatchPermissionsEntryGranted(ctx context.Context, conn *lakeformation.Client, catalogID string, entry awstypes.BatchPermissionsRequestEntry) (bool, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: entry.Principal,
+		Resource:  entry.Resource,
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	var permissions []awstypes.PrincipalResourcePermissions
+	pages := lakeformation.NewListPermissionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Resource does not exist") {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, permission := range page.PrincipalResourcePermissions {
+			if reflect.ValueOf(permission).IsZero() {
+				continue
+			}
+
+			if aws.ToString(input.Principal.DataLakePrincipalIdentifier) != aws.ToString(permission.Principal.DataLakePrincipalIdentifier) {
+				continue
+			}
+
+			permissions = append(permissions, permission)
+		}
+	}
+
+	var tableType string
+	if input.Resource.Table != nil {
+		tableType = TableTypeTable
+	}
+
+	cleanPermissions := FilterPermissions(input, tableType, nil, nil, false, permissions)
+	granted := flattenResourcePermissions(cleanPermissions)
+	grantable := flattenGrantPermissions(cleanPermissions)
+
+	for _, v := range entry.Permissions {
+		if !slices.Contains(granted, string(v)) {
+			return false, nil
+		}
+	}
+
+	for _, v := range entry.PermissionsWithGrantOption {
+		if !slices.Contains(grantable, string(v)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// batchPermissionsRevokeEntries returns the removed entries, less any permissions that a remaining entry
+// for the same principal and resource still grants.
+func batchPermissionsRevokeEntries(tfListDel, tfListKeep []interface{}) []awstypes.BatchPermissionsRequestEntry {
+	keep := expandBatchPermissionsRequestEntries(tfListKeep)
+	var apiObjects []awstypes.BatchPermissionsRequestEntry
+
+	for _, apiObject := range expandBatchPermissionsRequestEntries(tfListDel) {
+		for _, v := range keep {
+			if aws.ToString(v.Principal.DataLakePrincipalIdentifier) != aws.ToString(apiObject.Principal.DataLakePrincipalIdentifier) || !reflect.DeepEqual(v.Resource, apiObject.Resource) {
+				continue
+			}
+
+			apiObject.Permissions = slices.DeleteFunc(apiObject.Permissions, func(p awstypes.Permission) bool {
+				return slices.Contains(v.Permissions, p)
+			})
+			apiObject.PermissionsWithGrantOption = slices.DeleteFunc(apiObject.PermissionsWithGrantOption, func(p awstypes.Permission) bool {
+				return slices.Contains(v.PermissionsWithGrantOption, p)
+			})
+		}
+
+		if len(apiObject.Permissions) == 0 && len(apiObject.PermissionsWithGrantOption) == 0 {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBatchPermissionsRequestEntries(tfList []interface{}) []awstypes.BatchPermissionsRequestEntry {
+	var apiObjects []awstypes.BatchPermissionsRequestEntry
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandBatchPermissionsRequestEntry(tfMap)
+		apiObject.Id = aws.String(strconv.Itoa(i))
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBatchPermissionsRequestEntry(tfMap map[string]interface{}) awstypes.BatchPermissionsRequestEntry {
+	apiObject := awstypes.BatchPermissionsRequestEntry{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(tfMap[names.AttrPrincipal].(string)),
+		},
+		Resource: &awstypes.Resource{},
+	}
+
+	if v, ok := tfMap[names.AttrPermissions].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Permissions = flex.ExpandStringyValueSet[awstypes.Permission](v)
+	}
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PermissionsWithGrantOption = flex.ExpandStringyValueSet[awstypes.Permission](v)
+	}
+
+	if v, ok := tfMap["catalog_resource"].(bool); ok && v {
+		apiObject.Resource.Catalog = ExpandCatalogResource()
+	}
+
+	if v, ok := tfMap["data_cells_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.DataCellsFilter = ExpandDataCellsFilter(v)
+	}
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.DataLocation = ExpandDataLocationResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrDatabase].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Database = ExpandDatabaseResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lf_tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.LFTag = ExpandLFTagKeyResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lf_tag_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.LFTagPolicy = ExpandLFTagPolicyResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Table = ExpandTableResource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
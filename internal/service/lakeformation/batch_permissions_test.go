@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccBatchPermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_batch_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchPermissionsConfig_basic(rName, `["ALTER", "CREATE_TABLE", "DROP"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":      acctest.Ct1,
+						"database.0.name": rName,
+						"permissions.#":   acctest.Ct3,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"table.#":               acctest.Ct1,
+						"table.0.database_name": rName,
+						"table.0.wildcard":      acctest.CtTrue,
+						"permissions.#":         acctest.Ct1,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBatchPermissions_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_batch_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchPermissionsConfig_basic(rName, `["ALTER", "CREATE_TABLE", "DROP"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceBatchPermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccBatchPermissions_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_batch_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchPermissionsConfig_basic(rName, `["ALTER", "CREATE_TABLE", "DROP"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccBatchPermissionsConfig_basic(rName, `["CREATE_TABLE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":    acctest.Ct1,
+						"permissions.#": acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "entry.*.permissions.*", string(awstypes.PermissionCreateTable)),
+				),
+			},
+		},
+	})
+}
+
+// testAccBatchPermissionsPrincipals returns the distinct principals of a resource's entries.
+func testAccBatchPermissionsPrincipals(rs *terraform.ResourceState) []string {
+	var principals []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "entry.") && strings.HasSuffix(k, ".principal") {
+			principals = append(principals, v)
+		}
+	}
+
+	return principals
+}
+
+func testAccCheckBatchPermissionsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, principal := range testAccBatchPermissionsPrincipals(rs) {
+			n, err := testAccBatchPermissionsCount(ctx, conn, principal)
+
+			if err != nil {
+				return err
+			}
+
+			if n == 0 {
+				return fmt.Errorf("Lake Formation Batch Permissions (%s) for principal (%s) not found", rs.Primary.ID, principal)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBatchPermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_batch_permissions" {
+				continue
+			}
+
+			for _, principal := range testAccBatchPermissionsPrincipals(rs) {
+				n, err := testAccBatchPermissionsCount(ctx, conn, principal)
+
+				if err != nil {
+					return err
+				}
+
+				if n > 0 {
+					return fmt.Errorf("Lake Formation Batch Permissions (%s) for principal (%s) still exist", rs.Primary.ID, principal)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBatchPermissionsCount(ctx context.Context, conn *lakeformation.Client, principal string) (int, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+	}
+
+	var n int
+	pages := lakeformation.NewListPermissionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return 0, err
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v.Principal != nil && aws.ToString(v.Principal.DataLakePrincipalIdentifier) == principal {
+				n++
+			}
+		}
+	}
+
+	return n, nil
+}
+
+func testAccBatchPermissionsConfig_basic(rName, databasePermissions string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_batch_permissions" "test" {
+  entry {
+    permissions = %[2]s
+    principal   = aws_iam_role.test.arn
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  entry {
+    permissions = ["SELECT"]
+    principal   = aws_iam_role.test.arn
+
+    table {
+      database_name = aws_glue_catalog_database.test.name
+      wildcard      = true
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, databasePermissions)
+}
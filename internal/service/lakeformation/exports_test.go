@@ -5,9 +5,12 @@ package lakeformation
 
 // exports used for testing only.
 var (
-	ResourceDataCellsFilter = newResourceDataCellsFilter
-	ResourceResourceLFTag   = newResourceResourceLFTag
+	ResourceBatchPermissions            = resourceBatchPermissions
+	ResourceDataCellsFilter             = newResourceDataCellsFilter
+	ResourceIdentityCenterConfiguration = newResourceIdentityCenterConfiguration
+	ResourceResourceLFTag               = newResourceResourceLFTag
 
-	FindDataCellsFilterByID = findDataCellsFilterByID
-	FindResourceLFTagByID   = findResourceLFTagByID
+	FindDataCellsFilterByID             = findDataCellsFilterByID
+	FindIdentityCenterConfigurationByID = findIdentityCenterConfigurationByID
+	FindResourceLFTagByID               = findResourceLFTagByID
 )
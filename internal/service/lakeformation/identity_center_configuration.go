@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Center Configuration")
+func newResourceIdentityCenterConfiguration(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIdentityCenterConfiguration{}, nil
+}
+
+const (
+	ResNameIdentityCenterConfiguration = "Identity Center Configuration"
+)
+
+type resourceIdentityCenterConfiguration struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentityCenterConfiguration) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lakeformation_identity_center_configuration"
+}
+
+func (r *resourceIdentityCenterConfiguration) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCatalogID: catalogIDSchemaOptionalComputed(),
+			names.AttrID:        framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_share": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"share_recipients": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"external_filtering": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[externalFilteringConfiguration](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorized_targets": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+						},
+						names.AttrStatus: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EnableStatus](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIdentityCenterConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var plan resourceIdentityCenterConfigurationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogID := r.Meta().AccountID
+	if !plan.CatalogID.IsUnknown() && !plan.CatalogID.IsNull() {
+		catalogID = plan.CatalogID.ValueString()
+	}
+
+	in := &lakeformation.CreateLakeFormationIdentityCenterConfigurationInput{
+		CatalogId:       aws.String(catalogID),
+		InstanceArn:     plan.InstanceARN.ValueStringPointer(),
+		ShareRecipients: expandShareRecipients(ctx, plan.ShareRecipients),
+	}
+
+	if !plan.ExternalFiltering.IsNull() {
+		in.ExternalFiltering = &awstypes.ExternalFilteringConfiguration{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan.ExternalFiltering, in.ExternalFiltering)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_, err := conn.CreateLakeFormationIdentityCenterConfiguration(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameIdentityCenterConfiguration, catalogID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIdentityCenterConfigurationByID(ctx, conn, catalogID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameIdentityCenterConfiguration, catalogID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = fwflex.StringValueToFramework(ctx, catalogID)
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceIdentityCenterConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceIdentityCenterConfigurationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findIdentityCenterConfigurationByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionSetting, ResNameIdentityCenterConfiguration, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentityCenterConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var plan, state resourceIdentityCenterConfigurationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ExternalFiltering.Equal(state.ExternalFiltering) || !plan.ShareRecipients.Equal(state.ShareRecipients) {
+		in := &lakeformation.UpdateLakeFormationIdentityCenterConfigurationInput{
+			CatalogId:       state.ID.ValueStringPointer(),
+			ShareRecipients: expandShareRecipients(ctx, plan.ShareRecipients),
+		}
+
+		if !plan.ExternalFiltering.IsNull() {
+			in.ExternalFiltering = &awstypes.ExternalFilteringConfiguration{}
+			resp.Diagnostics.Append(fwflex.Expand(ctx, plan.ExternalFiltering, in.ExternalFiltering)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		} else {
+			// Removing the block disables external filtering.
+			in.ExternalFiltering = &awstypes.ExternalFilteringConfiguration{
+				AuthorizedTargets: []string{},
+				Status:            awstypes.EnableStatusDisabled,
+			}
+		}
+
+		_, err := conn.UpdateLakeFormationIdentityCenterConfiguration(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNameIdentityCenterConfiguration, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		output, err := findIdentityCenterConfigurationByID(ctx, conn, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNameIdentityCenterConfiguration, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentityCenterConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceIdentityCenterConfigurationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteLakeFormationIdentityCenterConfiguration(ctx, &lakeformation.DeleteLakeFormationIdentityCenterConfigurationInput{
+		CatalogId: state.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionDeleting, ResNameIdentityCenterConfiguration, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findIdentityCenterConfigurationByID(ctx context.Context, conn *lakeformation.Client, catalogID string) (*lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput, error) {
+	in := &lakeformation.DescribeLakeFormationIdentityCenterConfigurationInput{
+		CatalogId: aws.String(catalogID),
+	}
+
+	out, err := conn.DescribeLakeFormationIdentityCenterConfiguration(ctx, in)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ApplicationArn == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandShareRecipients(ctx context.Context, v fwtypes.SetValueOf[types.String]) []awstypes.DataLakePrincipal {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return tfslices.ApplyToAll(fwflex.ExpandFrameworkStringValueSet(ctx, v), func(identifier string) awstypes.DataLakePrincipal {
+		return awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(identifier),
+		}
+	})
+}
+
+type resourceIdentityCenterConfigurationData struct {
+	ApplicationARN    types.String                                                    `tfsdk:"application_arn"`
+	CatalogID         types.String                                                    `tfsdk:"catalog_id"`
+	ExternalFiltering fwtypes.ListNestedObjectValueOf[externalFilteringConfiguration] `tfsdk:"external_filtering"`
+	ID                types.String                                                    `tfsdk:"id"`
+	InstanceARN       fwtypes.ARN                                                     `tfsdk:"instance_arn"`
+	ResourceShare     types.String                                                    `tfsdk:"resource_share"`
+	ShareRecipients   fwtypes.SetValueOf[types.String]                                `tfsdk:"share_recipients"`
+}
+
+func (data *resourceIdentityCenterConfigurationData) refreshFromOutput(ctx context.Context, output *lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ApplicationARN = fwflex.StringToFramework(ctx, output.ApplicationArn)
+	data.CatalogID = fwflex.StringToFramework(ctx, output.CatalogId)
+	data.InstanceARN = fwtypes.ARNValue(aws.ToString(output.InstanceArn))
+	data.ResourceShare = fwflex.StringToFramework(ctx, output.ResourceShare)
+
+	// A disabled external filtering configuration with no block configured is equivalent to an absent block.
+	if v := output.ExternalFiltering; v != nil && (v.Status == awstypes.EnableStatusEnabled || !data.ExternalFiltering.IsNull()) {
+		diags.Append(fwflex.Flatten(ctx, v, &data.ExternalFiltering)...)
+	} else {
+		data.ExternalFiltering = fwtypes.NewListNestedObjectValueOfNull[externalFilteringConfiguration](ctx)
+	}
+
+	if len(output.ShareRecipients) > 0 {
+		diags.Append(fwflex.Flatten(ctx, tfslices.ApplyToAll(output.ShareRecipients, func(v awstypes.DataLakePrincipal) string {
+			return aws.ToString(v.DataLakePrincipalIdentifier)
+		}), &data.ShareRecipients)...)
+	} else {
+		data.ShareRecipients = fwtypes.NewSetValueOfNull[types.String](ctx)
+	}
+
+	return diags
+}
+
+type externalFilteringConfiguration struct {
+	AuthorizedTargets fwtypes.SetValueOf[types.String]          `tfsdk:"authorized_targets"`
+	Status            fwtypes.StringEnum[awstypes.EnableStatus] `tfsdk:"status"`
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIdentityCenterConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput
+	resourceName := "aws_lakeformation_identity_center_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityCenterConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterConfigurationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "application_arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrCatalogID),
+					resource.TestCheckResourceAttr(resourceName, "external_filtering.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityCenterConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput
+	resourceName := "aws_lakeformation_identity_center_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityCenterConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterConfigurationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceIdentityCenterConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityCenterConfiguration_externalFiltering(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_identity_center_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityCenterConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterConfigurationConfig_externalFiltering(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "external_filtering.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "external_filtering.0.authorized_targets.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "external_filtering.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIdentityCenterConfigurationConfig_externalFiltering(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityCenterConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "external_filtering.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "external_filtering.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityCenterConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_identity_center_configuration" {
+				continue
+			}
+
+			_, err := tflakeformation.FindIdentityCenterConfigurationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.LakeFormation, create.ErrActionCheckingDestroyed, tflakeformation.ResNameIdentityCenterConfiguration, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.LakeFormation, create.ErrActionCheckingDestroyed, tflakeformation.ResNameIdentityCenterConfiguration, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityCenterConfigurationExists(ctx context.Context, name string, v *lakeformation.DescribeLakeFormationIdentityCenterConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameIdentityCenterConfiguration, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		output, err := tflakeformation.FindIdentityCenterConfigurationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameIdentityCenterConfiguration, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIdentityCenterConfigurationConfig_basic() string {
+	return `
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_lakeformation_identity_center_configuration" "test" {
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+`
+}
+
+func testAccIdentityCenterConfigurationConfig_externalFiltering(rName, status string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_application" "test" {
+  name                     = %[1]q
+  application_provider_arn = "arn:aws:sso::aws:applicationProvider/custom"
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_lakeformation_identity_center_configuration" "test" {
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+
+  external_filtering {
+    authorized_targets = [aws_ssoadmin_application.test.application_arn]
+    status             = %[2]q
+  }
+}
+`, rName, status)
+}
@@ -13,6 +13,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"BatchPermissions": {
+			acctest.CtBasic:      testAccBatchPermissions_basic,
+			acctest.CtDisappears: testAccBatchPermissions_disappears,
+			"update":             testAccBatchPermissions_update,
+		},
 		"DataLakeSettings": {
 			acctest.CtBasic:      testAccDataLakeSettings_basic,
 			acctest.CtDisappears: testAccDataLakeSettings_disappears,
@@ -29,6 +34,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			acctest.CtBasic:  testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"IdentityCenterConfiguration": {
+			acctest.CtBasic:      testAccIdentityCenterConfiguration_basic,
+			acctest.CtDisappears: testAccIdentityCenterConfiguration_disappears,
+			"externalFiltering":  testAccIdentityCenterConfiguration_externalFiltering,
+		},
 		"PermissionsBasic": {
 			acctest.CtBasic:       testAccPermissions_basic,
 			"database":            testAccPermissions_database,
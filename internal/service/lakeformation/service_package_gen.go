@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceDataCellsFilter,
 			Name:    "Data Cells Filter",
 		},
+		{
+			Factory: newResourceIdentityCenterConfiguration,
+			Name:    "Identity Center Configuration",
+		},
 		{
 			Factory: newResourceResourceLFTag,
 			Name:    "Resource LF Tag",
@@ -51,6 +55,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBatchPermissions,
+			TypeName: "aws_lakeformation_batch_permissions",
+			Name:     "Batch Permissions",
+		},
 		{
 			Factory:  ResourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
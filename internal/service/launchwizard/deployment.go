@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Deployment")
+// @Tags(identifierAttribute="arn")
+func newResourceDeployment(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDeployment{}
+	r.SetDefaultCreateTimeout(180 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type resourceDeployment struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[resourceDeploymentData]
+	framework.WithTimeouts
+}
+
+const (
+	ResNameDeployment = "Deployment"
+)
+
+func (r *resourceDeployment) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_launchwizard_deployment"
+}
+
+func (r *resourceDeployment) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"deployment_pattern_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_group": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"specifications": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDeployment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().LaunchWizardClient(ctx)
+	var plan resourceDeploymentData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &launchwizard.CreateDeploymentInput{
+		DeploymentPatternName: flex.StringFromFramework(ctx, plan.DeploymentPatternName),
+		Name:                  flex.StringFromFramework(ctx, plan.Name),
+		Specifications:        flex.ExpandFrameworkStringValueMap(ctx, plan.Specifications),
+		Tags:                  getTagsIn(ctx),
+		WorkloadName:          flex.StringFromFramework(ctx, plan.WorkloadName),
+	}
+
+	out, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LaunchWizard, create.ErrActionCreating, ResNameDeployment, plan.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringToFramework(ctx, out.DeploymentId)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	deployment, err := waitDeploymentCreated(ctx, conn, state.ID.ValueString(), createTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LaunchWizard, create.ErrActionWaitingForCreation, ResNameDeployment, plan.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, deployment)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDeployment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().LaunchWizardClient(ctx)
+	var state resourceDeploymentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findDeploymentByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LaunchWizard, create.ErrActionReading, ResNameDeployment, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDeployment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().LaunchWizardClient(ctx)
+	var state resourceDeploymentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Launch Wizard Deployment", map[string]interface{}{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	_, err := conn.DeleteDeployment(ctx, &launchwizard.DeleteDeploymentInput{
+		DeploymentId: flex.StringFromFramework(ctx, state.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LaunchWizard, create.ErrActionDeleting, ResNameDeployment, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitDeploymentDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LaunchWizard, create.ErrActionWaitingForDeletion, ResNameDeployment, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceDeployment) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceDeployment) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceDeploymentData struct {
+	ARN                   types.String   `tfsdk:"arn"`
+	DeploymentPatternName types.String   `tfsdk:"deployment_pattern_name"`
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	ResourceGroup         types.String   `tfsdk:"resource_group"`
+	Specifications        types.Map      `tfsdk:"specifications"`
+	Status                types.String   `tfsdk:"status"`
+	Tags                  types.Map      `tfsdk:"tags"`
+	TagsAll               types.Map      `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	WorkloadName          types.String   `tfsdk:"workload_name"`
+}
+
+func (r *resourceDeploymentData) refreshFromOutput(ctx context.Context, output *awstypes.DeploymentData) {
+	r.ARN = flex.StringToFramework(ctx, output.DeploymentArn)
+	r.DeploymentPatternName = flex.StringToFramework(ctx, output.PatternName)
+	r.Name = flex.StringToFramework(ctx, output.Name)
+	r.ResourceGroup = flex.StringToFramework(ctx, output.ResourceGroup)
+	r.Specifications = flex.FlattenFrameworkStringValueMap(ctx, output.Specifications)
+	r.Status = flex.StringValueToFramework(ctx, output.Status)
+	r.WorkloadName = flex.StringToFramework(ctx, output.WorkloadName)
+}
+
+func waitDeploymentCreated(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.DeploymentStatusCreating, awstypes.DeploymentStatusInProgress, awstypes.DeploymentStatusValidating),
+		Target:                    enum.Slice(awstypes.DeploymentStatusCompleted),
+		Refresh:                   statusDeployment(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentDeleted(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeploymentStatusCompleted, awstypes.DeploymentStatusDeleteInitiating, awstypes.DeploymentStatusDeleteInProgress, awstypes.DeploymentStatusFailed),
+		Target:  []string{},
+		Refresh: statusDeployment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusDeployment(ctx context.Context, conn *launchwizard.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findDeploymentByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findDeploymentByID(ctx context.Context, conn *launchwizard.Client, id string) (*awstypes.DeploymentData, error) {
+	in := &launchwizard.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+	out, err := conn.GetDeployment(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Deployment == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := out.Deployment.Status; status == awstypes.DeploymentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: in,
+		}
+	}
+
+	return out.Deployment, nil
+}
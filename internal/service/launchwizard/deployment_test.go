@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflaunchwizard "github.com/hashicorp/terraform-provider-aws/internal/service/launchwizard"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Launch Wizard deployments provision complete workloads (e.g. SAP or SQL Server)
+// whose specifications depend on the account's networking, key pairs and secrets,
+// so they are supplied through environment variables.
+const (
+	envVarWorkloadName          = "LAUNCHWIZARD_WORKLOAD_NAME"
+	envVarDeploymentPatternName = "LAUNCHWIZARD_DEPLOYMENT_PATTERN_NAME"
+	envVarSpecifications        = "LAUNCHWIZARD_SPECIFICATIONS"
+)
+
+func TestAccLaunchWizardDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	workloadName := acctest.SkipIfEnvVarNotSet(t, envVarWorkloadName)
+	patternName := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentPatternName)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarSpecifications)
+	var deployment awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, workloadName, patternName, specifications),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "deployment_pattern_name", patternName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DeploymentStatusCompleted)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", workloadName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	workloadName := acctest.SkipIfEnvVarNotSet(t, envVarWorkloadName)
+	patternName := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentPatternName)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarSpecifications)
+	var deployment awstypes.DeploymentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launchwizard_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, workloadName, patternName, specifications),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflaunchwizard.ResourceDeployment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launchwizard_deployment" {
+				continue
+			}
+
+			_, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LaunchWizard, create.ErrActionCheckingDestroyed, tflaunchwizard.ResNameDeployment, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, name string, deployment *awstypes.DeploymentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LaunchWizard, create.ErrActionCheckingExistence, tflaunchwizard.ResNameDeployment, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LaunchWizard, create.ErrActionCheckingExistence, tflaunchwizard.ResNameDeployment, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+		resp, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.LaunchWizard, create.ErrActionCheckingExistence, tflaunchwizard.ResNameDeployment, rs.Primary.ID, err)
+		}
+
+		*deployment = *resp
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	input := &launchwizard.ListWorkloadsInput{}
+	_, err := conn.ListWorkloads(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccDeploymentConfig_basic(rName, workloadName, patternName, specifications string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = %[2]q
+  deployment_pattern_name = %[3]q
+  specifications          = jsondecode(%[4]q)
+}
+`, rName, workloadName, patternName, specifications)
+}
@@ -58,6 +58,10 @@ func ResourceContainerService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"current_deployment_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"is_disabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -252,6 +256,11 @@ func resourceContainerServiceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set(names.AttrARN, cs.Arn)
 	d.Set(names.AttrAvailabilityZone, cs.Location.AvailabilityZone)
 	d.Set(names.AttrCreatedAt, aws.ToTime(cs.CreatedAt).Format(time.RFC3339))
+	if cs.CurrentDeployment != nil {
+		d.Set("current_deployment_version", cs.CurrentDeployment.Version)
+	} else {
+		d.Set("current_deployment_version", nil)
+	}
 	d.Set("power_id", cs.PowerId)
 	d.Set("principal_arn", cs.PrincipalArn)
 	d.Set("private_domain_name", cs.PrivateDomainName)
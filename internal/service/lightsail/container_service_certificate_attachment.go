@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lightsail_container_service_certificate_attachment", name="Container Service Certificate Attachment")
+func ResourceContainerServiceCertificateAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerServiceCertificateAttachmentCreate,
+		ReadWithoutTimeout:   resourceContainerServiceCertificateAttachmentRead,
+		UpdateWithoutTimeout: resourceContainerServiceCertificateAttachmentUpdate,
+		DeleteWithoutTimeout: resourceContainerServiceCertificateAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			names.AttrServiceName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	containerServiceCertificateAttachmentResourceIDPartCount = 2
+)
+
+func resourceContainerServiceCertificateAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	serviceName := d.Get(names.AttrServiceName).(string)
+	certificateName := d.Get("certificate_name").(string)
+	id, err := flex.FlattenResourceId([]string{serviceName, certificateName}, containerServiceCertificateAttachmentResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainNames := flex.ExpandStringValueSet(d.Get("domain_names").(*schema.Set))
+	if err := putContainerServicePublicDomainNames(ctx, conn, serviceName, certificateName, domainNames, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lightsail Container Service Certificate Attachment (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceContainerServiceCertificateAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceContainerServiceCertificateAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), containerServiceCertificateAttachmentResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	serviceName, certificateName := parts[0], parts[1]
+	domainNames, err := FindContainerServiceCertificateAttachmentByTwoPartKey(ctx, conn, serviceName, certificateName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Container Service Certificate Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lightsail Container Service Certificate Attachment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("certificate_name", certificateName)
+	d.Set("domain_names", domainNames)
+	d.Set(names.AttrServiceName, serviceName)
+
+	return diags
+}
+
+func resourceContainerServiceCertificateAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	domainNames := flex.ExpandStringValueSet(d.Get("domain_names").(*schema.Set))
+	if err := putContainerServicePublicDomainNames(ctx, conn, d.Get(names.AttrServiceName).(string), d.Get("certificate_name").(string), domainNames, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lightsail Container Service Certificate Attachment (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContainerServiceCertificateAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceContainerServiceCertificateAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	log.Printf("[INFO] Deleting Lightsail Container Service Certificate Attachment: %s", d.Id())
+	// An empty list of domain names detaches the certificate.
+	err := putContainerServicePublicDomainNames(ctx, conn, d.Get(names.AttrServiceName).(string), d.Get("certificate_name").(string), []string{}, d.Timeout(schema.TimeoutDelete))
+
+	if IsANotFoundError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lightsail Container Service Certificate Attachment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// putContainerServicePublicDomainNames sets the domain names served with the specified certificate.
+// Certificates not present in the request are left unchanged, so updates are serialized per service.
+func putContainerServicePublicDomainNames(ctx context.Context, conn *lightsail.Client, serviceName, certificateName string, domainNames []string, timeout time.Duration) error {
+	conns.GlobalMutexKV.Lock(serviceName)
+	defer conns.GlobalMutexKV.Unlock(serviceName)
+
+	input := &lightsail.UpdateContainerServiceInput{
+		PublicDomainNames: map[string][]string{
+			certificateName: domainNames,
+		},
+		ServiceName: aws.String(serviceName),
+	}
+
+	if _, err := conn.UpdateContainerService(ctx, input); err != nil {
+		return err
+	}
+
+	if err := waitContainerServiceUpdated(ctx, conn, serviceName, timeout); err != nil {
+		return fmt.Errorf("waiting for Lightsail Container Service (%s) update: %w", serviceName, err)
+	}
+
+	return nil
+}
+
+func FindContainerServiceCertificateAttachmentByTwoPartKey(ctx context.Context, conn *lightsail.Client, serviceName, certificateName string) ([]string, error) {
+	cs, err := FindContainerServiceByName(ctx, conn, serviceName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	domainNames, ok := cs.PublicDomainNames[certificateName]
+
+	if !ok || len(domainNames) == 0 {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("certificate %s is not attached to Lightsail Container Service %s", certificateName, serviceName),
+		}
+	}
+
+	return domainNames, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailContainerServiceCertificateAttachment_certificateNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerServiceCertificateAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerServiceCertificateAttachmentConfig_basic(rName),
+				ExpectError: regexache.MustCompile(`do not exist`),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerServiceCertificateAttachmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lightsail_container_service_certificate_attachment" {
+				continue
+			}
+
+			_, err := tflightsail.FindContainerServiceCertificateAttachmentByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrServiceName], rs.Primary.Attributes["certificate_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lightsail Container Service Certificate Attachment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerServiceCertificateAttachmentConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+
+  lifecycle {
+    ignore_changes = [public_domain_names]
+  }
+}
+
+resource "aws_lightsail_container_service_certificate_attachment" "test" {
+  service_name     = aws_lightsail_container_service.test.name
+  certificate_name = "NonExsitingCertificate"
+  domain_names = [
+    "nonexisting1.com",
+    "nonexisting2.com",
+  ]
+}
+`, rName)
+}
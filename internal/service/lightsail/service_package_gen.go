@@ -61,6 +61,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "ContainerService",
 			},
 		},
+		{
+			Factory:  ResourceContainerServiceCertificateAttachment,
+			TypeName: "aws_lightsail_container_service_certificate_attachment",
+			Name:     "Container Service Certificate Attachment",
+		},
 		{
 			Factory:  ResourceContainerServiceDeploymentVersion,
 			TypeName: "aws_lightsail_container_service_deployment_version",
@@ -225,7 +225,7 @@ func waitContainerServiceDeploymentVersionActive(ctx context.Context, conn *ligh
 
 	if output, ok := outputRaw.(*types.ContainerServiceDeployment); ok {
 		if output.State == types.ContainerServiceDeploymentStateFailed {
-			tfresource.SetLastError(err, errors.New("The deployment failed. Use the GetContainerLog action to view the log events for the containers in the deployment to try to determine the reason for the failure."))
+			tfresource.SetLastError(err, errors.New("The deployment failed. The container service continues to run its previously active deployment, if any. Use the GetContainerLog action to view the log events for the containers in the deployment to try to determine the reason for the failure."))
 		}
 
 		return err
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreateTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrKey),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "key_arn", "geo", fmt.Sprintf("api-key/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "key_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "no_expiry", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_actions.*", "geo:GetMap*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					acctest.CheckResourceAttrRFC3339(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"no_expiry"},
+			},
+		},
+	})
+}
+
+func TestAccLocationKey_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflocation.ResourceKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationKey_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccKeyConfig_updated(rName, "updated", "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "expire_time", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_referers.*", "https://example.com/*"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationKey_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"no_expiry"},
+			},
+			{
+				Config: testAccKeyConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccKeyConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_key" {
+				continue
+			}
+
+			input := &locationservice.DescribeKeyInput{
+				KeyName: aws.String(rs.Primary.ID),
+			}
+
+			_, err := conn.DescribeKeyWithContext(ctx, input)
+			if err != nil {
+				if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+					return nil
+				}
+				return err
+			}
+
+			return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameKey, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameKey, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameKey, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+		_, err := conn.DescribeKeyWithContext(ctx, &locationservice.DescribeKeyInput{
+			KeyName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameKey, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccKeyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_map" "test" {
+  map_name = %[1]q
+
+  configuration {
+    style = "VectorHereBerlin"
+  }
+}
+`, rName)
+}
+
+func testAccKeyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+}
+`, rName))
+}
+
+func testAccKeyConfig_updated(rName, description, expireTime string) string {
+	return acctest.ConfigCompose(testAccKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_key" "test" {
+  key_name    = %[1]q
+  description = %[2]q
+  expire_time = %[3]q
+
+  restrictions {
+    allow_actions   = ["geo:GetMapTile", "geo:GetMapStyleDescriptor"]
+    allow_referers  = ["https://example.com/*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+}
+`, rName, description, expireTime))
+}
+
+func testAccKeyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccKeyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
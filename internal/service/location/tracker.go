@@ -42,6 +42,17 @@ func ResourceTracker() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1000),
 			},
+			"event_bridge_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key_enable_geospatial_queries": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{names.AttrKMSKeyID},
+			},
 			names.AttrKMSKeyID: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -86,6 +97,14 @@ func resourceTrackerCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("event_bridge_enabled"); ok {
+		input.EventBridgeEnabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key_enable_geospatial_queries"); ok {
+		input.KmsKeyEnableGeospatialQueries = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
 		input.KmsKeyId = aws.String(v.(string))
 	}
@@ -139,6 +158,8 @@ func resourceTrackerRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.Set(names.AttrCreateTime, aws.TimeValue(output.CreateTime).Format(time.RFC3339))
 	d.Set(names.AttrDescription, output.Description)
+	d.Set("event_bridge_enabled", output.EventBridgeEnabled)
+	d.Set("kms_key_enable_geospatial_queries", output.KmsKeyEnableGeospatialQueries)
 	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
 	d.Set("position_filtering", output.PositionFiltering)
 
@@ -155,7 +176,7 @@ func resourceTrackerUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LocationConn(ctx)
 
-	if d.HasChanges(names.AttrDescription, "position_filtering") {
+	if d.HasChanges(names.AttrDescription, "event_bridge_enabled", "kms_key_enable_geospatial_queries", "position_filtering") {
 		input := &locationservice.UpdateTrackerInput{
 			TrackerName: aws.String(d.Id()),
 		}
@@ -164,6 +185,14 @@ func resourceTrackerUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.Description = aws.String(v.(string))
 		}
 
+		if d.HasChange("event_bridge_enabled") {
+			input.EventBridgeEnabled = aws.Bool(d.Get("event_bridge_enabled").(bool))
+		}
+
+		if d.HasChange("kms_key_enable_geospatial_queries") {
+			input.KmsKeyEnableGeospatialQueries = aws.Bool(d.Get("kms_key_enable_geospatial_queries").(bool))
+		}
+
 		if v, ok := d.GetOk("position_filtering"); ok {
 			input.PositionFiltering = aws.String(v.(string))
 		}
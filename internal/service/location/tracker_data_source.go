@@ -31,6 +31,14 @@ func DataSourceTracker() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"event_bridge_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"kms_key_enable_geospatial_queries": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrKMSKeyID: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -78,6 +86,8 @@ func dataSourceTrackerRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.SetId(aws.StringValue(output.TrackerName))
 	d.Set(names.AttrCreateTime, aws.TimeValue(output.CreateTime).Format(time.RFC3339))
 	d.Set(names.AttrDescription, output.Description)
+	d.Set("event_bridge_enabled", output.EventBridgeEnabled)
+	d.Set("kms_key_enable_geospatial_queries", output.KmsKeyEnableGeospatialQueries)
 	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
 	d.Set("position_filtering", output.PositionFiltering)
 	d.Set(names.AttrTags, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).IgnoreTagsConfig).Map())
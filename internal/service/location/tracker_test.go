@@ -37,6 +37,8 @@ func TestAccLocationTracker_basic(t *testing.T) {
 					testAccCheckTrackerExists(ctx, resourceName),
 					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreateTime),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "event_bridge_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_enable_geospatial_queries", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
 					resource.TestCheckResourceAttr(resourceName, "position_filtering", locationservice.PositionFilteringTimeBased),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
@@ -111,6 +113,75 @@ func TestAccLocationTracker_description(t *testing.T) {
 	})
 }
 
+func TestAccLocationTracker_eventBridgeEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_tracker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrackerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrackerConfig_eventBridgeEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrackerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_bridge_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrackerConfig_eventBridgeEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrackerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_bridge_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationTracker_kmsKeyEnableGeospatialQueries(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_tracker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrackerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrackerConfig_kmsKeyEnableGeospatialQueries(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrackerExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_enable_geospatial_queries", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrackerConfig_kmsKeyEnableGeospatialQueries(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrackerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_enable_geospatial_queries", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLocationTracker_kmsKeyID(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -303,6 +374,29 @@ resource "aws_location_tracker" "test" {
 `, rName)
 }
 
+func testAccTrackerConfig_eventBridgeEnabled(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_location_tracker" "test" {
+  tracker_name         = %[1]q
+  event_bridge_enabled = %[2]t
+}
+`, rName, enabled)
+}
+
+func testAccTrackerConfig_kmsKeyEnableGeospatialQueries(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_location_tracker" "test" {
+  tracker_name                      = %[1]q
+  kms_key_id                        = aws_kms_key.test.arn
+  kms_key_enable_geospatial_queries = %[2]t
+}
+`, rName, enabled)
+}
+
 func testAccTrackerConfig_positionFiltering(rName, positionFiltering string) string {
 	return fmt.Sprintf(`
 resource "aws_location_tracker" "test" {
@@ -5,6 +5,7 @@ package logs
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -110,6 +111,18 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	_, err := conn.CreateLogGroup(ctx, input)
 
+	if errs.IsA[*types.ResourceAlreadyExistsException](err) && meta.(*conns.AWSClient).AdoptExistingResources(ctx) {
+		if err := adoptLogGroup(ctx, conn, d, meta.(*conns.AWSClient).IgnoreTagsConfig, name); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adopting CloudWatch Logs Log Group (%s): %s", name, err)
+		}
+
+		d.SetId(name)
+
+		diags = create.AppendDiagAdoptedWarning(diags, names.Logs, "Log Group", name)
+
+		return append(diags, resourceGroupRead(ctx, d, meta)...)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Logs Log Group (%s): %s", name, err)
 	}
@@ -245,6 +258,66 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// adoptLogGroup updates an existing log group to match the configuration.
+// A log group's class cannot be changed, so any difference is shown in the next plan.
+func adoptLogGroup(ctx context.Context, conn *cloudwatchlogs.Client, d *schema.ResourceData, ignoreTagsConfig *tftags.IgnoreConfig, name string) error {
+	lg, err := findLogGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("reading log group: %w", err)
+	}
+
+	if v, ok := d.GetOk("retention_in_days"); ok {
+		if int32(v.(int)) != aws.ToInt32(lg.RetentionInDays) {
+			input := &cloudwatchlogs.PutRetentionPolicyInput{
+				LogGroupName:    aws.String(name),
+				RetentionInDays: aws.Int32(int32(v.(int))),
+			}
+
+			if _, err := conn.PutRetentionPolicy(ctx, input); err != nil {
+				return fmt.Errorf("setting retention policy: %w", err)
+			}
+		}
+	} else if lg.RetentionInDays != nil {
+		if _, err := conn.DeleteRetentionPolicy(ctx, &cloudwatchlogs.DeleteRetentionPolicyInput{
+			LogGroupName: aws.String(name),
+		}); err != nil {
+			return fmt.Errorf("deleting retention policy: %w", err)
+		}
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		if v.(string) != aws.ToString(lg.KmsKeyId) {
+			if _, err := conn.AssociateKmsKey(ctx, &cloudwatchlogs.AssociateKmsKeyInput{
+				KmsKeyId:     aws.String(v.(string)),
+				LogGroupName: aws.String(name),
+			}); err != nil {
+				return fmt.Errorf("associating KMS key: %w", err)
+			}
+		}
+	} else if lg.KmsKeyId != nil {
+		if _, err := conn.DisassociateKmsKey(ctx, &cloudwatchlogs.DisassociateKmsKeyInput{
+			LogGroupName: aws.String(name),
+		}); err != nil {
+			return fmt.Errorf("disassociating KMS key: %w", err)
+		}
+	}
+
+	arn := TrimLogGroupARNWildcardSuffix(aws.ToString(lg.Arn))
+
+	oldTags, err := listTags(ctx, conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %w", err)
+	}
+
+	if err := updateTags(ctx, conn, arn, oldTags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig), getTagsIn(ctx)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func findLogGroupByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*types.LogGroup, error) {
 	input := &cloudwatchlogs.DescribeLogGroupsInput{
 		LogGroupNamePrefix: aws.String(name),
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -330,6 +332,38 @@ func TestAccLogsGroup_skipDestroyInconsistentPlan(t *testing.T) {
 	})
 }
 
+func TestAccLogsGroup_adoptExisting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.LogGroup
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					conn := acctest.ProviderMeta(ctx, t).LogsClient(ctx)
+
+					if err := testAccCreateLogGroupOutOfBand(ctx, conn, rName); err != nil {
+						t.Fatalf("creating CloudWatch Logs Log Group (%s): %s", rName, err)
+					}
+				},
+				Config: testAccGroupConfig_adoptExisting(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLogGroupExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLogGroupExists(ctx context.Context, t *testing.T, n string, v *types.LogGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -395,6 +429,26 @@ func testAccCheckGroupNoDestroy(ctx context.Context, t *testing.T) resource.Test
 	}
 }
 
+func testAccCreateLogGroupOutOfBand(ctx context.Context, conn *cloudwatchlogs.Client, name string) error {
+	_, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(name),
+		Tags: map[string]string{
+			"OutOfBand": acctest.CtTrue,
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String(name),
+		RetentionInDays: aws.Int32(1),
+	})
+
+	return err
+}
+
 func testAccGroupConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
@@ -482,3 +536,11 @@ resource "aws_cloudwatch_log_group" "test" {
 }
 `, rName)
 }
+
+func testAccGroupConfig_adoptExisting(rName string, val int) string {
+	return acctest.ConfigCompose(`
+provider "aws" {
+  adopt_existing_resources = true
+}
+`, testAccGroupConfig_retentionPolicy(rName, val))
+}
@@ -3,9 +3,19 @@
 
 package mediaconvert
 
+import (
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+)
+
 // Exports for use in tests only.
 var (
-	ResourceQueue = resourceQueue
+	ResourceJobTemplate = resourceJobTemplate
+	ResourcePreset      = resourcePreset
+	ResourceQueue       = resourceQueue
+
+	FindJobTemplateByName = findJobTemplateByName
+	FindPresetByName      = findPresetByName
+	FindQueueByName       = findQueueByName
 
-	FindQueueByName = findQueueByName
+	NormalizeJobTemplateSettingsJSON = normalizeSettingsJSON[types.JobTemplateSettings]
 )
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_media_convert_job_template", name="Job Template")
+// @Tags(identifierAttribute="arn")
+func resourceJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobTemplateCreate,
+		ReadWithoutTimeout:   resourceJobTemplateRead,
+		UpdateWithoutTimeout: resourceJobTemplateUpdate,
+		DeleteWithoutTimeout: resourceJobTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AccelerationMode](),
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hop_destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrPriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-50, 50),
+						},
+						"queue": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"wait_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrPriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"settings_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentSettingsJSONDiffs[types.JobTemplateSettings],
+			},
+			"status_update_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.StatusUpdateInterval](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	settings, err := expandSettingsJSON[types.JobTemplateSettings](d.Get("settings_json").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:     aws.String(name),
+		Priority: aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+		Settings: settings,
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccelerationSettings = expandAccelerationSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hop_destinations"); ok && len(v.([]interface{})) > 0 {
+		input.HopDestinations = expandHopDestinations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = types.StatusUpdateInterval(v.(string))
+	}
+
+	output, err := conn.CreateJobTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.JobTemplate.Name))
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	jobTemplate, err := findJobTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if jobTemplate.AccelerationSettings != nil {
+		if err := d.Set("acceleration_settings", []interface{}{flattenAccelerationSettings(jobTemplate.AccelerationSettings)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting acceleration_settings: %s", err)
+		}
+	} else {
+		d.Set("acceleration_settings", nil)
+	}
+	d.Set(names.AttrARN, jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set(names.AttrDescription, jobTemplate.Description)
+	if err := d.Set("hop_destinations", flattenHopDestinations(jobTemplate.HopDestinations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hop_destinations: %s", err)
+	}
+	d.Set(names.AttrName, jobTemplate.Name)
+	d.Set(names.AttrPriority, jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	settings, err := flattenSettingsJSON(jobTemplate.Settings)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set("settings_json", settings)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+	d.Set(names.AttrType, jobTemplate.Type)
+
+	return diags
+}
+
+func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		settings, err := expandSettingsJSON[types.JobTemplateSettings](d.Get("settings_json").(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Name:     aws.String(d.Id()),
+			Priority: aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+			Settings: settings,
+		}
+
+		if v, ok := d.GetOk("acceleration_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AccelerationSettings = expandAccelerationSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("category"); ok {
+			input.Category = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("hop_destinations"); ok && len(v.([]interface{})) > 0 {
+			input.HopDestinations = expandHopDestinations(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("status_update_interval"); ok {
+			input.StatusUpdateInterval = types.StatusUpdateInterval(v.(string))
+		}
+
+		_, err = conn.UpdateJobTemplate(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Media Convert Job Template: %s", d.Id())
+	_, err := conn.DeleteJobTemplate(ctx, &mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJobTemplateByName(ctx context.Context, conn *mediaconvert.Client, name string) (*types.JobTemplate, error) {
+	input := &mediaconvert.GetJobTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetJobTemplate(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobTemplate, nil
+}
+
+func expandAccelerationSettings(tfMap map[string]interface{}) *types.AccelerationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AccelerationSettings{}
+
+	if v, ok := tfMap[names.AttrMode].(string); ok && v != "" {
+		apiObject.Mode = types.AccelerationMode(v)
+	}
+
+	return apiObject
+}
+
+func expandHopDestinations(tfList []interface{}) []types.HopDestination {
+	var apiObjects []types.HopDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.HopDestination{}
+
+		if v, ok := tfMap[names.AttrPriority].(int); ok {
+			apiObject.Priority = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["queue"].(string); ok && v != "" {
+			apiObject.Queue = aws.String(v)
+		}
+
+		if v, ok := tfMap["wait_minutes"].(int); ok && v != 0 {
+			apiObject.WaitMinutes = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAccelerationSettings(apiObject *types.AccelerationSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrMode: apiObject.Mode,
+	}
+
+	return tfMap
+}
+
+func flattenHopDestinations(apiObjects []types.HopDestination) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrPriority: aws.ToInt32(apiObject.Priority),
+			"queue":            aws.ToString(apiObject.Queue),
+			"wait_minutes":     aws.ToInt32(apiObject.WaitMinutes),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertJobTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName, 1280),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "hop_destinations.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "queue"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings_json"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, string(types.TypeCustom)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_basic(rName, 1920),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName, 1280),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.0.mode", string(types.AccelerationModePreferred)),
+					resource.TestCheckResourceAttr(resourceName, "category", "vod"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "hop_destinations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hop_destinations.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "hop_destinations.0.queue", "aws_media_convert_queue.hop", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "hop_destinations.0.wait_minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "5"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", "aws_media_convert_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", string(types.StatusUpdateIntervalSeconds30)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_job_template" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Job Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateExists(ctx context.Context, n string, v *types.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobTemplateConfig_settingsJSON(width int) string {
+	return fmt.Sprintf(`
+  settings_json = jsonencode({
+    timecodeConfig = {
+      source = "ZEROBASED"
+    }
+    outputGroups = [{
+      name = "File Group"
+      outputGroupSettings = {
+        type = "FILE_GROUP_SETTINGS"
+        fileGroupSettings = {
+          destination = "s3://example-bucket/output/"
+        }
+      }
+      outputs = [{
+        containerSettings = {
+          container = "MP4"
+          mp4Settings = {}
+        }
+        videoDescription = {
+          width  = %[1]d
+          height = 720
+          codecSettings = {
+            codec = "H_264"
+            h264Settings = {
+              rateControlMode    = "QVBR"
+              maxBitrate         = 5000000
+              sceneChangeDetect  = "TRANSITION_DETECTION"
+              qualityTuningLevel = "SINGLE_PASS"
+            }
+          }
+        }
+        audioDescriptions = [{
+          codecSettings = {
+            codec = "AAC"
+            aacSettings = {
+              bitrate    = 96000
+              codingMode = "CODING_MODE_2_0"
+              sampleRate = 48000
+            }
+          }
+        }]
+      }]
+    }]
+    inputs = [{
+      audioSelectors = {
+        "Audio Selector 1" = {
+          defaultSelection = "DEFAULT"
+        }
+      }
+      timecodeSource = "ZEROBASED"
+    }]
+  })
+`, width)
+}
+
+func testAccJobTemplateConfig_basic(rName string, width int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccJobTemplateConfig_settingsJSON(width))
+}
+
+func testAccJobTemplateConfig_full(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_queue" "hop" {
+  name = "%[1]s-hop"
+}
+
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  category               = "vod"
+  description            = "test"
+  priority               = 5
+  queue                  = aws_media_convert_queue.test.arn
+  status_update_interval = "SECONDS_30"
+
+  acceleration_settings {
+    mode = "PREFERRED"
+  }
+
+  hop_destinations {
+    priority     = 10
+    queue        = aws_media_convert_queue.hop.arn
+    wait_minutes = 15
+  }
+%[2]s
+}
+`, rName, testAccJobTemplateConfig_settingsJSON(1280))
+}
+
+func testAccJobTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[4]s
+  tags = {
+    %[2]s = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccJobTemplateConfig_settingsJSON(1280))
+}
+
+func testAccJobTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[6]s
+  tags = {
+    %[2]s = %[3]q
+    %[4]s = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccJobTemplateConfig_settingsJSON(1280))
+}
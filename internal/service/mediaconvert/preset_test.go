@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertPreset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, "first", 5000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`presets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "category", "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "settings_json"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, string(types.TypeCustom)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPresetConfig_basic(rName, "second", 8000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, "first", 5000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourcePreset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPresetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_preset" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Preset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPresetExists(ctx context.Context, n string, v *types.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPresetConfig_basic(rName, category string, maxBitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name     = %[1]q
+  category = %[2]q
+
+  settings_json = jsonencode({
+    containerSettings = {
+      container   = "MP4"
+      mp4Settings = {}
+    }
+    videoDescription = {
+      width  = 1280
+      height = 720
+      codecSettings = {
+        codec = "H_264"
+        h264Settings = {
+          rateControlMode    = "QVBR"
+          maxBitrate         = %[3]d
+          sceneChangeDetect  = "TRANSITION_DETECTION"
+          qualityTuningLevel = "SINGLE_PASS"
+        }
+      }
+    }
+  })
+}
+`, rName, category, maxBitrate)
+}
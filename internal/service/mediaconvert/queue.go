@@ -5,14 +5,18 @@ package mediaconvert
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -69,14 +73,27 @@ func resourceQueue() *schema.Resource {
 							Required:         true,
 							ValidateDiagFunc: enum.Validate[types.Commitment](),
 						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purchased_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"renewal_type": {
 							Type:             schema.TypeString,
 							Required:         true,
 							ValidateDiagFunc: enum.Validate[types.RenewalType](),
 						},
 						"reserved_slots": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 					},
 				},
@@ -91,7 +108,10 @@ func resourceQueue() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffReservationPlanSettings,
+		),
 	}
 }
 
@@ -172,8 +192,11 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.Description = aws.String(v.(string))
 		}
 
-		if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
-			input.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+		// Resubmitting the reservation plan settings renews the commitment, so only send them when they change.
+		if d.HasChange("reservation_plan_settings") {
+			if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				input.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+			}
 		}
 
 		_, err := conn.UpdateQueue(ctx, input)
@@ -199,6 +222,10 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 
+	if errs.IsA[*types.ConflictException](err) && d.Get("pricing_plan").(string) == string(types.PricingPlanReserved) {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Queue (%s): reserved queues cannot be deleted until their commitment expires: %s", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Queue (%s): %s", d.Id(), err)
 	}
@@ -206,6 +233,27 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// customizeDiffReservationPlanSettings validates changes to a reserved queue's commitment.
+// Reserved transcode slots can be added to an existing commitment but not removed.
+func customizeDiffReservationPlanSettings(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if pricingPlan := d.Get("pricing_plan").(string); pricingPlan != string(types.PricingPlanReserved) {
+			return fmt.Errorf("reservation_plan_settings can only be configured when pricing_plan is %q", types.PricingPlanReserved)
+		}
+	}
+
+	if d.Id() == "" || !d.HasChange("reservation_plan_settings.0.reserved_slots") {
+		return nil
+	}
+
+	o, n := d.GetChange("reservation_plan_settings.0.reserved_slots")
+	if o, n := o.(int), n.(int); o > 0 && n < o {
+		return fmt.Errorf("reservation_plan_settings.0.reserved_slots cannot be decreased from %d to %d during the commitment term", o, n)
+	}
+
+	return nil
+}
+
 func findQueueByName(ctx context.Context, conn *mediaconvert.Client, name string) (*types.Queue, error) {
 	input := &mediaconvert.GetQueueInput{
 		Name: aws.String(name),
@@ -262,6 +310,15 @@ func flattenReservationPlan(apiObject *types.ReservationPlan) map[string]interfa
 		"commitment":     apiObject.Commitment,
 		"renewal_type":   apiObject.RenewalType,
 		"reserved_slots": aws.ToInt32(apiObject.ReservedSlots),
+		names.AttrStatus: apiObject.Status,
+	}
+
+	if v := apiObject.ExpiresAt; v != nil {
+		tfMap["expires_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.PurchasedAt; v != nil {
+		tfMap["purchased_at"] = aws.ToTime(v).Format(time.RFC3339)
 	}
 
 	return tfMap
@@ -155,7 +155,7 @@ func TestAccMediaConvertQueue_reservationPlanSettings(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.commitment", string(types.CommitmentOneYear)),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.renewal_type", string(types.RenewalTypeExpire)),
-					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.reserved_slots", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.reserved_slots", acctest.Ct2),
 				),
 			},
 			{
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Job template and preset settings are exposed as JSON documents in the shape used by the
+// MediaConvert console and API ("Export JSON"). The SDK structures carry no JSON tags, so
+// decoding relies on encoding/json's case-insensitive field matching.
+
+func expandSettingsJSON[T any](s string) (*T, error) {
+	var apiObject T
+
+	if err := json.Unmarshal([]byte(s), &apiObject); err != nil {
+		return nil, err
+	}
+
+	return &apiObject, nil
+}
+
+// flattenSettingsJSON returns the JSON representation of the specified settings in the
+// camel-cased form used by the MediaConvert API, with all null and empty values removed.
+func flattenSettingsJSON(apiObject any) (string, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	var v any
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+
+	v, _ = compactSettings(v)
+
+	if v == nil {
+		return "{}", nil
+	}
+
+	b, err = json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// compactSettings recursively removes empty values from the decoded JSON value and
+// lower-cases the first letter of each object key. It returns false if the value is empty.
+func compactSettings(v any) (any, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case map[string]any:
+		m := make(map[string]any)
+		for k, v := range v {
+			if v, ok := compactSettings(v); ok {
+				m[strings.ToLower(k[:1])+k[1:]] = v
+			}
+		}
+		return m, len(m) > 0
+	case []any:
+		var s []any
+		for _, v := range v {
+			if v, ok := compactSettings(v); ok {
+				s = append(s, v)
+			}
+		}
+		return s, len(s) > 0
+	default:
+		return v, true
+	}
+}
+
+// normalizeSettingsJSON round-trips the specified JSON through the settings structure
+// so that key casing and empty values do not produce differences.
+func normalizeSettingsJSON[T any](s string) (string, error) {
+	apiObject, err := expandSettingsJSON[T](s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return flattenSettingsJSON(apiObject)
+}
+
+func suppressEquivalentSettingsJSONDiffs[T any](k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	oldNormalized, err := normalizeSettingsJSON[T](old)
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalizeSettingsJSON[T](new)
+	if err != nil {
+		return false
+	}
+
+	return verify.JSONStringsEqual(oldNormalized, newNormalized)
+}
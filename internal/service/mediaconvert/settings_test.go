@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"testing"
+
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestNormalizeJobTemplateSettingsJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		"empty": {
+			input:    `{}`,
+			expected: `{}`,
+		},
+		"camel case": {
+			input:    `{"timecodeConfig":{"source":"ZEROBASED"},"outputGroups":[{"name":"File Group","outputGroupSettings":{"type":"FILE_GROUP_SETTINGS","fileGroupSettings":{"destination":"s3://example/"}}}]}`,
+			expected: `{"outputGroups":[{"name":"File Group","outputGroupSettings":{"fileGroupSettings":{"destination":"s3://example/"},"type":"FILE_GROUP_SETTINGS"}}],"timecodeConfig":{"source":"ZEROBASED"}}`,
+		},
+		"pascal case": {
+			input:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			expected: `{"timecodeConfig":{"source":"ZEROBASED"}}`,
+		},
+		"empty values": {
+			input:    `{"adAvailOffset":null,"inputs":[],"timecodeConfig":{"source":""},"outputGroups":[{"name":"File Group","outputs":[{"videoDescription":{"width":1280,"codecSettings":{"codec":"H_264","h264Settings":{"rateControlMode":"QVBR","maxBitrate":5000000}}}}]}]}`,
+			expected: `{"outputGroups":[{"name":"File Group","outputs":[{"videoDescription":{"codecSettings":{"codec":"H_264","h264Settings":{"maxBitrate":5000000,"rateControlMode":"QVBR"}},"width":1280}}]}]}`,
+		},
+		"invalid JSON": {
+			input:   `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfmediaconvert.NormalizeJobTemplateSettingsJSON(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if err == nil && !verify.JSONStringsEqual(got, testCase.expected) {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
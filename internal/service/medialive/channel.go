@@ -71,7 +71,6 @@ func ResourceChannel() *schema.Resource {
 				"channel_class": {
 					Type:             schema.TypeString,
 					Required:         true,
-					ForceNew:         true,
 					ValidateDiagFunc: enum.Validate[types.ChannelClass](),
 				},
 				"channel_id": {
@@ -856,6 +855,37 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_channel") {
+		channel, err := FindChannelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		}
+
+		if channel.State == types.ChannelStateRunning {
+			if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("channel_class") {
+		in := &medialive.UpdateChannelClassInput{
+			ChannelClass: types.ChannelClass(d.Get("channel_class").(string)),
+			ChannelId:    aws.String(d.Id()),
+			Destinations: expandChannelDestinations(d.Get("destinations").(*schema.Set).List()),
+		}
+
+		_, err := conn.UpdateChannelClass(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		}
+
+		if _, err := waitChannelUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_channel", "channel_class") {
 		in := &medialive.UpdateChannelInput{
 			ChannelId: aws.String(d.Id()),
 		}
@@ -896,18 +926,6 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			in.RoleArn = aws.String(d.Get(names.AttrRoleARN).(string))
 		}
 
-		channel, err := FindChannelByID(ctx, conn, d.Id())
-
-		if err != nil {
-			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
-		}
-
-		if channel.State == types.ChannelStateRunning {
-			if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
-				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
-			}
-		}
-
 		out, err := conn.UpdateChannel(ctx, in)
 		if err != nil {
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
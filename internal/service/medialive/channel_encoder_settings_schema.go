@@ -600,6 +600,46 @@ func channelEncoderSettingsSchema() *schema.Schema {
 												},
 											},
 										},
+										"cmaf_ingest_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													names.AttrDestination: func() *schema.Schema {
+														return destinationSchema()
+													}(),
+													"nielsen_id3_behavior": {
+														Type:             schema.TypeString,
+														Optional:         true,
+														Computed:         true,
+														ValidateDiagFunc: enum.Validate[types.CmafNielsenId3Behavior](),
+													},
+													"scte35_type": {
+														Type:             schema.TypeString,
+														Optional:         true,
+														Computed:         true,
+														ValidateDiagFunc: enum.Validate[types.Scte35Type](),
+													},
+													"segment_length": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"segment_length_units": {
+														Type:             schema.TypeString,
+														Optional:         true,
+														Computed:         true,
+														ValidateDiagFunc: enum.Validate[types.CmafIngestSegmentLengthUnits](),
+													},
+													"send_delay_ms": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
 										"frame_capture_group_settings": {
 											Type:     schema.TypeList,
 											Optional: true,
@@ -940,6 +980,7 @@ func channelEncoderSettingsSchema() *schema.Schema {
 																"static_key_settings": {
 																	Type:     schema.TypeList,
 																	Optional: true,
+																	MaxItems: 1,
 																	Elem: &schema.Resource{
 																		Schema: map[string]*schema.Schema{
 																			"static_key_value": {
@@ -1344,6 +1385,32 @@ func channelEncoderSettingsSchema() *schema.Schema {
 														Computed:         true,
 														ValidateDiagFunc: enum.Validate[types.FrameCaptureIntervalUnit](),
 													},
+													"timecode_burnin_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"timecode_burnin_font_size": {
+																	Type:             schema.TypeString,
+																	Optional:         true,
+																	Computed:         true,
+																	ValidateDiagFunc: enum.Validate[types.TimecodeBurninFontSize](),
+																},
+																"timecode_burnin_position": {
+																	Type:             schema.TypeString,
+																	Optional:         true,
+																	Computed:         true,
+																	ValidateDiagFunc: enum.Validate[types.TimecodeBurninPosition](),
+																},
+																names.AttrPrefix: {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
 												},
 											},
 										},
@@ -2425,6 +2492,19 @@ func outputSettingsSchema() *schema.Schema {
 						},
 					},
 				},
+				"cmaf_ingest_output_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name_modifier": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
 				"frame_capture_output_settings": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -3541,6 +3621,9 @@ func expandChannelEncoderSettingsOutputGroupsOutputGroupSettings(tfList []interf
 	if v, ok := m["archive_group_settings"].([]interface{}); ok && len(v) > 0 {
 		o.ArchiveGroupSettings = expandArchiveGroupSettings(v)
 	}
+	if v, ok := m["cmaf_ingest_group_settings"].([]interface{}); ok && len(v) > 0 {
+		o.CmafIngestGroupSettings = expandCmafIngestGroupSettings(v)
+	}
 	if v, ok := m["frame_capture_group_settings"].([]interface{}); ok && len(v) > 0 {
 		o.FrameCaptureGroupSettings = expandFrameCaptureGroupSettings(v)
 	}
@@ -3619,6 +3702,36 @@ func expandArchiveGroupSettings(tfList []interface{}) *types.ArchiveGroupSetting
 	return &o
 }
 
+func expandCmafIngestGroupSettings(tfList []interface{}) *types.CmafIngestGroupSettings {
+	if tfList == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.CmafIngestGroupSettings
+	if v, ok := m[names.AttrDestination].([]interface{}); ok && len(v) > 0 {
+		out.Destination = expandDestination(v)
+	}
+	if v, ok := m["nielsen_id3_behavior"].(string); ok && v != "" {
+		out.NielsenId3Behavior = types.CmafNielsenId3Behavior(v)
+	}
+	if v, ok := m["scte35_type"].(string); ok && v != "" {
+		out.Scte35Type = types.Scte35Type(v)
+	}
+	if v, ok := m["segment_length"].(int); ok && v != 0 {
+		out.SegmentLength = aws.Int32(int32(v))
+	}
+	if v, ok := m["segment_length_units"].(string); ok && v != "" {
+		out.SegmentLengthUnits = types.CmafIngestSegmentLengthUnits(v)
+	}
+	if v, ok := m["send_delay_ms"].(int); ok && v != 0 {
+		out.SendDelayMs = aws.Int32(int32(v))
+	}
+
+	return &out
+}
+
 func expandFrameCaptureGroupSettings(tfList []interface{}) *types.FrameCaptureGroupSettings {
 	if tfList == nil {
 		return nil
@@ -4294,6 +4407,9 @@ func expandOutputsOutputSettings(tfList []interface{}) *types.OutputSettings {
 	if v, ok := m["archive_output_settings"].([]interface{}); ok && len(v) > 0 {
 		os.ArchiveOutputSettings = expandOutputsOutputSettingsArchiveOutputSettings(v)
 	}
+	if v, ok := m["cmaf_ingest_output_settings"].([]interface{}); ok && len(v) > 0 {
+		os.CmafIngestOutputSettings = expandOutputsOutSettingsCmafIngestOutputSettings(v)
+	}
 	if v, ok := m["frame_capture_output_settings"].([]interface{}); ok && len(v) > 0 {
 		os.FrameCaptureOutputSettings = expandOutputsOutSettingsFrameCaptureOutputSettings(v)
 	}
@@ -4351,6 +4467,21 @@ func expandOutputsOutputSettingsArchiveOutputSettings(tfList []interface{}) *typ
 	return &settings
 }
 
+func expandOutputsOutSettingsCmafIngestOutputSettings(tfList []interface{}) *types.CmafIngestOutputSettings {
+	if tfList == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.CmafIngestOutputSettings
+	if v, ok := m["name_modifier"].(string); ok && v != "" {
+		out.NameModifier = aws.String(v)
+	}
+
+	return &out
+}
+
 func expandOutputsOutSettingsFrameCaptureOutputSettings(tfList []interface{}) *types.FrameCaptureOutputSettings {
 	if tfList == nil {
 		return nil
@@ -5373,6 +5504,9 @@ func expandsVideoDescriptionsCodecSettingsFrameCaptureSettings(tfList []interfac
 	if v, ok := m["capture_interval_units"].(string); ok && v != "" {
 		out.CaptureIntervalUnits = types.FrameCaptureIntervalUnit(v)
 	}
+	if v, ok := m["timecode_burnin_settings"].([]interface{}); ok && len(v) > 0 {
+		out.TimecodeBurninSettings = expandH265TimecodeBurninSettings(v)
+	}
 
 	return &out
 }
@@ -5860,6 +5994,7 @@ func flattenOutputGroupSettings(os *types.OutputGroupSettings) []interface{} {
 
 	m := map[string]interface{}{
 		"archive_group_settings":       flattenOutputGroupSettingsArchiveGroupSettings(os.ArchiveGroupSettings),
+		"cmaf_ingest_group_settings":   flattenOutputGroupSettingsCmafIngestGroupSettings(os.CmafIngestGroupSettings),
 		"frame_capture_group_settings": flattenOutputGroupSettingsFrameCaptureGroupSettings(os.FrameCaptureGroupSettings),
 		"hls_group_settings":           flattenOutputGroupSettingsHLSGroupSettings(os.HlsGroupSettings),
 		"ms_smooth_group_settings":     flattenOutputGroupSettingsMsSmoothGroupSettings(os.MsSmoothGroupSettings),
@@ -5906,6 +6041,7 @@ func flattenOutputsOutputSettings(in *types.OutputSettings) []interface{} {
 
 	m := map[string]interface{}{
 		"archive_output_settings":       flattenOutputsOutputSettingsArchiveOutputSettings(in.ArchiveOutputSettings),
+		"cmaf_ingest_output_settings":   flattenOutputsOutputSettingsCmafIngestOutputSettings(in.CmafIngestOutputSettings),
 		"frame_capture_output_settings": flattenOutputsOutputSettingsFrameCaptureOutputSettings(in.FrameCaptureOutputSettings),
 		"hls_output_settings":           flattenOutputsOutputSettingsHLSOutputSettings(in.HlsOutputSettings),
 		"media_package_output_settings": func(inner *types.MediaPackageOutputSettings) []interface{} {
@@ -5946,6 +6082,18 @@ func flattenOutputsOutputSettingsArchiveOutputSettings(in *types.ArchiveOutputSe
 	return []interface{}{m}
 }
 
+func flattenOutputsOutputSettingsCmafIngestOutputSettings(in *types.CmafIngestOutputSettings) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"name_modifier": aws.ToString(in.NameModifier),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenOutputsOutputSettingsFrameCaptureOutputSettings(in *types.FrameCaptureOutputSettings) []interface{} {
 	if in == nil {
 		return nil
@@ -6258,6 +6406,23 @@ func flattenOutputGroupSettingsArchiveGroupSettings(in *types.ArchiveGroupSettin
 	return []interface{}{m}
 }
 
+func flattenOutputGroupSettingsCmafIngestGroupSettings(in *types.CmafIngestGroupSettings) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		names.AttrDestination:  flattenDestination(in.Destination),
+		"nielsen_id3_behavior": string(in.NielsenId3Behavior),
+		"scte35_type":          string(in.Scte35Type),
+		"segment_length":       int(aws.ToInt32(in.SegmentLength)),
+		"segment_length_units": string(in.SegmentLengthUnits),
+		"send_delay_ms":        int(aws.ToInt32(in.SendDelayMs)),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenOutputGroupSettingsFrameCaptureGroupSettings(in *types.FrameCaptureGroupSettings) []interface{} {
 	if in == nil {
 		return nil
@@ -6914,8 +7079,9 @@ func flattenCodecSettingsFrameCaptureSettings(in *types.FrameCaptureSettings) []
 	}
 
 	m := map[string]interface{}{
-		"capture_interval":       int(aws.ToInt32(in.CaptureInterval)),
-		"capture_interval_units": string(in.CaptureIntervalUnits),
+		"capture_interval":         int(aws.ToInt32(in.CaptureInterval)),
+		"capture_interval_units":   string(in.CaptureIntervalUnits),
+		"timecode_burnin_settings": flattenH265TimecodeBurninSettings(in.TimecodeBurninSettings),
 	}
 
 	return []interface{}{m}
@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Input Switch Schedule Action")
+func newInputSwitchScheduleActionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &inputSwitchScheduleActionResource{}
+
+	return r, nil
+}
+
+type inputSwitchScheduleActionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (*inputSwitchScheduleActionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_medialive_input_switch_schedule_action"
+}
+
+func (r *inputSwitchScheduleActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"input_attachment_name_reference": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url_path": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"input_clipping_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputClippingSettingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"input_timecode_source": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.InputTimecodeSource](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"start_timecode": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[startTimecodeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"timecode": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"stop_timecode": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stopTimecodeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"last_frame_clipping_behavior": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LastFrameClippingBehavior](),
+										Optional:   true,
+									},
+									"timecode": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"schedule_action_start_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleActionStartSettingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"fixed_mode_schedule_action_start_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[fixedModeScheduleActionStartSettingsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("follow_mode_schedule_action_start_settings"),
+									path.MatchRelative().AtParent().AtName("immediate_mode_schedule_action_start_settings"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"time": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"follow_mode_schedule_action_start_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[followModeScheduleActionStartSettingsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"follow_point": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FollowPoint](),
+										Required:   true,
+									},
+									"reference_action_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"immediate_mode_schedule_action_start_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[immediateModeScheduleActionStartSettingsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *inputSwitchScheduleActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data inputSwitchScheduleActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	inputSwitchSettings := &awstypes.InputSwitchScheduleActionSettings{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, inputSwitchSettings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	startSettings := &awstypes.ScheduleActionStartSettings{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.ScheduleActionStartSettings, startSettings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &medialive.BatchUpdateScheduleInput{
+		ChannelId: fwflex.StringFromFramework(ctx, data.ChannelID),
+		Creates: &awstypes.BatchScheduleActionCreateRequest{
+			ScheduleActions: []awstypes.ScheduleAction{{
+				ActionName: fwflex.StringFromFramework(ctx, data.ActionName),
+				ScheduleActionSettings: &awstypes.ScheduleActionSettings{
+					InputSwitchSettings: inputSwitchSettings,
+				},
+				ScheduleActionStartSettings: startSettings,
+			}},
+		},
+	}
+
+	_, err := conn.BatchUpdateSchedule(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaLive Channel (%s) Input Switch Schedule Action (%s)", data.ChannelID.ValueString(), data.ActionName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *inputSwitchScheduleActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data inputSwitchScheduleActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	output, err := findInputSwitchScheduleActionByTwoPartKey(ctx, conn, data.ChannelID.ValueString(), data.ActionName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaLive Input Switch Schedule Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.ScheduleActionSettings.InputSwitchSettings, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Immediate mode actions are reported back with the fixed start time at which they ran,
+	// so only refresh the start settings when they are not yet known (e.g. on import).
+	if data.ScheduleActionStartSettings.IsNull() {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.ScheduleActionStartSettings, &data.ScheduleActionStartSettings)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *inputSwitchScheduleActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data inputSwitchScheduleActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	_, err := conn.BatchUpdateSchedule(ctx, &medialive.BatchUpdateScheduleInput{
+		ChannelId: fwflex.StringFromFramework(ctx, data.ChannelID),
+		Deletes: &awstypes.BatchScheduleActionDeleteRequest{
+			ActionNames: []string{data.ActionName.ValueString()},
+		},
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaLive Input Switch Schedule Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findInputSwitchScheduleActionByTwoPartKey(ctx context.Context, conn *medialive.Client, channelID, actionName string) (*awstypes.ScheduleAction, error) {
+	input := &medialive.DescribeScheduleInput{
+		ChannelId: aws.String(channelID),
+	}
+
+	pages := medialive.NewDescribeSchedulePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ScheduleActions {
+			if aws.ToString(v.ActionName) != actionName {
+				continue
+			}
+
+			if v.ScheduleActionSettings == nil || v.ScheduleActionSettings.InputSwitchSettings == nil {
+				return nil, &retry.NotFoundError{
+					Message:     fmt.Sprintf("schedule action %s is not an input switch action", actionName),
+					LastRequest: input,
+				}
+			}
+
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+type inputSwitchScheduleActionResourceModel struct {
+	ActionName                   types.String                                                      `tfsdk:"action_name"`
+	ChannelID                    types.String                                                      `tfsdk:"channel_id"`
+	ID                           types.String                                                      `tfsdk:"id"`
+	InputAttachmentNameReference types.String                                                      `tfsdk:"input_attachment_name_reference"`
+	InputClippingSettings        fwtypes.ListNestedObjectValueOf[inputClippingSettingsModel]       `tfsdk:"input_clipping_settings"`
+	ScheduleActionStartSettings  fwtypes.ListNestedObjectValueOf[scheduleActionStartSettingsModel] `tfsdk:"schedule_action_start_settings"`
+	URLPath                      fwtypes.ListValueOf[types.String]                                 `tfsdk:"url_path"`
+}
+
+const (
+	inputSwitchScheduleActionResourceIDPartCount = 2
+)
+
+func (m *inputSwitchScheduleActionResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), inputSwitchScheduleActionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.ChannelID = types.StringValue(parts[0])
+	m.ActionName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *inputSwitchScheduleActionResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.ChannelID.ValueString(), m.ActionName.ValueString()}, inputSwitchScheduleActionResourceIDPartCount, false)))
+}
+
+type inputClippingSettingsModel struct {
+	InputTimecodeSource fwtypes.StringEnum[awstypes.InputTimecodeSource]    `tfsdk:"input_timecode_source"`
+	StartTimecode       fwtypes.ListNestedObjectValueOf[startTimecodeModel] `tfsdk:"start_timecode"`
+	StopTimecode        fwtypes.ListNestedObjectValueOf[stopTimecodeModel]  `tfsdk:"stop_timecode"`
+}
+
+type startTimecodeModel struct {
+	Timecode types.String `tfsdk:"timecode"`
+}
+
+type stopTimecodeModel struct {
+	LastFrameClippingBehavior fwtypes.StringEnum[awstypes.LastFrameClippingBehavior] `tfsdk:"last_frame_clipping_behavior"`
+	Timecode                  types.String                                           `tfsdk:"timecode"`
+}
+
+type scheduleActionStartSettingsModel struct {
+	FixedModeScheduleActionStartSettings     fwtypes.ListNestedObjectValueOf[fixedModeScheduleActionStartSettingsModel]     `tfsdk:"fixed_mode_schedule_action_start_settings"`
+	FollowModeScheduleActionStartSettings    fwtypes.ListNestedObjectValueOf[followModeScheduleActionStartSettingsModel]    `tfsdk:"follow_mode_schedule_action_start_settings"`
+	ImmediateModeScheduleActionStartSettings fwtypes.ListNestedObjectValueOf[immediateModeScheduleActionStartSettingsModel] `tfsdk:"immediate_mode_schedule_action_start_settings"`
+}
+
+type fixedModeScheduleActionStartSettingsModel struct {
+	Time types.String `tfsdk:"time"`
+}
+
+type followModeScheduleActionStartSettingsModel struct {
+	FollowPoint         fwtypes.StringEnum[awstypes.FollowPoint] `tfsdk:"follow_point"`
+	ReferenceActionName types.String                             `tfsdk:"reference_action_name"`
+}
+
+type immediateModeScheduleActionStartSettingsModel struct{}
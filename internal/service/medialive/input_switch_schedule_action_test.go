@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveInputSwitchScheduleAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.ScheduleAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_input_switch_schedule_action.test"
+	startTime := time.Now().Add(24 * time.Hour).UTC().Format("2006-01-02T15:04:05.000Z")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputSwitchScheduleActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputSwitchScheduleActionConfig_basic(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputSwitchScheduleActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "aws_medialive_channel.test", "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachment_name_reference", "example-input1"),
+					resource.TestCheckResourceAttr(resourceName, "input_clipping_settings.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "schedule_action_start_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "schedule_action_start_settings.0.fixed_mode_schedule_action_start_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "schedule_action_start_settings.0.fixed_mode_schedule_action_start_settings.0.time", startTime),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInputSwitchScheduleAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.ScheduleAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_input_switch_schedule_action.test"
+	startTime := time.Now().Add(24 * time.Hour).UTC().Format("2006-01-02T15:04:05.000Z")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputSwitchScheduleActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputSwitchScheduleActionConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSwitchScheduleActionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmedialive.ResourceInputSwitchScheduleAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInputSwitchScheduleActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_input_switch_schedule_action" {
+				continue
+			}
+
+			_, err := tfmedialive.FindInputSwitchScheduleActionByTwoPartKey(ctx, conn, rs.Primary.Attributes["channel_id"], rs.Primary.Attributes["action_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaLive Input Switch Schedule Action %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInputSwitchScheduleActionExists(ctx context.Context, n string, v *awstypes.ScheduleAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		output, err := tfmedialive.FindInputSwitchScheduleActionByTwoPartKey(ctx, conn, rs.Primary.Attributes["channel_id"], rs.Primary.Attributes["action_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccInputSwitchScheduleActionConfig_basic(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_medialive_input_switch_schedule_action" "test" {
+  action_name                     = %[1]q
+  channel_id                      = aws_medialive_channel.test.channel_id
+  input_attachment_name_reference = "example-input1"
+
+  schedule_action_start_settings {
+    fixed_mode_schedule_action_start_settings {
+      time = %[2]q
+    }
+  }
+}
+`, rName, startTime))
+}
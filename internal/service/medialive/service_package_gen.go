@@ -26,6 +26,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newInputSwitchScheduleActionResource,
+			Name:    "Input Switch Schedule Action",
+		},
 		{
 			Factory: newResourceMultiplexProgram,
 		},
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+// Exports for use in tests only.
+var (
+	ResourceMonitor = resourceMonitor
+	ResourceProbe   = resourceProbe
+
+	FindMonitorByName     = findMonitorByName
+	FindProbeByTwoPartKey = findProbeByTwoPartKey
+)
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_networkmonitor_monitor", name="Monitor")
+// @Tags(identifierAttribute="arn")
+func resourceMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMonitorCreate,
+		ReadWithoutTimeout:   resourceMonitorRead,
+		UpdateWithoutTimeout: resourceMonitorUpdate,
+		DeleteWithoutTimeout: resourceMonitorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{30, 60}),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 200),
+					validation.StringMatch(regexache.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	name := d.Get("monitor_name").(string)
+	input := &networkmonitor.CreateMonitorInput{
+		ClientToken: aws.String(id.UniqueId()),
+		MonitorName: aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("aggregation_period"); ok {
+		input.AggregationPeriod = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := conn.CreateMonitor(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Network Monitor Monitor (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitMonitorReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Monitor (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMonitorRead(ctx, d, meta)...)
+}
+
+func resourceMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	output, err := findMonitorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Network Monitor Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Network Monitor Monitor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aggregation_period", output.AggregationPeriod)
+	d.Set(names.AttrARN, output.MonitorArn)
+	d.Set("monitor_name", output.MonitorName)
+	d.Set(names.AttrState, output.State)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	if d.HasChange("aggregation_period") {
+		input := &networkmonitor.UpdateMonitorInput{
+			AggregationPeriod: aws.Int64(int64(d.Get("aggregation_period").(int))),
+			MonitorName:       aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateMonitor(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Network Monitor Monitor (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitMonitorReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Monitor (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMonitorRead(ctx, d, meta)...)
+}
+
+func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudWatch Network Monitor Monitor: %s", d.Id())
+	_, err := conn.DeleteMonitor(ctx, &networkmonitor.DeleteMonitorInput{
+		MonitorName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Network Monitor Monitor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMonitorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Monitor (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMonitorByName(ctx context.Context, conn *networkmonitor.Client, name string) (*networkmonitor.GetMonitorOutput, error) {
+	input := &networkmonitor.GetMonitorInput{
+		MonitorName: aws.String(name),
+	}
+
+	output, err := conn.GetMonitor(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusMonitor(ctx context.Context, conn *networkmonitor.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMonitorByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name string, timeout time.Duration) (*networkmonitor.GetMonitorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MonitorStatePending),
+		Target:  enum.Slice(awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
+		Refresh: statusMonitor(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetMonitorOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name string, timeout time.Duration) (*networkmonitor.GetMonitorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MonitorStateDeleting, awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
+		Target:  []string{},
+		Refresh: statusMonitor(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetMonitorOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_networkmonitor_probe", name="Probe")
+// @Tags(identifierAttribute="arn")
+func resourceProbe() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProbeCreate,
+		ReadWithoutTimeout:   resourceProbeRead,
+		UpdateWithoutTimeout: resourceProbeUpdate,
+		DeleteWithoutTimeout: resourceProbeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDestination: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"monitor_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"packet_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(56, 8500),
+			},
+			"probe_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrProtocol: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// ICMP probes must not specify a destination port; TCP probes must.
+				if !d.NewValueKnown("destination_port") || !d.NewValueKnown(names.AttrProtocol) {
+					return nil
+				}
+
+				port := d.Get("destination_port").(int)
+				switch awstypes.Protocol(d.Get(names.AttrProtocol).(string)) {
+				case awstypes.ProtocolTcp:
+					if port == 0 {
+						return fmt.Errorf(`"destination_port" is required when "protocol" is %q`, awstypes.ProtocolTcp)
+					}
+				case awstypes.ProtocolIcmp:
+					if port != 0 {
+						return fmt.Errorf(`"destination_port" must not be set when "protocol" is %q`, awstypes.ProtocolIcmp)
+					}
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+func resourceProbeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	monitorName := d.Get("monitor_name").(string)
+	probe := &awstypes.ProbeInput{
+		Destination: aws.String(d.Get(names.AttrDestination).(string)),
+		Protocol:    awstypes.Protocol(d.Get(names.AttrProtocol).(string)),
+		SourceArn:   aws.String(d.Get("source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("destination_port"); ok {
+		probe.DestinationPort = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("packet_size"); ok {
+		probe.PacketSize = aws.Int32(int32(v.(int)))
+	}
+
+	input := &networkmonitor.CreateProbeInput{
+		ClientToken: aws.String(id.UniqueId()),
+		MonitorName: aws.String(monitorName),
+		Probe:       probe,
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateProbe(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Network Monitor Probe (%s): %s", monitorName, err)
+	}
+
+	d.SetId(probeCreateResourceID(monitorName, aws.ToString(output.ProbeId)))
+
+	if _, err := waitProbeReady(ctx, conn, monitorName, aws.ToString(output.ProbeId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Probe (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProbeRead(ctx, d, meta)...)
+}
+
+func resourceProbeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	monitorName, probeID, err := probeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findProbeByTwoPartKey(ctx, conn, monitorName, probeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Network Monitor Probe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Network Monitor Probe (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address_family", output.AddressFamily)
+	d.Set(names.AttrARN, output.ProbeArn)
+	d.Set(names.AttrDestination, output.Destination)
+	d.Set("destination_port", output.DestinationPort)
+	d.Set("monitor_name", monitorName)
+	d.Set("packet_size", output.PacketSize)
+	d.Set("probe_id", output.ProbeId)
+	d.Set(names.AttrProtocol, output.Protocol)
+	d.Set("source_arn", output.SourceArn)
+	d.Set(names.AttrVPCID, output.VpcId)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceProbeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	monitorName, probeID, err := probeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &networkmonitor.UpdateProbeInput{
+			MonitorName: aws.String(monitorName),
+			ProbeId:     aws.String(probeID),
+		}
+
+		if d.HasChange(names.AttrDestination) {
+			input.Destination = aws.String(d.Get(names.AttrDestination).(string))
+		}
+
+		if d.HasChange("destination_port") {
+			input.DestinationPort = aws.Int32(int32(d.Get("destination_port").(int)))
+		}
+
+		if d.HasChange("packet_size") {
+			input.PacketSize = aws.Int32(int32(d.Get("packet_size").(int)))
+		}
+
+		if d.HasChange(names.AttrProtocol) {
+			input.Protocol = awstypes.Protocol(d.Get(names.AttrProtocol).(string))
+		}
+
+		_, err := conn.UpdateProbe(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Network Monitor Probe (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitProbeReady(ctx, conn, monitorName, probeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Probe (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProbeRead(ctx, d, meta)...)
+}
+
+func resourceProbeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	monitorName, probeID, err := probeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// An active probe must be deactivated before it can be deleted.
+	_, err = conn.UpdateProbe(ctx, &networkmonitor.UpdateProbeInput{
+		MonitorName: aws.String(monitorName),
+		ProbeId:     aws.String(probeID),
+		State:       awstypes.ProbeStateInactive,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deactivating CloudWatch Network Monitor Probe (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProbeReady(ctx, conn, monitorName, probeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Probe (%s) deactivate: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Network Monitor Probe: %s", d.Id())
+	_, err = conn.DeleteProbe(ctx, &networkmonitor.DeleteProbeInput{
+		MonitorName: aws.String(monitorName),
+		ProbeId:     aws.String(probeID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Network Monitor Probe (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProbeDeleted(ctx, conn, monitorName, probeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Probe (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const probeResourceIDSeparator = ","
+
+func probeCreateResourceID(monitorName, probeID string) string {
+	parts := []string{monitorName, probeID}
+	id := strings.Join(parts, probeResourceIDSeparator)
+
+	return id
+}
+
+func probeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, probeResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MONITORNAME%[2]sPROBEID", id, probeResourceIDSeparator)
+}
+
+func findProbeByTwoPartKey(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) (*networkmonitor.GetProbeOutput, error) {
+	input := &networkmonitor.GetProbeInput{
+		MonitorName: aws.String(monitorName),
+		ProbeId:     aws.String(probeID),
+	}
+
+	output, err := conn.GetProbe(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.ProbeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusProbe(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProbeByTwoPartKey(ctx, conn, monitorName, probeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProbeStatePending),
+		Target:  enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive),
+		Refresh: statusProbe(ctx, conn, monitorName, probeID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProbeDeleted(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProbeStateDeleting, awstypes.ProbeStateInactive),
+		Target:  []string{},
+		Refresh: statusProbe(ctx, conn, monitorName, probeID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmonitor "github.com/hashicorp/terraform-provider-aws/internal/service/networkmonitor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkMonitorProbe_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmonitor_probe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProbeConfig_basic(rName, "10.0.0.1", 8080, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProbeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "IPV4"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "networkmonitor", regexache.MustCompile(`probe/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "10.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "8080"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_name", "aws_networkmonitor_monitor.test", "monitor_name"),
+					resource.TestCheckResourceAttr(resourceName, "packet_size", "200"),
+					resource.TestCheckResourceAttrSet(resourceName, "probe_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "TCP"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_subnet.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, "aws_vpc.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProbeConfig_basic(rName, "10.0.0.2", 8443, 256),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProbeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "10.0.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "packet_size", "256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorProbe_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmonitor_probe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProbeConfig_basic(rName, "10.0.0.1", 8080, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfnetworkmonitor.ResourceProbe(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorProbe_icmpWithPort(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProbeConfig_icmpWithPort(rName),
+				ExpectError: regexache.MustCompile(`"destination_port" must not be set when "protocol" is "ICMP"`),
+			},
+		},
+	})
+}
+
+func testAccCheckProbeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_networkmonitor_probe" {
+				continue
+			}
+
+			_, err := tfnetworkmonitor.FindProbeByTwoPartKey(ctx, conn, rs.Primary.Attributes["monitor_name"], rs.Primary.Attributes["probe_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Network Monitor Probe %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProbeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+		_, err := tfnetworkmonitor.FindProbeByTwoPartKey(ctx, conn, rs.Primary.Attributes["monitor_name"], rs.Primary.Attributes["probe_id"])
+
+		return err
+	}
+}
+
+func testAccProbeConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name       = %[1]q
+  aggregation_period = 30
+}
+`, rName))
+}
+
+func testAccProbeConfig_basic(rName, destination string, port, packetSize int) string {
+	return acctest.ConfigCompose(testAccProbeConfig_base(rName), fmt.Sprintf(`
+resource "aws_networkmonitor_probe" "test" {
+  monitor_name     = aws_networkmonitor_monitor.test.monitor_name
+  source_arn       = aws_subnet.test[0].arn
+  destination      = %[1]q
+  destination_port = %[2]d
+  protocol         = "TCP"
+  packet_size      = %[3]d
+}
+`, destination, port, packetSize))
+}
+
+func testAccProbeConfig_icmpWithPort(rName string) string {
+	return acctest.ConfigCompose(testAccProbeConfig_base(rName), `
+resource "aws_networkmonitor_probe" "test" {
+  monitor_name     = aws_networkmonitor_monitor.test.monitor_name
+  source_arn       = aws_subnet.test[0].arn
+  destination      = "10.0.0.1"
+  destination_port = 8080
+  protocol         = "ICMP"
+}
+`)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_networkmonitor_monitor", &resource.Sweeper{
+		Name: "aws_networkmonitor_monitor",
+		F:    sweepMonitors,
+		Dependencies: []string{
+			"aws_networkmonitor_probe",
+		},
+	})
+
+	resource.AddTestSweepers("aws_networkmonitor_probe", &resource.Sweeper{
+		Name: "aws_networkmonitor_probe",
+		F:    sweepProbes,
+	})
+}
+
+func sweepMonitors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &networkmonitor.ListMonitorsInput{}
+	conn := client.NetworkMonitorClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := networkmonitor.NewListMonitorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Network Monitor Monitor sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Network Monitor Monitors (%s): %w", region, err)
+		}
+
+		for _, v := range page.Monitors {
+			r := resourceMonitor()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.MonitorName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Network Monitor Monitors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProbes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &networkmonitor.ListMonitorsInput{}
+	conn := client.NetworkMonitorClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := networkmonitor.NewListMonitorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Network Monitor Probe sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Network Monitor Monitors (%s): %w", region, err)
+		}
+
+		for _, v := range page.Monitors {
+			monitorName := aws.ToString(v.MonitorName)
+			monitor, err := findMonitorByName(ctx, conn, monitorName)
+
+			if err != nil {
+				continue
+			}
+
+			for _, v := range monitor.Probes {
+				r := resourceProbe()
+				d := r.Data(nil)
+				d.SetId(probeCreateResourceID(monitorName, aws.ToString(v.ProbeId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Network Monitor Probes (%s): %w", region, err)
+	}
+
+	return nil
+}
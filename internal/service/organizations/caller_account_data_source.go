@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_organizations_caller_account", name="Caller Account")
+func dataSourceCallerAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCallerAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delegated_services": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"is_delegated_administrator": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_management_account": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"management_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCallerAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	org, err := findOrganization(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Organization: %s", err)
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	managementAccountID := aws.ToString(org.MasterAccountId)
+	isManagementAccount := managementAccountID == accountID
+
+	var delegatedServices []string
+
+	// The management account can never be a delegated administrator.
+	if !isManagementAccount {
+		output, err := findDelegatedServicesByAccountID(ctx, conn, accountID)
+
+		switch {
+		// ListDelegatedServicesForAccount can only be called by the management account or a delegated administrator.
+		case errs.IsA[*awstypes.AccessDeniedException](err), errs.IsA[*awstypes.AccountNotRegisteredException](err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Organizations Delegated Services (%s): %s", accountID, err)
+		default:
+			delegatedServices = tfslices.ApplyToAll(output, func(v awstypes.DelegatedService) string {
+				return aws.ToString(v.ServicePrincipal)
+			})
+		}
+	}
+
+	isDelegatedAdministrator := len(delegatedServices) > 0
+	if v, ok := d.GetOk("service_principals"); ok && v.(*schema.Set).Len() > 0 {
+		for _, servicePrincipal := range flex.ExpandStringValueSet(v.(*schema.Set)) {
+			if !slices.Contains(delegatedServices, servicePrincipal) {
+				isDelegatedAdministrator = false
+				break
+			}
+		}
+	}
+
+	d.SetId(accountID)
+	d.Set(names.AttrAccountID, accountID)
+	d.Set("delegated_services", delegatedServices)
+	d.Set("is_delegated_administrator", isDelegatedAdministrator)
+	d.Set("is_management_account", isManagementAccount)
+	d.Set("management_account_id", managementAccountID)
+	d.Set("organization_arn", org.Arn)
+	d.Set("organization_id", org.Id)
+
+	return diags
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCallerAccountDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_caller_account.test"
+	organizationDataSourceName := "data.aws_organizations_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallerAccountDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(dataSourceName, "delegated_services.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "is_delegated_administrator", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "is_management_account", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(dataSourceName, "management_account_id", organizationDataSourceName, "master_account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "organization_arn", organizationDataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "organization_id", organizationDataSourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCallerAccountDataSource_delegatedAdministrator(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_caller_account.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallerAccountDataSourceConfig_delegatedAdministrator(servicePrincipal),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, "data.aws_caller_identity.delegated", names.AttrAccountID),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "delegated_services.*", servicePrincipal),
+					resource.TestCheckResourceAttr(dataSourceName, "is_delegated_administrator", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "is_management_account", acctest.CtFalse),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "management_account_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCallerAccountDataSourceConfig_basic = `
+data "aws_organizations_organization" "test" {}
+
+data "aws_organizations_caller_account" "test" {}
+`
+
+func testAccCallerAccountDataSourceConfig_delegatedAdministrator(servicePrincipal string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+resource "aws_organizations_delegated_administrator" "delegated" {
+  account_id        = data.aws_caller_identity.delegated.account_id
+  service_principal = %[1]q
+}
+
+data "aws_organizations_caller_account" "test" {
+  provider = "awsalternate"
+
+  service_principals = [%[1]q]
+
+  depends_on = [aws_organizations_delegated_administrator.delegated]
+}
+`, servicePrincipal))
+}
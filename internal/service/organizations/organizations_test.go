@@ -82,6 +82,10 @@ func TestAccOrganizations_serial(t *testing.T) {
 			acctest.CtDisappears: testAccResourcePolicy_disappears,
 			"tags":               testAccResourcePolicy_tags,
 		},
+		"CallerAccount": {
+			acctest.CtBasic:          testAccCallerAccountDataSource_basic,
+			"delegatedAdministrator": testAccCallerAccountDataSource_delegatedAdministrator,
+		},
 		"DelegatedAdministrator": {
 			acctest.CtBasic:      testAccDelegatedAdministrator_basic,
 			acctest.CtDisappears: testAccDelegatedAdministrator_disappears,
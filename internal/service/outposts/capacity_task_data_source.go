@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package outposts
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_outposts_capacity_task", name="Capacity Task")
+func dataSourceCapacityTask() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCapacityTaskRead,
+
+		Schema: map[string]*schema.Schema{
+			"capacity_task_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(21, 40),
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 180),
+			},
+			"requested_instance_pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCapacityTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OutpostsConn(ctx)
+
+	outpostID, taskID := d.Get("outpost_identifier").(string), d.Get("capacity_task_id").(string)
+	output, err := findCapacityTaskByTwoPartKey(ctx, conn, outpostID, taskID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Outposts Capacity Task", err))
+	}
+
+	d.SetId(aws.StringValue(output.CapacityTaskId))
+	if output.CompletionDate != nil {
+		d.Set("completion_date", aws.TimeValue(output.CompletionDate).Format(time.RFC3339))
+	}
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	}
+	d.Set("dry_run", output.DryRun)
+	if v := output.Failed; v != nil {
+		d.Set("failure_reason", v.Reason)
+		d.Set("failure_type", v.Type)
+	}
+	if output.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	}
+	d.Set("order_id", output.OrderId)
+	if err := d.Set("requested_instance_pools", flattenInstanceTypeCapacities(output.RequestedInstancePools)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting requested_instance_pools: %s", err)
+	}
+	d.Set(names.AttrStatus, output.CapacityTaskStatus)
+
+	return diags
+}
+
+func findCapacityTaskByTwoPartKey(ctx context.Context, conn *outposts.Outposts, outpostID, taskID string) (*outposts.GetCapacityTaskOutput, error) {
+	input := &outposts.GetCapacityTaskInput{
+		CapacityTaskId:    aws.String(taskID),
+		OutpostIdentifier: aws.String(outpostID),
+	}
+
+	output, err := conn.GetCapacityTaskWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, outposts.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenInstanceTypeCapacities(apiObjects []*outposts.InstanceTypeCapacity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"count":                aws.Int64Value(apiObject.Count),
+			names.AttrInstanceType: aws.StringValue(apiObject.InstanceType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package outposts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Capacity tasks cannot be started without changing the Outpost's physical capacity.
+	envVarCapacityTaskID = "OUTPOSTS_CAPACITY_TASK_ID"
+)
+
+func TestAccOutpostsCapacityTaskDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	capacityTaskID := acctest.SkipIfEnvVarNotSet(t, envVarCapacityTaskID)
+	dataSourceName := "data.aws_outposts_capacity_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OutpostsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityTaskDataSourceConfig_basic(capacityTaskID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "capacity_task_id", capacityTaskID),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "requested_instance_pools.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "requested_instance_pools.0.count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "requested_instance_pools.0.instance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityTaskDataSourceConfig_basic(capacityTaskID string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_capacity_task" "test" {
+  outpost_identifier = tolist(data.aws_outposts_outposts.test.ids)[0]
+  capacity_task_id   = %[1]q
+}
+`, capacityTaskID)
+}
@@ -39,6 +39,11 @@ func DataSourceOutpostAsset() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"instance_families": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"rack_elevation": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -47,6 +52,10 @@ func DataSourceOutpostAsset() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -89,8 +98,14 @@ func DataSourceOutpostAssetRead(ctx context.Context, d *schema.ResourceData, met
 	d.SetId(aws.StringValue(outpost_id))
 	d.Set("asset_id", asset.AssetId)
 	d.Set("asset_type", asset.AssetType)
-	d.Set("host_id", asset.ComputeAttributes.HostId)
-	d.Set("rack_elevation", asset.AssetLocation.RackElevation)
+	if v := asset.ComputeAttributes; v != nil {
+		d.Set("host_id", v.HostId)
+		d.Set("instance_families", aws.StringValueSlice(v.InstanceFamilies))
+		d.Set(names.AttrState, v.State)
+	}
+	if v := asset.AssetLocation; v != nil {
+		d.Set("rack_elevation", v.RackElevation)
+	}
 	d.Set("rack_id", asset.RackId)
 	return diags
 }
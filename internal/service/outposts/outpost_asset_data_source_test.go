@@ -29,6 +29,7 @@ func TestAccOutpostsAssetDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "asset_type"),
 					resource.TestMatchResourceAttr(dataSourceName, "rack_elevation", regexache.MustCompile(`^[\S \n]+$`)),
 					resource.TestMatchResourceAttr(dataSourceName, "rack_id", regexache.MustCompile(`^[\S \n]+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_families.#"),
 				),
 			},
 		},
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceOutpostAssets,
 			TypeName: "aws_outposts_assets",
 		},
+		{
+			Factory:  dataSourceCapacityTask,
+			TypeName: "aws_outposts_capacity_task",
+			Name:     "Capacity Task",
+		},
 		{
 			Factory:  DataSourceOutpost,
 			TypeName: "aws_outposts_outpost",
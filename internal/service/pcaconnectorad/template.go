@@ -0,0 +1,1447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template")
+// @Tags(identifierAttribute="arn")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *templateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template"
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int64Attribute{
+				Computed: true,
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV2Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("template_v3"),
+									path.MatchRelative().AtParent().AtName("template_v4"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"superseded_templates": templateSupersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   templateCertificateValidityBlock(ctx),
+									"enrollment_flags":       templateEnrollmentFlagsBlock(ctx),
+									"extensions":             templateExtensionsBlock(ctx),
+									"general_flags":          templateGeneralFlagsBlock(ctx),
+									"private_key_attributes": templatePrivateKeyAttributesV2Block(ctx),
+									"private_key_flags":      templatePrivateKeyFlagsV2Block(ctx),
+									"subject_name_flags":     templateSubjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV3Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Required:   true,
+									},
+									"superseded_templates": templateSupersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   templateCertificateValidityBlock(ctx),
+									"enrollment_flags":       templateEnrollmentFlagsBlock(ctx),
+									"extensions":             templateExtensionsBlock(ctx),
+									"general_flags":          templateGeneralFlagsBlock(ctx),
+									"private_key_attributes": templatePrivateKeyAttributesV3Block(ctx, true),
+									"private_key_flags":      templatePrivateKeyFlagsV3Block(ctx),
+									"subject_name_flags":     templateSubjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v4": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV4Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Optional:   true,
+									},
+									"superseded_templates": templateSupersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   templateCertificateValidityBlock(ctx),
+									"enrollment_flags":       templateEnrollmentFlagsBlock(ctx),
+									"extensions":             templateExtensionsBlock(ctx),
+									"general_flags":          templateGeneralFlagsBlock(ctx),
+									"private_key_attributes": templatePrivateKeyAttributesV3Block(ctx, false),
+									"private_key_flags":      templatePrivateKeyFlagsV4Block(ctx),
+									"subject_name_flags":     templateSubjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := expandTemplateDefinition(ctx, fwdiag.Must(data.Definition.ToPtr(ctx)))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &pcaconnectorad.CreateTemplateInput{
+		ClientToken:  aws.String(id.UniqueId()),
+		ConnectorArn: fwflex.StringFromFramework(ctx, data.ConnectorARN),
+		Definition:   definition,
+		Name:         aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Private CA Connector for Active Directory Template (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.setID()
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, template.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFramework(ctx, template.PolicySchema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ConnectorARN = fwtypes.ARNValue(aws.ToString(output.ConnectorArn))
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, output.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFramework(ctx, output.PolicySchema)
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := flattenTemplateDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition = definition
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		// AutoFlEx doesn't yet handle union types.
+		definition, diags := expandTemplateDefinition(ctx, fwdiag.Must(new.Definition.ToPtr(ctx)))
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &pcaconnectorad.UpdateTemplateInput{
+			Definition:                    definition,
+			ReenrollAllCertificateHolders: fwflex.BoolFromFramework(ctx, new.ReenrollAllCertificateHolders),
+			TemplateArn:                   fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		_, err := conn.UpdateTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Private CA Connector for Active Directory Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	template, err := findTemplateByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.PolicySchema = fwflex.Int32ToFramework(ctx, template.PolicySchema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteTemplate(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitTemplateDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Private CA Connector for Active Directory Template (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *templateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func templateOptionalBoolAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func templateSupersededTemplatesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 100),
+			listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 64)),
+		},
+	}
+}
+
+func templateRequiredSingleBlockValidators() []validator.List {
+	return []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+		listvalidator.SizeAtMost(1),
+	}
+}
+
+func templateCertificateValidityBlock(ctx context.Context) schema.ListNestedBlock {
+	validityPeriodBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"period": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 8766000),
+					},
+				},
+				"period_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"renewal_period":  validityPeriodBlock,
+				"validity_period": validityPeriodBlock,
+			},
+		},
+	}
+}
+
+func templateEnrollmentFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[enrollmentFlagsModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"enable_key_reuse_on_nt_token_keyset_storage_full": templateOptionalBoolAttribute(),
+				"include_symmetric_algorithms":                     templateOptionalBoolAttribute(),
+				"no_security_extension":                            templateOptionalBoolAttribute(),
+				"remove_invalid_certificate_from_personal_store":   templateOptionalBoolAttribute(),
+				"user_interaction_required":                        templateOptionalBoolAttribute(),
+			},
+		},
+	}
+}
+
+func templateExtensionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"application_policies": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"critical": templateOptionalBoolAttribute(),
+						},
+						Blocks: map[string]schema.Block{
+							"policies": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeBetween(1, 100),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"policy_object_identifier": schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthBetween(1, 64),
+												stringvalidator.ExactlyOneOf(
+													path.MatchRelative().AtParent().AtName("policy_type"),
+												),
+											},
+										},
+										"policy_type": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"key_usage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+					Validators: templateRequiredSingleBlockValidators(),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"critical": templateOptionalBoolAttribute(),
+						},
+						Blocks: map[string]schema.Block{
+							"usage_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageFlagsModel](ctx),
+								Validators: templateRequiredSingleBlockValidators(),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"data_encipherment": templateOptionalBoolAttribute(),
+										"digital_signature": templateOptionalBoolAttribute(),
+										"key_agreement":     templateOptionalBoolAttribute(),
+										"key_encipherment":  templateOptionalBoolAttribute(),
+										"non_repudiation":   templateOptionalBoolAttribute(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateGeneralFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generalFlagsModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"auto_enrollment": templateOptionalBoolAttribute(),
+				"machine_type":    templateOptionalBoolAttribute(),
+			},
+		},
+	}
+}
+
+func templatePrivateKeyAttributesV2Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"crypto_providers": templateCryptoProvidersAttribute(),
+				"key_spec": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+					Required:   true,
+				},
+				"minimal_key_length": templateMinimalKeyLengthAttribute(),
+			},
+		},
+	}
+}
+
+// templatePrivateKeyAttributesV3Block returns the private key attributes block shared by V3 and V4 templates.
+// V4 templates make the algorithm and key usage property optional.
+func templatePrivateKeyAttributesV3Block(ctx context.Context, required bool) schema.ListNestedBlock {
+	keyUsagePropertyValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+	if required {
+		keyUsagePropertyValidators = templateRequiredSingleBlockValidators()
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV3Model](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+					Required:   required,
+					Optional:   !required,
+				},
+				"crypto_providers": templateCryptoProvidersAttribute(),
+				"key_spec": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+					Required:   true,
+				},
+				"minimal_key_length": templateMinimalKeyLengthAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"key_usage_property": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+					Validators: keyUsagePropertyValidators,
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"property_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"property_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyFlagsModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("property_type"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"decrypt":       templateOptionalBoolAttribute(),
+										"key_agreement": templateOptionalBoolAttribute(),
+										"sign":          templateOptionalBoolAttribute(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateCryptoProvidersAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 100),
+			listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 100)),
+		},
+	}
+}
+
+func templateMinimalKeyLengthAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func templatePrivateKeyFlagsV2Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV2Model](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"client_version": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV2](),
+					Required:   true,
+				},
+				"exportable_key":                 templateOptionalBoolAttribute(),
+				"strong_key_protection_required": templateOptionalBoolAttribute(),
+			},
+		},
+	}
+}
+
+func templatePrivateKeyFlagsV3Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV3Model](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"client_version": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV3](),
+					Required:   true,
+				},
+				"exportable_key":                        templateOptionalBoolAttribute(),
+				"require_alternate_signature_algorithm": templateOptionalBoolAttribute(),
+				"strong_key_protection_required":        templateOptionalBoolAttribute(),
+			},
+		},
+	}
+}
+
+func templatePrivateKeyFlagsV4Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV4Model](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"client_version": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV4](),
+					Required:   true,
+				},
+				"exportable_key":                        templateOptionalBoolAttribute(),
+				"require_alternate_signature_algorithm": templateOptionalBoolAttribute(),
+				"require_same_key_renewal":              templateOptionalBoolAttribute(),
+				"strong_key_protection_required":        templateOptionalBoolAttribute(),
+				"use_legacy_provider":                   templateOptionalBoolAttribute(),
+			},
+		},
+	}
+}
+
+func templateSubjectNameFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[subjectNameFlagsModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"require_common_name":        templateOptionalBoolAttribute(),
+				"require_directory_path":     templateOptionalBoolAttribute(),
+				"require_dns_as_cn":          templateOptionalBoolAttribute(),
+				"require_email":              templateOptionalBoolAttribute(),
+				"san_require_directory_guid": templateOptionalBoolAttribute(),
+				"san_require_dns":            templateOptionalBoolAttribute(),
+				"san_require_domain_dns":     templateOptionalBoolAttribute(),
+				"san_require_email":          templateOptionalBoolAttribute(),
+				"san_require_spn":            templateOptionalBoolAttribute(),
+				"san_require_upn":            templateOptionalBoolAttribute(),
+			},
+		},
+	}
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
+
+func statusTemplate(ctx context.Context, conn *pcaconnectorad.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTemplateByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTemplateDeleted(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.Template, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TemplateStatusActive, awstypes.TemplateStatusDeleting),
+		Target:  []string{},
+		Refresh: statusTemplate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Template); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type templateResourceModel struct {
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	ID                            types.String                                             `tfsdk:"id"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int64                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          types.Map                                                `tfsdk:"tags"`
+	TagsAll                       types.Map                                                `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+func (data *templateResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *templateResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListValueOf[types.String]                            `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                   `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV3Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListValueOf[types.String]                            `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                   `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV3Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListValueOf[types.String]                            `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policies"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.ListValueOf[types.String]    `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int64                          `tfsdk:"minimal_key_length"`
+}
+
+// privateKeyAttributesV3Model is used by both V3 and V4 templates.
+type privateKeyAttributesV3Model struct {
+	Algorithm        fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm]       `tfsdk:"algorithm"`
+	CryptoProviders  fwtypes.ListValueOf[types.String]                      `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec]                   `tfsdk:"key_spec"`
+	KeyUsageProperty fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int64                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SanRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SanRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SanRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SanRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SanRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SanRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
+
+// expandTemplateObject expands a nested block that contains no union types.
+func expandTemplateObject[T, M any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[M]) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	var apiObject T
+	diags.Append(fwflex.Expand(ctx, data, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &apiObject, diags
+}
+
+// flattenTemplateObject flattens an API object that contains no union types.
+func flattenTemplateObject[M, T any](ctx context.Context, apiObject *T) (fwtypes.ListNestedObjectValueOf[M], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[M](ctx), diags
+	}
+
+	var data M
+	diags.Append(fwflex.Flatten(ctx, apiObject, &data)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[M](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func expandTemplateDefinition(ctx context.Context, templateDefinitionData *templateDefinitionModel) (awstypes.TemplateDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !templateDefinitionData.TemplateV2.IsNull() {
+		apiObject, d := expandTemplateV2(ctx, fwdiag.Must(templateDefinitionData.TemplateV2.ToPtr(ctx)))
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.TemplateDefinitionMemberTemplateV2{
+			Value: *apiObject,
+		}, diags
+	}
+
+	if !templateDefinitionData.TemplateV3.IsNull() {
+		apiObject, d := expandTemplateV3(ctx, fwdiag.Must(templateDefinitionData.TemplateV3.ToPtr(ctx)))
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.TemplateDefinitionMemberTemplateV3{
+			Value: *apiObject,
+		}, diags
+	}
+
+	if !templateDefinitionData.TemplateV4.IsNull() {
+		apiObject, d := expandTemplateV4(ctx, fwdiag.Must(templateDefinitionData.TemplateV4.ToPtr(ctx)))
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.TemplateDefinitionMemberTemplateV4{
+			Value: *apiObject,
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenTemplateDefinition(ctx context.Context, apiObject awstypes.TemplateDefinition) (fwtypes.ListNestedObjectValueOf[templateDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+	}
+
+	templateDefinitionData := templateDefinitionModel{
+		TemplateV2: fwtypes.NewListNestedObjectValueOfNull[templateV2Model](ctx),
+		TemplateV3: fwtypes.NewListNestedObjectValueOfNull[templateV3Model](ctx),
+		TemplateV4: fwtypes.NewListNestedObjectValueOfNull[templateV4Model](ctx),
+	}
+
+	var d diag.Diagnostics
+	switch v := apiObject.(type) {
+	case *awstypes.TemplateDefinitionMemberTemplateV2:
+		templateDefinitionData.TemplateV2, d = flattenTemplateV2(ctx, &v.Value)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV3:
+		templateDefinitionData.TemplateV3, d = flattenTemplateV3(ctx, &v.Value)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV4:
+		templateDefinitionData.TemplateV4, d = flattenTemplateV4(ctx, &v.Value)
+	}
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateDefinitionData), diags
+}
+
+func expandTemplateV2(ctx context.Context, templateV2Data *templateV2Model) (*awstypes.TemplateV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	apiObject := &awstypes.TemplateV2{
+		SupersededTemplates: fwflex.ExpandFrameworkStringValueList(ctx, templateV2Data.SupersededTemplates),
+	}
+
+	apiObject.CertificateValidity, d = expandTemplateObject[awstypes.CertificateValidity](ctx, templateV2Data.CertificateValidity)
+	diags.Append(d...)
+	apiObject.EnrollmentFlags, d = expandTemplateObject[awstypes.EnrollmentFlagsV2](ctx, templateV2Data.EnrollmentFlags)
+	diags.Append(d...)
+	apiObject.GeneralFlags, d = expandTemplateObject[awstypes.GeneralFlagsV2](ctx, templateV2Data.GeneralFlags)
+	diags.Append(d...)
+	apiObject.PrivateKeyAttributes, d = expandTemplateObject[awstypes.PrivateKeyAttributesV2](ctx, templateV2Data.PrivateKeyAttributes)
+	diags.Append(d...)
+	apiObject.PrivateKeyFlags, d = expandTemplateObject[awstypes.PrivateKeyFlagsV2](ctx, templateV2Data.PrivateKeyFlags)
+	diags.Append(d...)
+	apiObject.SubjectNameFlags, d = expandTemplateObject[awstypes.SubjectNameFlagsV2](ctx, templateV2Data.SubjectNameFlags)
+	diags.Append(d...)
+
+	if extensionsData := fwdiag.Must(templateV2Data.Extensions.ToPtr(ctx)); extensionsData != nil {
+		applicationPolicies, keyUsage, d := expandExtensions(ctx, extensionsData)
+		diags.Append(d...)
+
+		apiObject.Extensions = &awstypes.ExtensionsV2{
+			ApplicationPolicies: applicationPolicies,
+			KeyUsage:            keyUsage,
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func flattenTemplateV2(ctx context.Context, apiObject *awstypes.TemplateV2) (fwtypes.ListNestedObjectValueOf[templateV2Model], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	templateV2Data := templateV2Model{
+		Extensions:          fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx),
+		SupersededTemplates: fwflex.FlattenFrameworkStringValueListOfString(ctx, apiObject.SupersededTemplates),
+	}
+
+	templateV2Data.CertificateValidity, d = flattenTemplateObject[certificateValidityModel](ctx, apiObject.CertificateValidity)
+	diags.Append(d...)
+	templateV2Data.EnrollmentFlags, d = flattenTemplateObject[enrollmentFlagsModel](ctx, apiObject.EnrollmentFlags)
+	diags.Append(d...)
+	templateV2Data.GeneralFlags, d = flattenTemplateObject[generalFlagsModel](ctx, apiObject.GeneralFlags)
+	diags.Append(d...)
+	templateV2Data.PrivateKeyAttributes, d = flattenTemplateObject[privateKeyAttributesV2Model](ctx, apiObject.PrivateKeyAttributes)
+	diags.Append(d...)
+	templateV2Data.PrivateKeyFlags, d = flattenTemplateObject[privateKeyFlagsV2Model](ctx, apiObject.PrivateKeyFlags)
+	diags.Append(d...)
+	templateV2Data.SubjectNameFlags, d = flattenTemplateObject[subjectNameFlagsModel](ctx, apiObject.SubjectNameFlags)
+	diags.Append(d...)
+
+	if v := apiObject.Extensions; v != nil {
+		templateV2Data.Extensions, d = flattenExtensions(ctx, v.ApplicationPolicies, v.KeyUsage)
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[templateV2Model](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateV2Data), diags
+}
+
+func expandTemplateV3(ctx context.Context, templateV3Data *templateV3Model) (*awstypes.TemplateV3, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	apiObject := &awstypes.TemplateV3{
+		HashAlgorithm:       templateV3Data.HashAlgorithm.ValueEnum(),
+		SupersededTemplates: fwflex.ExpandFrameworkStringValueList(ctx, templateV3Data.SupersededTemplates),
+	}
+
+	apiObject.CertificateValidity, d = expandTemplateObject[awstypes.CertificateValidity](ctx, templateV3Data.CertificateValidity)
+	diags.Append(d...)
+	apiObject.EnrollmentFlags, d = expandTemplateObject[awstypes.EnrollmentFlagsV3](ctx, templateV3Data.EnrollmentFlags)
+	diags.Append(d...)
+	apiObject.GeneralFlags, d = expandTemplateObject[awstypes.GeneralFlagsV3](ctx, templateV3Data.GeneralFlags)
+	diags.Append(d...)
+	apiObject.PrivateKeyFlags, d = expandTemplateObject[awstypes.PrivateKeyFlagsV3](ctx, templateV3Data.PrivateKeyFlags)
+	diags.Append(d...)
+	apiObject.SubjectNameFlags, d = expandTemplateObject[awstypes.SubjectNameFlagsV3](ctx, templateV3Data.SubjectNameFlags)
+	diags.Append(d...)
+
+	apiObject.PrivateKeyAttributes, d = expandTemplateObject[awstypes.PrivateKeyAttributesV3](ctx, templateV3Data.PrivateKeyAttributes)
+	diags.Append(d...)
+	if apiObject.PrivateKeyAttributes != nil {
+		apiObject.PrivateKeyAttributes.KeyUsageProperty = expandKeyUsageProperty(ctx, fwdiag.Must(templateV3Data.PrivateKeyAttributes.ToPtr(ctx)))
+	}
+
+	if extensionsData := fwdiag.Must(templateV3Data.Extensions.ToPtr(ctx)); extensionsData != nil {
+		applicationPolicies, keyUsage, d := expandExtensions(ctx, extensionsData)
+		diags.Append(d...)
+
+		apiObject.Extensions = &awstypes.ExtensionsV3{
+			ApplicationPolicies: applicationPolicies,
+			KeyUsage:            keyUsage,
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func flattenTemplateV3(ctx context.Context, apiObject *awstypes.TemplateV3) (fwtypes.ListNestedObjectValueOf[templateV3Model], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	templateV3Data := templateV3Model{
+		Extensions:           fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx),
+		HashAlgorithm:        fwtypes.StringEnumValue(apiObject.HashAlgorithm),
+		PrivateKeyAttributes: fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesV3Model](ctx),
+		SupersededTemplates:  fwflex.FlattenFrameworkStringValueListOfString(ctx, apiObject.SupersededTemplates),
+	}
+
+	templateV3Data.CertificateValidity, d = flattenTemplateObject[certificateValidityModel](ctx, apiObject.CertificateValidity)
+	diags.Append(d...)
+	templateV3Data.EnrollmentFlags, d = flattenTemplateObject[enrollmentFlagsModel](ctx, apiObject.EnrollmentFlags)
+	diags.Append(d...)
+	templateV3Data.GeneralFlags, d = flattenTemplateObject[generalFlagsModel](ctx, apiObject.GeneralFlags)
+	diags.Append(d...)
+	templateV3Data.PrivateKeyFlags, d = flattenTemplateObject[privateKeyFlagsV3Model](ctx, apiObject.PrivateKeyFlags)
+	diags.Append(d...)
+	templateV3Data.SubjectNameFlags, d = flattenTemplateObject[subjectNameFlagsModel](ctx, apiObject.SubjectNameFlags)
+	diags.Append(d...)
+
+	if v := apiObject.PrivateKeyAttributes; v != nil {
+		templateV3Data.PrivateKeyAttributes, d = flattenPrivateKeyAttributes(ctx, v, v.KeyUsageProperty)
+		diags.Append(d...)
+	}
+
+	if v := apiObject.Extensions; v != nil {
+		templateV3Data.Extensions, d = flattenExtensions(ctx, v.ApplicationPolicies, v.KeyUsage)
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[templateV3Model](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateV3Data), diags
+}
+
+func expandTemplateV4(ctx context.Context, templateV4Data *templateV4Model) (*awstypes.TemplateV4, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	apiObject := &awstypes.TemplateV4{
+		HashAlgorithm:       templateV4Data.HashAlgorithm.ValueEnum(),
+		SupersededTemplates: fwflex.ExpandFrameworkStringValueList(ctx, templateV4Data.SupersededTemplates),
+	}
+
+	apiObject.CertificateValidity, d = expandTemplateObject[awstypes.CertificateValidity](ctx, templateV4Data.CertificateValidity)
+	diags.Append(d...)
+	apiObject.EnrollmentFlags, d = expandTemplateObject[awstypes.EnrollmentFlagsV4](ctx, templateV4Data.EnrollmentFlags)
+	diags.Append(d...)
+	apiObject.GeneralFlags, d = expandTemplateObject[awstypes.GeneralFlagsV4](ctx, templateV4Data.GeneralFlags)
+	diags.Append(d...)
+	apiObject.PrivateKeyFlags, d = expandTemplateObject[awstypes.PrivateKeyFlagsV4](ctx, templateV4Data.PrivateKeyFlags)
+	diags.Append(d...)
+	apiObject.SubjectNameFlags, d = expandTemplateObject[awstypes.SubjectNameFlagsV4](ctx, templateV4Data.SubjectNameFlags)
+	diags.Append(d...)
+
+	apiObject.PrivateKeyAttributes, d = expandTemplateObject[awstypes.PrivateKeyAttributesV4](ctx, templateV4Data.PrivateKeyAttributes)
+	diags.Append(d...)
+	if apiObject.PrivateKeyAttributes != nil {
+		apiObject.PrivateKeyAttributes.KeyUsageProperty = expandKeyUsageProperty(ctx, fwdiag.Must(templateV4Data.PrivateKeyAttributes.ToPtr(ctx)))
+	}
+
+	if extensionsData := fwdiag.Must(templateV4Data.Extensions.ToPtr(ctx)); extensionsData != nil {
+		applicationPolicies, keyUsage, d := expandExtensions(ctx, extensionsData)
+		diags.Append(d...)
+
+		apiObject.Extensions = &awstypes.ExtensionsV4{
+			ApplicationPolicies: applicationPolicies,
+			KeyUsage:            keyUsage,
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func flattenTemplateV4(ctx context.Context, apiObject *awstypes.TemplateV4) (fwtypes.ListNestedObjectValueOf[templateV4Model], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	templateV4Data := templateV4Model{
+		Extensions:           fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx),
+		HashAlgorithm:        fwtypes.StringEnumNull[awstypes.HashAlgorithm](),
+		PrivateKeyAttributes: fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesV3Model](ctx),
+		SupersededTemplates:  fwflex.FlattenFrameworkStringValueListOfString(ctx, apiObject.SupersededTemplates),
+	}
+
+	if v := apiObject.HashAlgorithm; v != "" {
+		templateV4Data.HashAlgorithm = fwtypes.StringEnumValue(v)
+	}
+
+	templateV4Data.CertificateValidity, d = flattenTemplateObject[certificateValidityModel](ctx, apiObject.CertificateValidity)
+	diags.Append(d...)
+	templateV4Data.EnrollmentFlags, d = flattenTemplateObject[enrollmentFlagsModel](ctx, apiObject.EnrollmentFlags)
+	diags.Append(d...)
+	templateV4Data.GeneralFlags, d = flattenTemplateObject[generalFlagsModel](ctx, apiObject.GeneralFlags)
+	diags.Append(d...)
+	templateV4Data.PrivateKeyFlags, d = flattenTemplateObject[privateKeyFlagsV4Model](ctx, apiObject.PrivateKeyFlags)
+	diags.Append(d...)
+	templateV4Data.SubjectNameFlags, d = flattenTemplateObject[subjectNameFlagsModel](ctx, apiObject.SubjectNameFlags)
+	diags.Append(d...)
+
+	if v := apiObject.PrivateKeyAttributes; v != nil {
+		templateV4Data.PrivateKeyAttributes, d = flattenPrivateKeyAttributes(ctx, v, v.KeyUsageProperty)
+		diags.Append(d...)
+	}
+
+	if v := apiObject.Extensions; v != nil {
+		templateV4Data.Extensions, d = flattenExtensions(ctx, v.ApplicationPolicies, v.KeyUsage)
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[templateV4Model](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateV4Data), diags
+}
+
+// expandExtensions returns the members common to the ExtensionsV2, ExtensionsV3 and ExtensionsV4 API objects.
+func expandExtensions(ctx context.Context, extensionsData *extensionsModel) (*awstypes.ApplicationPolicies, *awstypes.KeyUsage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	keyUsage, d := expandTemplateObject[awstypes.KeyUsage](ctx, extensionsData.KeyUsage)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return expandApplicationPolicies(ctx, fwdiag.Must(extensionsData.ApplicationPolicies.ToPtr(ctx))), keyUsage, diags
+}
+
+func flattenExtensions(ctx context.Context, applicationPolicies *awstypes.ApplicationPolicies, keyUsage *awstypes.KeyUsage) (fwtypes.ListNestedObjectValueOf[extensionsModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	extensionsData := extensionsModel{
+		ApplicationPolicies: flattenApplicationPolicies(ctx, applicationPolicies),
+	}
+
+	var d diag.Diagnostics
+	extensionsData.KeyUsage, d = flattenTemplateObject[keyUsageModel](ctx, keyUsage)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &extensionsData), diags
+}
+
+func expandApplicationPolicies(ctx context.Context, applicationPoliciesData *applicationPoliciesModel) *awstypes.ApplicationPolicies {
+	if applicationPoliciesData == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ApplicationPolicies{
+		Critical: fwflex.BoolFromFramework(ctx, applicationPoliciesData.Critical),
+	}
+
+	for _, applicationPolicyData := range fwdiag.Must(applicationPoliciesData.Policies.ToSlice(ctx)) {
+		if !applicationPolicyData.PolicyObjectIdentifier.IsNull() {
+			apiObject.Policies = append(apiObject.Policies, &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+				Value: applicationPolicyData.PolicyObjectIdentifier.ValueString(),
+			})
+		}
+
+		if !applicationPolicyData.PolicyType.IsNull() {
+			apiObject.Policies = append(apiObject.Policies, &awstypes.ApplicationPolicyMemberPolicyType{
+				Value: applicationPolicyData.PolicyType.ValueEnum(),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenApplicationPolicies(ctx context.Context, apiObject *awstypes.ApplicationPolicies) fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[applicationPoliciesModel](ctx)
+	}
+
+	var applicationPoliciesData []*applicationPolicyModel
+
+	for _, v := range apiObject.Policies {
+		applicationPolicyData := &applicationPolicyModel{
+			PolicyObjectIdentifier: types.StringNull(),
+			PolicyType:             fwtypes.StringEnumNull[awstypes.ApplicationPolicyType](),
+		}
+
+		switch v := v.(type) {
+		case *awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+			applicationPolicyData.PolicyObjectIdentifier = fwflex.StringValueToFramework(ctx, v.Value)
+
+		case *awstypes.ApplicationPolicyMemberPolicyType:
+			applicationPolicyData.PolicyType = fwtypes.StringEnumValue(v.Value)
+		}
+
+		applicationPoliciesData = append(applicationPoliciesData, applicationPolicyData)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &applicationPoliciesModel{
+		Critical: fwflex.BoolToFramework(ctx, apiObject.Critical),
+		Policies: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, applicationPoliciesData),
+	})
+}
+
+// flattenPrivateKeyAttributes flattens a PrivateKeyAttributesV3 or PrivateKeyAttributesV4 API object.
+func flattenPrivateKeyAttributes(ctx context.Context, apiObject any, keyUsageProperty awstypes.KeyUsageProperty) (fwtypes.ListNestedObjectValueOf[privateKeyAttributesV3Model], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var privateKeyAttributesData privateKeyAttributesV3Model
+	diags.Append(fwflex.Flatten(ctx, apiObject, &privateKeyAttributesData)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesV3Model](ctx), diags
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	privateKeyAttributesData.KeyUsageProperty = flattenKeyUsageProperty(ctx, keyUsageProperty)
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &privateKeyAttributesData), diags
+}
+
+func expandKeyUsageProperty(ctx context.Context, privateKeyAttributesData *privateKeyAttributesV3Model) awstypes.KeyUsageProperty {
+	keyUsagePropertyData := fwdiag.Must(privateKeyAttributesData.KeyUsageProperty.ToPtr(ctx))
+
+	if keyUsagePropertyData == nil {
+		return nil
+	}
+
+	if !keyUsagePropertyData.PropertyFlags.IsNull() {
+		keyUsagePropertyFlagsData := fwdiag.Must(keyUsagePropertyData.PropertyFlags.ToPtr(ctx))
+
+		return &awstypes.KeyUsagePropertyMemberPropertyFlags{
+			Value: awstypes.KeyUsagePropertyFlags{
+				Decrypt:      fwflex.BoolFromFramework(ctx, keyUsagePropertyFlagsData.Decrypt),
+				KeyAgreement: fwflex.BoolFromFramework(ctx, keyUsagePropertyFlagsData.KeyAgreement),
+				Sign:         fwflex.BoolFromFramework(ctx, keyUsagePropertyFlagsData.Sign),
+			},
+		}
+	}
+
+	if !keyUsagePropertyData.PropertyType.IsNull() {
+		return &awstypes.KeyUsagePropertyMemberPropertyType{
+			Value: keyUsagePropertyData.PropertyType.ValueEnum(),
+		}
+	}
+
+	return nil
+}
+
+func flattenKeyUsageProperty(ctx context.Context, apiObject awstypes.KeyUsageProperty) fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyModel](ctx)
+	}
+
+	keyUsagePropertyData := keyUsagePropertyModel{
+		PropertyFlags: fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyFlagsModel](ctx),
+		PropertyType:  fwtypes.StringEnumNull[awstypes.KeyUsagePropertyType](),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.KeyUsagePropertyMemberPropertyFlags:
+		keyUsagePropertyData.PropertyFlags = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &keyUsagePropertyFlagsModel{
+			Decrypt:      fwflex.BoolToFramework(ctx, v.Value.Decrypt),
+			KeyAgreement: fwflex.BoolToFramework(ctx, v.Value.KeyAgreement),
+			Sign:         fwflex.BoolToFramework(ctx, v.Value.Sign),
+		})
+
+	case *awstypes.KeyUsagePropertyMemberPropertyType:
+		keyUsagePropertyData.PropertyType = fwtypes.StringEnumValue(v.Value)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &keyUsagePropertyData)
+}
@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template Group Access Control Entries")
+func newTemplateGroupAccessControlEntriesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateGroupAccessControlEntriesResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultUpdateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type templateGroupAccessControlEntriesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *templateGroupAccessControlEntriesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template_group_access_control_entries"
+}
+
+func (r *templateGroupAccessControlEntriesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"template_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_control_entry": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[accessControlEntryModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"group_display_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 256),
+							},
+						},
+						"group_security_identifier": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(7, 256),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"access_rights": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accessRightsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"auto_enroll": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+										Required:   true,
+									},
+									"enroll": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *templateGroupAccessControlEntriesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	var entries []awstypes.AccessControlEntrySummary
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.AccessControlEntries, &entries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	templateARN := data.TemplateARN.ValueString()
+
+	// Any existing entries not in the configuration are removed.
+	existing, err := findTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, templateARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s) Group Access Control Entries", templateARN), err.Error())
+
+		return
+	}
+
+	if err := syncTemplateGroupAccessControlEntries(ctx, conn, templateARN, existing, entries, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Private CA Connector for Active Directory Template (%s) Group Access Control Entries", templateARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateGroupAccessControlEntriesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, data.ID.ValueString())
+
+	if err == nil && len(output) == 0 {
+		err = tfresource.NewEmptyResultError(data.ID.ValueString())
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s) Group Access Control Entries", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.AccessControlEntries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateGroupAccessControlEntriesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.AccessControlEntries.Equal(old.AccessControlEntries) {
+		var oldEntries, newEntries []awstypes.AccessControlEntrySummary
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.AccessControlEntries, &oldEntries)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.AccessControlEntries, &newEntries)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := syncTemplateGroupAccessControlEntries(ctx, conn, new.ID.ValueString(), oldEntries, newEntries, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Private CA Connector for Active Directory Template (%s) Group Access Control Entries", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateGroupAccessControlEntriesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	var entries []awstypes.AccessControlEntrySummary
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.AccessControlEntries, &entries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := syncTemplateGroupAccessControlEntries(ctx, conn, data.ID.ValueString(), entries, nil, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Template (%s) Group Access Control Entries", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// syncTemplateGroupAccessControlEntries reconciles a template's access control entries, keyed by group security identifier.
+// The API has no batch operations, so each changed entry is written individually, retrying on throttling.
+func syncTemplateGroupAccessControlEntries(ctx context.Context, conn *pcaconnectorad.Client, templateARN string, old, new []awstypes.AccessControlEntrySummary, timeout time.Duration) error {
+	oldBySID := make(map[string]awstypes.AccessControlEntrySummary, len(old))
+	for _, v := range old {
+		oldBySID[aws.ToString(v.GroupSecurityIdentifier)] = v
+	}
+	newBySID := make(map[string]awstypes.AccessControlEntrySummary, len(new))
+	for _, v := range new {
+		newBySID[aws.ToString(v.GroupSecurityIdentifier)] = v
+	}
+
+	for sid := range oldBySID {
+		if _, ok := newBySID[sid]; ok {
+			continue
+		}
+
+		input := &pcaconnectorad.DeleteTemplateGroupAccessControlEntryInput{
+			GroupSecurityIdentifier: aws.String(sid),
+			TemplateArn:             aws.String(templateARN),
+		}
+
+		_, err := retryTemplateGroupAccessControlEntryWrite(ctx, timeout, func() (interface{}, error) {
+			return conn.DeleteTemplateGroupAccessControlEntry(ctx, input)
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting access control entry (%s): %w", sid, err)
+		}
+	}
+
+	for sid, v := range newBySID {
+		o, ok := oldBySID[sid]
+
+		switch {
+		case !ok:
+			input := &pcaconnectorad.CreateTemplateGroupAccessControlEntryInput{
+				AccessRights:            v.AccessRights,
+				ClientToken:             aws.String(id.UniqueId()),
+				GroupDisplayName:        v.GroupDisplayName,
+				GroupSecurityIdentifier: aws.String(sid),
+				TemplateArn:             aws.String(templateARN),
+			}
+
+			if _, err := retryTemplateGroupAccessControlEntryWrite(ctx, timeout, func() (interface{}, error) {
+				return conn.CreateTemplateGroupAccessControlEntry(ctx, input)
+			}); err != nil {
+				return fmt.Errorf("creating access control entry (%s): %w", sid, err)
+			}
+		case !accessControlEntriesEqual(o, v):
+			input := &pcaconnectorad.UpdateTemplateGroupAccessControlEntryInput{
+				AccessRights:            v.AccessRights,
+				GroupDisplayName:        v.GroupDisplayName,
+				GroupSecurityIdentifier: aws.String(sid),
+				TemplateArn:             aws.String(templateARN),
+			}
+
+			if _, err := retryTemplateGroupAccessControlEntryWrite(ctx, timeout, func() (interface{}, error) {
+				return conn.UpdateTemplateGroupAccessControlEntry(ctx, input)
+			}); err != nil {
+				return fmt.Errorf("updating access control entry (%s): %w", sid, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func retryTemplateGroupAccessControlEntryWrite(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhenIsA[*awstypes.ThrottlingException](ctx, timeout, f)
+}
+
+func accessControlEntriesEqual(a, b awstypes.AccessControlEntrySummary) bool {
+	if aws.ToString(a.GroupDisplayName) != aws.ToString(b.GroupDisplayName) {
+		return false
+	}
+
+	var x, y awstypes.AccessRights
+	if a.AccessRights != nil {
+		x = *a.AccessRights
+	}
+	if b.AccessRights != nil {
+		y = *b.AccessRights
+	}
+
+	return x.AutoEnroll == y.AutoEnroll && x.Enroll == y.Enroll
+}
+
+func findTemplateGroupAccessControlEntriesByTemplateARN(ctx context.Context, conn *pcaconnectorad.Client, templateARN string) ([]awstypes.AccessControlEntrySummary, error) {
+	input := &pcaconnectorad.ListTemplateGroupAccessControlEntriesInput{
+		TemplateArn: aws.String(templateARN),
+	}
+	var output []awstypes.AccessControlEntrySummary
+
+	pages := pcaconnectorad.NewListTemplateGroupAccessControlEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccessControlEntries...)
+	}
+
+	return output, nil
+}
+
+type templateGroupAccessControlEntriesResourceModel struct {
+	AccessControlEntries fwtypes.SetNestedObjectValueOf[accessControlEntryModel] `tfsdk:"access_control_entry"`
+	ID                   types.String                                            `tfsdk:"id"`
+	TemplateARN          fwtypes.ARN                                             `tfsdk:"template_arn"`
+	Timeouts             timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+func (data *templateGroupAccessControlEntriesResourceModel) InitFromID() error {
+	data.TemplateARN = fwtypes.ARNValue(data.ID.ValueString())
+
+	return nil
+}
+
+func (data *templateGroupAccessControlEntriesResourceModel) setID() {
+	data.ID = types.StringValue(data.TemplateARN.ValueString())
+}
+
+type accessControlEntryModel struct {
+	AccessRights            fwtypes.ListNestedObjectValueOf[accessRightsModel] `tfsdk:"access_rights"`
+	GroupDisplayName        types.String                                       `tfsdk:"group_display_name"`
+	GroupSecurityIdentifier types.String                                       `tfsdk:"group_security_identifier"`
+}
+
+type accessRightsModel struct {
+	AutoEnroll fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"auto_enroll"`
+	Enroll     fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"enroll"`
+}
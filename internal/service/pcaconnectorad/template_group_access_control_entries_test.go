@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntries_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entries.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntriesConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_control_entry.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_entry.*", map[string]string{
+						"access_rights.0.auto_enroll": "DENY",
+						"access_rights.0.enroll":      "ALLOW",
+						"group_display_name":          "Authenticated Users",
+						"group_security_identifier":   "S-1-5-11",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "template_arn", "aws_pcaconnectorad_template.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateGroupAccessControlEntriesConfig_updated(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_control_entry.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_entry.*", map[string]string{
+						"access_rights.0.auto_enroll": "ALLOW",
+						"access_rights.0.enroll":      "ALLOW",
+						"group_display_name":          "Authenticated Users",
+						"group_security_identifier":   "S-1-5-11",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_entry.*", map[string]string{
+						"access_rights.0.auto_enroll": "DENY",
+						"access_rights.0.enroll":      "DENY",
+						"group_display_name":          "Everyone",
+						"group_security_identifier":   "S-1-1-0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateGroupAccessControlEntriesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template_group_access_control_entries" {
+				continue
+			}
+
+			output, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Template %s Group Access Control Entries still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateGroupAccessControlEntriesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("Private CA Connector for Active Directory Template %s Group Access Control Entries not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTemplateGroupAccessControlEntriesConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic(rName, domain), `
+resource "aws_pcaconnectorad_template_group_access_control_entries" "test" {
+  template_arn = aws_pcaconnectorad_template.test.arn
+
+  access_control_entry {
+    group_display_name        = "Authenticated Users"
+    group_security_identifier = "S-1-5-11"
+
+    access_rights {
+      auto_enroll = "DENY"
+      enroll      = "ALLOW"
+    }
+  }
+}
+`)
+}
+
+func testAccTemplateGroupAccessControlEntriesConfig_updated(rName, domain string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic(rName, domain), `
+resource "aws_pcaconnectorad_template_group_access_control_entries" "test" {
+  template_arn = aws_pcaconnectorad_template.test.arn
+
+  access_control_entry {
+    group_display_name        = "Authenticated Users"
+    group_security_identifier = "S-1-5-11"
+
+    access_rights {
+      auto_enroll = "ALLOW"
+      enroll      = "ALLOW"
+    }
+  }
+
+  access_control_entry {
+    group_display_name        = "Everyone"
+    group_security_identifier = "S-1-1-0"
+
+    access_rights {
+      auto_enroll = "DENY"
+      enroll      = "DENY"
+    }
+  }
+}
+`)
+}
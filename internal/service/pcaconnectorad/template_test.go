@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", "aws_pcaconnectorad_connector.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.certificate_validity.0.validity_period.0.period", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.certificate_validity.0.validity_period.0.period_type", "YEARS"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policies.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policies.0.policy_type", "CLIENT_AUTHENTICATION"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policies.1.policy_object_identifier", "1.3.6.1.5.5.7.3.4"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.private_key_attributes.0.minimal_key_length", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v3.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "object_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "policy_schema", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy_schema", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_templateV4(rName, domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.hash_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.algorithm", "ECDH_P256"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.key_usage_property.0.property_flags.0.sign", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.key_usage_property.0.property_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "policy_schema", "4"),
+					resource.TestCheckResourceAttr(resourceName, "reenroll_all_certificate_holders", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reenroll_all_certificate_holders"},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_tags1(rName, domain, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateConfig_tags2(rName, domain, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_tags1(rName, domain, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateExists(ctx context.Context, n string, v *awstypes.Template) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+const testAccTemplateConfig_templateV2Definition = `
+  definition {
+    template_v2 {
+      certificate_validity {
+        renewal_period {
+          period      = 6
+          period_type = "WEEKS"
+        }
+
+        validity_period {
+          period      = 1
+          period_type = "YEARS"
+        }
+      }
+
+      enrollment_flags {
+        include_symmetric_algorithms = true
+        user_interaction_required    = true
+      }
+
+      extensions {
+        application_policies {
+          policies {
+            policy_type = "CLIENT_AUTHENTICATION"
+          }
+
+          policies {
+            policy_object_identifier = "1.3.6.1.5.5.7.3.4"
+          }
+        }
+
+        key_usage {
+          usage_flags {
+            digital_signature = true
+            key_encipherment  = true
+          }
+        }
+      }
+
+      general_flags {
+        auto_enrollment = true
+      }
+
+      private_key_attributes {
+        key_spec           = "KEY_EXCHANGE"
+        minimal_key_length = 2048
+      }
+
+      private_key_flags {
+        client_version = "WINDOWS_SERVER_2012"
+      }
+
+      subject_name_flags {
+        require_common_name = true
+        san_require_upn     = true
+      }
+    }
+  }
+`
+
+func testAccTemplateConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+%[2]s}
+`, rName, testAccTemplateConfig_templateV2Definition))
+}
+
+func testAccTemplateConfig_templateV4(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn                    = aws_pcaconnectorad_connector.test.arn
+  name                             = %[1]q
+  reenroll_all_certificate_holders = true
+
+  definition {
+    template_v4 {
+      hash_algorithm = "SHA256"
+
+      certificate_validity {
+        renewal_period {
+          period      = 2
+          period_type = "WEEKS"
+        }
+
+        validity_period {
+          period      = 6
+          period_type = "MONTHS"
+        }
+      }
+
+      enrollment_flags {}
+
+      extensions {
+        key_usage {
+          critical = true
+
+          usage_flags {
+            digital_signature = true
+          }
+        }
+      }
+
+      general_flags {
+        machine_type = true
+      }
+
+      private_key_attributes {
+        algorithm          = "ECDH_P256"
+        key_spec           = "SIGNATURE"
+        minimal_key_length = 256
+
+        key_usage_property {
+          property_flags {
+            sign = true
+          }
+        }
+      }
+
+      private_key_flags {
+        client_version      = "WINDOWS_SERVER_2016"
+        use_legacy_provider = false
+      }
+
+      subject_name_flags {
+        san_require_dns = true
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTemplateConfig_tags1(rName, domain, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccTemplateConfig_templateV2Definition, tagKey1, tagValue1))
+}
+
+func testAccTemplateConfig_tags2(rName, domain, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccTemplateConfig_templateV2Definition, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -5,12 +5,16 @@ package ram
 
 // Exports for use in tests only.
 var (
+	ResourcePermission              = resourcePermission
+	ResourcePermissionAssociation   = resourcePermissionAssociation
 	ResourcePrincipalAssociation    = resourcePrincipalAssociation
 	ResourceResourceAssociation     = resourceResourceAssociation
 	ResourceResourceShare           = resourceResourceShare
 	ResourceResourceShareAccepter   = resourceResourceShareAccepter
 	ResourceSharingWithOrganization = resourceSharingWithOrganization
 
+	FindPermissionAssociationByTwoPartKey    = findPermissionAssociationByTwoPartKey
+	FindPermissionByARN                      = findPermissionByARN
 	FindPrincipalAssociationByTwoPartKey     = findPrincipalAssociationByTwoPartKey
 	FindResourceAssociationByTwoPartKey      = findResourceAssociationByTwoPartKey
 	FindResourceShareOwnerOtherAccountsByARN = findResourceShareOwnerOtherAccountsByARN
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	permissionAssociationPropagationTimeout = 1 * time.Minute
+)
+
+// @SDKResource("aws_ram_permission_association", name="Permission Association")
+func resourcePermissionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionAssociationCreate,
+		ReadWithoutTimeout:   resourcePermissionAssociationRead,
+		UpdateWithoutTimeout: resourcePermissionAssociationUpdate,
+		DeleteWithoutTimeout: resourcePermissionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"permission_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	permissionAssociationResourceIDPartCount = 2
+)
+
+func resourcePermissionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	resourceShareARN, permissionARN := d.Get("resource_share_arn").(string), d.Get("permission_arn").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{resourceShareARN, permissionARN}, permissionAssociationResourceIDPartCount, false))
+	input := &ram.AssociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		Replace:          aws.Bool(d.Get("replace").(bool)),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	if v, ok := d.GetOk("permission_version"); ok {
+		input.PermissionVersion = aws.Int32(int32(v.(int)))
+	}
+
+	_, err := conn.AssociateResourceSharePermission(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, permissionAssociationPropagationTimeout, func() (interface{}, error) {
+		return findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), permissionAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceShareARN, permissionARN := parts[0], parts[1]
+
+	permission, err := findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	version, err := strconv.Atoi(aws.ToString(permission.Version))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("default_version", permission.DefaultVersion)
+	d.Set("permission_arn", permission.Arn)
+	d.Set("permission_version", version)
+	d.Set("resource_share_arn", resourceShareARN)
+
+	return diags
+}
+
+func resourcePermissionAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	if d.HasChange("permission_version") {
+		// Replacing the association updates the resource share to the requested version.
+		input := &ram.AssociateResourceSharePermissionInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			PermissionArn:     aws.String(d.Get("permission_arn").(string)),
+			PermissionVersion: aws.Int32(int32(d.Get("permission_version").(int))),
+			Replace:           aws.Bool(true),
+			ResourceShareArn:  aws.String(d.Get("resource_share_arn").(string)),
+		}
+
+		_, err := conn.AssociateResourceSharePermission(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), permissionAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceShareARN, permissionARN := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting RAM Permission Association: %s", d.Id())
+	_, err = conn.DisassociateResourceSharePermission(ctx, &ram.DisassociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		ResourceShareArn: aws.String(resourceShareARN),
+	})
+
+	if errs.IsA[*awstypes.UnknownResourceException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, permissionAssociationPropagationTimeout, func() (interface{}, error) {
+		return findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPermissionAssociationByTwoPartKey(ctx context.Context, conn *ram.Client, resourceShareARN, permissionARN string) (*awstypes.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	pages := ram.NewListResourceSharePermissionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.UnknownResourceException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Permissions {
+			if aws.ToString(v.Arn) == permissionARN {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfram "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMPermissionAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission awstypes.ResourceSharePermissionSummary
+	resourceName := "aws_ram_permission_association.test"
+	permissionResourceName := "aws_ram_permission.test"
+	resourceShareResourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_arn", permissionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", resourceShareResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace"},
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission awstypes.ResourceSharePermissionSummary
+	resourceName := "aws_ram_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permission),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfram.ResourcePermissionAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionAssociationExists(ctx context.Context, n string, v *awstypes.ResourceSharePermissionSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMClient(ctx)
+
+		output, err := tfram.FindPermissionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_share_arn"], rs.Primary.Attributes["permission_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ram_permission_association" {
+				continue
+			}
+
+			_, err := tfram.FindPermissionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_share_arn"], rs.Primary.Attributes["permission_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RAM Permission Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPermissionAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_basic(rName, `"ec2:GetIpamPoolAllocations"`), fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_permission_association" "test" {
+  permission_arn     = aws_ram_permission.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+`, rName))
+}
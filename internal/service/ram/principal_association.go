@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ram"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,6 +43,8 @@ func resourcePrincipalAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourcePrincipalAssociationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrPrincipal: {
 				Type:     schema.TypeString,
@@ -169,6 +174,87 @@ func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourcePrincipalAssociationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("resource_share_arn") || !diff.NewValueKnown(names.AttrPrincipal) {
+		return nil
+	}
+
+	resourceShareARN, principal := diff.Get("resource_share_arn").(string), diff.Get(names.AttrPrincipal).(string)
+	resourceShare, err := findResourceShareOwnerSelfByARN(ctx, meta.(*conns.AWSClient).RAMClient(ctx), resourceShareARN)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RAM Resource Share (%s): %w", resourceShareARN, err)
+	}
+
+	if aws.ToBool(resourceShare.AllowExternalPrincipals) {
+		return nil
+	}
+
+	external, err := principalIsExternal(ctx, meta.(*conns.AWSClient), principal)
+
+	if err != nil {
+		return err
+	}
+
+	if external {
+		return fmt.Errorf("principal (%s) is outside of your organization and RAM Resource Share (%s) does not allow external principals", principal, resourceShareARN)
+	}
+
+	return nil
+}
+
+// principalIsExternal returns whether the principal is known to be outside of the caller's organization.
+// Principals whose organization membership cannot be determined are not considered external.
+func principalIsExternal(ctx context.Context, awsClient *conns.AWSClient, principal string) (bool, error) {
+	var accountID, organizationID string
+
+	if itypes.IsAWSAccountID(principal) {
+		accountID = principal
+	} else if arn.IsARN(principal) {
+		v, err := arn.Parse(principal)
+		if err != nil {
+			return false, err
+		}
+
+		switch v.Service {
+		case "organizations":
+			// arn:${Partition}:organizations::${MasterAccountId}:organization/o-${OrganizationId}
+			// arn:${Partition}:organizations::${MasterAccountId}:ou/o-${OrganizationId}/ou-${OrganizationalUnitId}
+			if parts := strings.Split(v.Resource, "/"); len(parts) > 1 {
+				organizationID = parts[1]
+			}
+		default:
+			accountID = v.AccountID
+		}
+	}
+
+	if (accountID == "" || accountID == awsClient.AccountID) && organizationID == "" {
+		return false, nil
+	}
+
+	organization, err := tforganizations.FindOrganization(ctx, awsClient.OrganizationsClient(ctx))
+
+	if tfresource.NotFound(err) {
+		// The caller isn't in an organization, so every other account is external.
+		return true, nil
+	}
+
+	if err != nil {
+		// Organization details aren't available to every caller; leave validation to RAM.
+		return false, nil
+	}
+
+	if organizationID != "" {
+		return organizationID != aws.ToString(organization.Id), nil
+	}
+
+	return false, nil
+}
+
 func findPrincipalAssociationByTwoPartKey(ctx context.Context, conn *ram.Client, resourceShareARN, principal string) (*awstypes.ResourceShareAssociation, error) {
 	input := &ram.GetResourceShareAssociationsInput{
 		AssociationType:   awstypes.ResourceShareAssociationTypePrincipal,
@@ -128,6 +128,24 @@ func TestAccRAMPrincipalAssociation_duplicate(t *testing.T) {
 	})
 }
 
+func TestAccRAMPrincipalAssociation_externalPrincipalNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrincipalAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPrincipalAssociationConfig_externalPrincipalNotAllowed(rName),
+				ExpectError: regexache.MustCompile(`is outside of your organization and RAM Resource Share .* does not allow external principals`),
+			},
+		},
+	})
+}
+
 func testAccPreCheckSharingWithOrganizationEnabled(ctx context.Context, t *testing.T) {
 	err := tfram.FindSharingWithOrganization(ctx, acctest.Provider.Meta().(*conns.AWSClient))
 
@@ -271,3 +289,19 @@ resource "aws_ram_principal_association" "test2" {
 }
 `, rName)
 }
+
+func testAccPrincipalAssociationConfig_externalPrincipalNotAllowed(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  allow_external_principals = false
+  name                      = %[1]q
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_ram_principal_association" "test" {
+  principal          = "arn:${data.aws_partition.current.partition}:organizations::123456789012:organization/o-abcdefghij"
+  resource_share_arn = aws_ram_resource_share.test.id
+}
+`, rName)
+}
@@ -36,6 +36,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourcePermission,
+			TypeName: "aws_ram_permission",
+			Name:     "Permission",
+		},
+		{
+			Factory:  resourcePermissionAssociation,
+			TypeName: "aws_ram_permission_association",
+			Name:     "Permission Association",
+		},
 		{
 			Factory:  resourcePrincipalAssociation,
 			TypeName: "aws_ram_principal_association",
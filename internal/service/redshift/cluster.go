@@ -348,6 +348,13 @@ func resourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"redshift_idc_application_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"snapshot_arn", "snapshot_identifier"},
+			},
 			"skip_final_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -586,6 +593,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			inputC.Encrypted = aws.Bool(v.(bool))
 		}
 
+		if v, ok := d.GetOk("redshift_idc_application_arn"); ok {
+			inputC.RedshiftIdcApplicationArn = aws.String(v.(string))
+		}
+
 		if v := d.Get("number_of_nodes").(int); v > 1 {
 			inputC.ClusterType = aws.String(clusterTypeMultiNode)
 			inputC.NumberOfNodes = aws.Int64(int64(d.Get("number_of_nodes").(int)))
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_redshift_deferred_maintenance_window", name="Deferred Maintenance Window")
+func resourceDeferredMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeferredMaintenanceWindowCreate,
+		ReadWithoutTimeout:   resourceDeferredMaintenanceWindowRead,
+		DeleteWithoutTimeout: resourceDeferredMaintenanceWindowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClusterIdentifier: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"defer_maintenance_duration": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(1, 45),
+				ConflictsWith: []string{"defer_maintenance_end_time"},
+			},
+			"defer_maintenance_end_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"defer_maintenance_duration"},
+			},
+			"defer_maintenance_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"defer_maintenance_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+func resourceDeferredMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	clusterID := d.Get(names.AttrClusterIdentifier).(string)
+	input := &redshift.ModifyClusterMaintenanceInput{
+		ClusterIdentifier: aws.String(clusterID),
+		DeferMaintenance:  aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("defer_maintenance_duration"); ok {
+		input.DeferMaintenanceDuration = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("defer_maintenance_end_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.DeferMaintenanceEndTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("defer_maintenance_identifier"); ok {
+		input.DeferMaintenanceIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("defer_maintenance_start_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.DeferMaintenanceStartTime = aws.Time(t)
+	}
+
+	_, err := conn.ModifyClusterMaintenanceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Deferred Maintenance Window (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	return append(diags, resourceDeferredMaintenanceWindowRead(ctx, d, meta)...)
+}
+
+func resourceDeferredMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	window, err := findDeferredMaintenanceWindowByTwoPartKey(ctx, conn, d.Id(), d.Get("defer_maintenance_identifier").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Deferred Maintenance Window (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Deferred Maintenance Window (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrClusterIdentifier, d.Id())
+	if window.DeferMaintenanceEndTime != nil {
+		d.Set("defer_maintenance_end_time", aws.TimeValue(window.DeferMaintenanceEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("defer_maintenance_end_time", nil)
+	}
+	d.Set("defer_maintenance_identifier", window.DeferMaintenanceIdentifier)
+	if window.DeferMaintenanceStartTime != nil {
+		d.Set("defer_maintenance_start_time", aws.TimeValue(window.DeferMaintenanceStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("defer_maintenance_start_time", nil)
+	}
+
+	return diags
+}
+
+func resourceDeferredMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Redshift Deferred Maintenance Window: %s", d.Id())
+	_, err := conn.ModifyClusterMaintenanceWithContext(ctx, &redshift.ModifyClusterMaintenanceInput{
+		ClusterIdentifier:          aws.String(d.Id()),
+		DeferMaintenance:           aws.Bool(false),
+		DeferMaintenanceIdentifier: aws.String(d.Get("defer_maintenance_identifier").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Deferred Maintenance Window (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeferredMaintenanceWindowByTwoPartKey(ctx context.Context, conn *redshift.Redshift, clusterID, deferMaintenanceID string) (*redshift.DeferredMaintenanceWindow, error) {
+	cluster, err := findClusterByID(ctx, conn, clusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range cluster.DeferredMaintenanceWindows {
+		if v == nil {
+			continue
+		}
+
+		// A cluster has at most one deferred maintenance window.
+		if deferMaintenanceID == "" || aws.StringValue(v.DeferMaintenanceIdentifier) == deferMaintenanceID {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
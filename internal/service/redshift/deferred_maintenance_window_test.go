@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftDeferredMaintenanceWindow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshift_deferred_maintenance_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeferredMaintenanceWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeferredMaintenanceWindowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeferredMaintenanceWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterIdentifier, "aws_redshift_cluster.test", names.AttrClusterIdentifier),
+					resource.TestCheckResourceAttr(resourceName, "defer_maintenance_duration", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "defer_maintenance_end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "defer_maintenance_identifier"),
+					resource.TestCheckResourceAttrSet(resourceName, "defer_maintenance_start_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"defer_maintenance_duration"},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDeferredMaintenanceWindow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshift_deferred_maintenance_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeferredMaintenanceWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeferredMaintenanceWindowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeferredMaintenanceWindowExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceDeferredMaintenanceWindow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeferredMaintenanceWindowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_deferred_maintenance_window" {
+				continue
+			}
+
+			_, err := tfredshift.FindDeferredMaintenanceWindowByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["defer_maintenance_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Deferred Maintenance Window %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeferredMaintenanceWindowExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn(ctx)
+
+		_, err := tfredshift.FindDeferredMaintenanceWindowByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["defer_maintenance_identifier"])
+
+		return err
+	}
+}
+
+func testAccDeferredMaintenanceWindowConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+resource "aws_redshift_deferred_maintenance_window" "test" {
+  cluster_identifier         = aws_redshift_cluster.test.cluster_identifier
+  defer_maintenance_duration = 7
+}
+`)
+}
@@ -11,11 +11,13 @@ var (
 	ResourceClusterSnapshot              = resourceClusterSnapshot
 	ResourceDataShareAuthorization       = newResourceDataShareAuthorization
 	ResourceDataShareConsumerAssociation = newResourceDataShareConsumerAssociation
+	ResourceDeferredMaintenanceWindow    = resourceDeferredMaintenanceWindow
 	ResourceEndpointAccess               = resourceEndpointAccess
 	ResourceEndpointAuthorization        = resourceEndpointAuthorization
 	ResourceEventSubscription            = resourceEventSubscription
 	ResourceHSMClientCertificate         = resourceHSMClientCertificate
 	ResourceHSMConfiguration             = resourceHSMConfiguration
+	ResourceIDCApplication               = resourceIDCApplication
 	ResourceLogging                      = newResourceLogging
 	ResourceParameterGroup               = resourceParameterGroup
 	ResourcePartner                      = resourcePartner
@@ -33,11 +35,13 @@ var (
 	FindClusterSnapshotByID                     = findClusterSnapshotByID
 	FindDataShareAuthorizationByID              = findDataShareAuthorizationByID
 	FindDataShareConsumerAssociationByID        = findDataShareConsumerAssociationByID
+	FindDeferredMaintenanceWindowByTwoPartKey   = findDeferredMaintenanceWindowByTwoPartKey
 	FindEndpointAccessByName                    = findEndpointAccessByName
 	FindEndpointAuthorizationByID               = findEndpointAuthorizationByID
 	FindEventSubscriptionByName                 = findEventSubscriptionByName
 	FindHSMClientCertificateByID                = findHSMClientCertificateByID
 	FindHSMConfigurationByID                    = findHSMConfigurationByID
+	FindIDCApplicationByARN                     = findIDCApplicationByARN
 	FindLoggingByID                             = findLoggingByID
 	FindParameterGroupByName                    = findParameterGroupByName
 	FindPartnerByID                             = findPartnerByID
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_redshift_idc_application", name="IDC Application")
+func resourceIDCApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDCApplicationCreate,
+		ReadWithoutTimeout:   resourceIDCApplicationRead,
+		UpdateWithoutTimeout: resourceIDCApplicationUpdate,
+		DeleteWithoutTimeout: resourceIDCApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorized_token_issuer": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorized_audiences_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"trusted_token_issuer_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrIAMRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"idc_display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"idc_instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"idc_managed_application_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idc_onboard_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"redshift_idc_application_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"service_integration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lake_formation_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(redshift.ServiceAuthorization_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIDCApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	name := d.Get("redshift_idc_application_name").(string)
+	input := &redshift.CreateRedshiftIdcApplicationInput{
+		IamRoleArn:                 aws.String(d.Get(names.AttrIAMRoleARN).(string)),
+		IdcDisplayName:             aws.String(d.Get("idc_display_name").(string)),
+		IdcInstanceArn:             aws.String(d.Get("idc_instance_arn").(string)),
+		RedshiftIdcApplicationName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("authorized_token_issuer"); ok && len(v.([]interface{})) > 0 {
+		input.AuthorizedTokenIssuerList = expandAuthorizedTokenIssuers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("identity_namespace"); ok {
+		input.IdentityNamespace = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service_integration"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceIntegrations = expandServiceIntegrations(v.([]interface{}))
+	}
+
+	output, err := conn.CreateRedshiftIdcApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift IDC Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RedshiftIdcApplication.RedshiftIdcApplicationArn))
+
+	return append(diags, resourceIDCApplicationRead(ctx, d, meta)...)
+}
+
+func resourceIDCApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	app, err := findIDCApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift IDC Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift IDC Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, app.RedshiftIdcApplicationArn)
+	if err := d.Set("authorized_token_issuer", flattenAuthorizedTokenIssuers(app.AuthorizedTokenIssuerList)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authorized_token_issuer: %s", err)
+	}
+	d.Set(names.AttrIAMRoleARN, app.IamRoleArn)
+	d.Set("idc_display_name", app.IdcDisplayName)
+	d.Set("idc_instance_arn", app.IdcInstanceArn)
+	d.Set("idc_managed_application_arn", app.IdcManagedApplicationArn)
+	d.Set("idc_onboard_status", app.IdcOnboardStatus)
+	d.Set("identity_namespace", app.IdentityNamespace)
+	d.Set("redshift_idc_application_name", app.RedshiftIdcApplicationName)
+	if err := d.Set("service_integration", flattenServiceIntegrations(app.ServiceIntegrations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service_integration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceIDCApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	input := &redshift.ModifyRedshiftIdcApplicationInput{
+		RedshiftIdcApplicationArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("authorized_token_issuer") {
+		input.AuthorizedTokenIssuerList = expandAuthorizedTokenIssuers(d.Get("authorized_token_issuer").([]interface{}))
+	}
+
+	if d.HasChange(names.AttrIAMRoleARN) {
+		input.IamRoleArn = aws.String(d.Get(names.AttrIAMRoleARN).(string))
+	}
+
+	if d.HasChange("idc_display_name") {
+		input.IdcDisplayName = aws.String(d.Get("idc_display_name").(string))
+	}
+
+	if d.HasChange("identity_namespace") {
+		input.IdentityNamespace = aws.String(d.Get("identity_namespace").(string))
+	}
+
+	if d.HasChange("service_integration") {
+		input.ServiceIntegrations = expandServiceIntegrations(d.Get("service_integration").([]interface{}))
+	}
+
+	_, err := conn.ModifyRedshiftIdcApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "modifying Redshift IDC Application (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIDCApplicationRead(ctx, d, meta)...)
+}
+
+func resourceIDCApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Redshift IDC Application: %s", d.Id())
+	_, err := conn.DeleteRedshiftIdcApplicationWithContext(ctx, &redshift.DeleteRedshiftIdcApplicationInput{
+		RedshiftIdcApplicationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeRedshiftIdcApplicationNotExistsFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift IDC Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIDCApplicationByARN(ctx context.Context, conn *redshift.Redshift, arn string) (*redshift.RedshiftIdcApplication, error) {
+	input := &redshift.DescribeRedshiftIdcApplicationsInput{
+		RedshiftIdcApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeRedshiftIdcApplicationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeRedshiftIdcApplicationNotExistsFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RedshiftIdcApplications) == 0 || output.RedshiftIdcApplications[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RedshiftIdcApplications); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RedshiftIdcApplications[0], nil
+}
+
+func expandAuthorizedTokenIssuers(tfList []interface{}) []*redshift.AuthorizedTokenIssuer {
+	apiObjects := []*redshift.AuthorizedTokenIssuer{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &redshift.AuthorizedTokenIssuer{
+			TrustedTokenIssuerArn: aws.String(tfMap["trusted_token_issuer_arn"].(string)),
+		}
+
+		if v, ok := tfMap["authorized_audiences_list"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AuthorizedAudiencesList = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAuthorizedTokenIssuers(apiObjects []*redshift.AuthorizedTokenIssuer) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"authorized_audiences_list": aws.StringValueSlice(apiObject.AuthorizedAudiencesList),
+			"trusted_token_issuer_arn":  aws.StringValue(apiObject.TrustedTokenIssuerArn),
+		})
+	}
+
+	return tfList
+}
+
+func expandServiceIntegrations(tfList []interface{}) []*redshift.ServiceIntegrationsUnion {
+	apiObjects := []*redshift.ServiceIntegrationsUnion{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &redshift.ServiceIntegrationsUnion{}
+
+		if v, ok := tfMap["lake_formation_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.LakeFormation = []*redshift.LakeFormationScopeUnion{{
+				LakeFormationQuery: &redshift.LakeFormationQuery{
+					Authorization: aws.String(tfMap["authorization"].(string)),
+				},
+			}}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenServiceIntegrations(apiObjects []*redshift.ServiceIntegrationsUnion) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		for _, v := range apiObject.LakeFormation {
+			if v == nil || v.LakeFormationQuery == nil {
+				continue
+			}
+
+			tfMap["lake_formation_query"] = []interface{}{map[string]interface{}{
+				"authorization": aws.StringValue(v.LakeFormationQuery.Authorization),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -113,6 +113,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceDeferredMaintenanceWindow,
+			TypeName: "aws_redshift_deferred_maintenance_window",
+			Name:     "Deferred Maintenance Window",
+		},
 		{
 			Factory:  resourceEndpointAccess,
 			TypeName: "aws_redshift_endpoint_access",
@@ -147,6 +152,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceIDCApplication,
+			TypeName: "aws_redshift_idc_application",
+			Name:     "IDC Application",
+		},
 		{
 			Factory:  resourceParameterGroup,
 			TypeName: "aws_redshift_parameter_group",
@@ -0,0 +1,740 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Stream Processor")
+// @Tags(identifierAttribute="arn")
+func newStreamProcessorResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &streamProcessorResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type streamProcessorResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*streamProcessorResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rekognition_stream_processor"
+}
+
+func (r *streamProcessorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_sharing_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSharingPreferenceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"opt_in": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kinesis_video_stream": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kinesisVideoStreamModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notification_channel": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[notificationChannelModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSNSTopicARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kinesis_data_stream": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kinesisDataStreamModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("s3_destination"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"s3_destination": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3DestinationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucket: schema.StringAttribute{
+										Required: true,
+									},
+									"key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"regions_of_interest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[regionOfInterestModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bounding_box": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[boundingBoxModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("polygon"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"height": schema.Float64Attribute{
+										Required:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 1)},
+									},
+									"left": schema.Float64Attribute{
+										Required:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 1)},
+									},
+									"top": schema.Float64Attribute{
+										Required:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 1)},
+									},
+									"width": schema.Float64Attribute{
+										Required:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 1)},
+									},
+								},
+							},
+						},
+						"polygon": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[pointModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"x": schema.Float64Attribute{
+										Required:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 1)},
+									},
+									"y": schema.Float64Attribute{
+										Required:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 1)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[settingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connected_home": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[connectedHomeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("face_search"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"labels": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+									"min_confidence": schema.Float64Attribute{
+										Optional:   true,
+										Computed:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 100)},
+									},
+								},
+							},
+						},
+						"face_search": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[faceSearchModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"collection_id": schema.StringAttribute{
+										Required: true,
+									},
+									"face_match_threshold": schema.Float64Attribute{
+										Optional:   true,
+										Computed:   true,
+										Validators: []validator.Float64{float64validator.Between(0, 100)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *streamProcessorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data streamProcessorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RekognitionClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &rekognition.CreateStreamProcessorInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateStreamProcessor(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Rekognition Stream Processor (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.StreamProcessorArn)
+	data.ID = data.Name
+
+	streamProcessor, err := waitStreamProcessorCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Rekognition Stream Processor (%s) create", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, streamProcessor)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *streamProcessorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data streamProcessorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RekognitionClient(ctx)
+
+	output, err := findStreamProcessorByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Rekognition Stream Processor (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *streamProcessorResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new streamProcessorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RekognitionClient(ctx)
+
+	input := &rekognition.UpdateStreamProcessorInput{
+		Name: fwflex.StringFromFramework(ctx, new.ID),
+	}
+	var update bool
+
+	if !new.DataSharingPreference.Equal(old.DataSharingPreference) {
+		input.DataSharingPreferenceForUpdate = &awstypes.StreamProcessorDataSharingPreference{}
+
+		if !new.DataSharingPreference.IsNull() {
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.DataSharingPreference, input.DataSharingPreferenceForUpdate)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		update = true
+	}
+
+	if !new.RegionsOfInterest.Equal(old.RegionsOfInterest) {
+		if len(new.RegionsOfInterest.Elements()) == 0 {
+			input.ParametersToDelete = append(input.ParametersToDelete, awstypes.StreamProcessorParameterToDeleteRegionsOfInterest)
+		} else {
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.RegionsOfInterest, &input.RegionsOfInterestForUpdate)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		update = true
+	}
+
+	if !new.Settings.Equal(old.Settings) {
+		newSettings, d := new.Settings.ToPtr(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldSettings, d := old.Settings.ToPtr(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Only label detection settings can be updated in place.
+		if !newSettings.ConnectedHome.Equal(oldSettings.ConnectedHome) && !newSettings.ConnectedHome.IsNull() {
+			connectedHome, d := newSettings.ConnectedHome.ToPtr(ctx)
+			response.Diagnostics.Append(d...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.SettingsForUpdate = &awstypes.StreamProcessorSettingsForUpdate{
+				ConnectedHomeForUpdate: &awstypes.ConnectedHomeSettingsForUpdate{},
+			}
+			response.Diagnostics.Append(fwflex.Expand(ctx, connectedHome, input.SettingsForUpdate.ConnectedHomeForUpdate)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			update = true
+		}
+	}
+
+	if update {
+		_, err := conn.UpdateStreamProcessor(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Rekognition Stream Processor (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitStreamProcessorUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Rekognition Stream Processor (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *streamProcessorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data streamProcessorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RekognitionClient(ctx)
+
+	_, err := conn.DeleteStreamProcessor(ctx, &rekognition.DeleteStreamProcessorInput{
+		Name: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Rekognition Stream Processor (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitStreamProcessorDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Rekognition Stream Processor (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *streamProcessorResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findStreamProcessorByName(ctx context.Context, conn *rekognition.Client, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	input := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeStreamProcessor(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStreamProcessor(ctx context.Context, conn *rekognition.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findStreamProcessorByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitStreamProcessorCreated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped),
+		Refresh:                   statusStreamProcessor(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errorFromStatusMessage(output.StatusMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StreamProcessorStatusUpdating),
+		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped, awstypes.StreamProcessorStatusRunning),
+		Refresh:                   statusStreamProcessor(ctx, conn, name),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errorFromStatusMessage(output.StatusMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StreamProcessorStatusStopped, awstypes.StreamProcessorStatusStopping, awstypes.StreamProcessorStatusUpdating),
+		Target:  []string{},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errorFromStatusMessage(output.StatusMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func errorFromStatusMessage(v *string) error {
+	if v := aws.ToString(v); v != "" {
+		return fmt.Errorf("%s", v)
+	}
+
+	return nil
+}
+
+type streamProcessorResourceModel struct {
+	ARN                   types.String                                                `tfsdk:"arn"`
+	DataSharingPreference fwtypes.ListNestedObjectValueOf[dataSharingPreferenceModel] `tfsdk:"data_sharing_preference"`
+	ID                    types.String                                                `tfsdk:"id"`
+	Input                 fwtypes.ListNestedObjectValueOf[inputModel]                 `tfsdk:"input"`
+	KMSKeyID              types.String                                                `tfsdk:"kms_key_id"`
+	Name                  types.String                                                `tfsdk:"name"`
+	NotificationChannel   fwtypes.ListNestedObjectValueOf[notificationChannelModel]   `tfsdk:"notification_channel"`
+	Output                fwtypes.ListNestedObjectValueOf[outputModel]                `tfsdk:"output"`
+	RegionsOfInterest     fwtypes.ListNestedObjectValueOf[regionOfInterestModel]      `tfsdk:"regions_of_interest"`
+	RoleARN               fwtypes.ARN                                                 `tfsdk:"role_arn"`
+	Settings              fwtypes.ListNestedObjectValueOf[settingsModel]              `tfsdk:"settings"`
+	Tags                  types.Map                                                   `tfsdk:"tags"`
+	TagsAll               types.Map                                                   `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+// refreshFromOutput writes state data from the DescribeStreamProcessor response.
+func (m *streamProcessorResourceModel) refreshFromOutput(ctx context.Context, output *rekognition.DescribeStreamProcessorOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dataSharingPreference := m.DataSharingPreference
+
+	diags.Append(fwflex.Flatten(ctx, output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ARN = fwflex.StringToFramework(ctx, output.StreamProcessorArn)
+	m.ID = m.Name
+
+	// The API always reports a data sharing preference; it defaults to opted out.
+	if dataSharingPreference.IsNull() && (output.DataSharingPreference == nil || !output.DataSharingPreference.OptIn) {
+		m.DataSharingPreference = dataSharingPreference
+	}
+
+	return diags
+}
+
+type dataSharingPreferenceModel struct {
+	OptIn types.Bool `tfsdk:"opt_in"`
+}
+
+type inputModel struct {
+	KinesisVideoStream fwtypes.ListNestedObjectValueOf[kinesisVideoStreamModel] `tfsdk:"kinesis_video_stream"`
+}
+
+type kinesisVideoStreamModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type notificationChannelModel struct {
+	SNSTopicARN fwtypes.ARN `tfsdk:"sns_topic_arn"`
+}
+
+type outputModel struct {
+	KinesisDataStream fwtypes.ListNestedObjectValueOf[kinesisDataStreamModel] `tfsdk:"kinesis_data_stream"`
+	S3Destination     fwtypes.ListNestedObjectValueOf[s3DestinationModel]     `tfsdk:"s3_destination"`
+}
+
+type kinesisDataStreamModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type s3DestinationModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+}
+
+type regionOfInterestModel struct {
+	BoundingBox fwtypes.ListNestedObjectValueOf[boundingBoxModel] `tfsdk:"bounding_box"`
+	Polygon     fwtypes.ListNestedObjectValueOf[pointModel]       `tfsdk:"polygon"`
+}
+
+type boundingBoxModel struct {
+	Height types.Float64 `tfsdk:"height"`
+	Left   types.Float64 `tfsdk:"left"`
+	Top    types.Float64 `tfsdk:"top"`
+	Width  types.Float64 `tfsdk:"width"`
+}
+
+type pointModel struct {
+	X types.Float64 `tfsdk:"x"`
+	Y types.Float64 `tfsdk:"y"`
+}
+
+type settingsModel struct {
+	ConnectedHome fwtypes.ListNestedObjectValueOf[connectedHomeModel] `tfsdk:"connected_home"`
+	FaceSearch    fwtypes.ListNestedObjectValueOf[faceSearchModel]    `tfsdk:"face_search"`
+}
+
+type connectedHomeModel struct {
+	Labels        fwtypes.ListValueOf[types.String] `tfsdk:"labels"`
+	MinConfidence types.Float64                     `tfsdk:"min_confidence"`
+}
+
+type faceSearchModel struct {
+	CollectionID       types.String  `tfsdk:"collection_id"`
+	FaceMatchThreshold types.Float64 `tfsdk:"face_match_threshold"`
+}
@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The draft version of an application is the one that resources are imported into.
+	appVersionDraft = "draft"
+)
+
+// @SDKResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+func resourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.AppAssessmentScheduleTypeDisabled,
+				ValidateDiagFunc: enum.Validate[awstypes.AppAssessmentScheduleType](),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]{1,59}$`), "must be 2-60 alphanumeric characters, hyphens or underscores, starting with an alphanumeric character"),
+			},
+			"resiliency_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"source_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"terraform_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_state_file_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(https|s3)://`), "must be an S3 URL"),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &resiliencehub.CreateAppInput{
+		AssessmentSchedule: awstypes.AppAssessmentScheduleType(d.Get("assessment_schedule").(string)),
+		Name:               aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateApp(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.App.AppArn))
+
+	sourceARNs := d.Get("source_arns").(*schema.Set)
+	terraformSources := d.Get("terraform_source").(*schema.Set)
+
+	if sourceARNs.Len() > 0 || terraformSources.Len() > 0 {
+		if err := appImportResources(ctx, conn, d.Id(), sourceARNs, terraformSources, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	app, err := findAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set(names.AttrDescription, app.Description)
+	d.Set("drift_status", app.DriftStatus)
+	d.Set(names.AttrName, app.Name)
+	d.Set("resiliency_policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", app.ResiliencyScore)
+	d.Set(names.AttrStatus, app.Status)
+
+	inputSources, err := findAppInputSourcesByTwoPartKey(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s) input sources: %s", d.Id(), err)
+	}
+
+	var sourceARNs []string
+	var terraformSources []interface{}
+	for _, v := range inputSources {
+		if v.TerraformSource != nil {
+			terraformSources = append(terraformSources, map[string]interface{}{
+				"s3_state_file_url": aws.ToString(v.TerraformSource.S3StateFileUrl),
+			})
+		} else if v.SourceArn != nil {
+			sourceARNs = append(sourceARNs, aws.ToString(v.SourceArn))
+		}
+	}
+	d.Set("source_arns", sourceARNs)
+	if err := d.Set("terraform_source", terraformSources); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting terraform_source: %s", err)
+	}
+
+	setTagsOut(ctx, app.Tags)
+
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	if d.HasChanges("assessment_schedule", names.AttrDescription, "resiliency_policy_arn") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn:             aws.String(d.Id()),
+			AssessmentSchedule: awstypes.AppAssessmentScheduleType(d.Get("assessment_schedule").(string)),
+			Description:        aws.String(d.Get(names.AttrDescription).(string)),
+		}
+
+		if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+			input.PolicyArn = aws.String(v.(string))
+		} else {
+			input.ClearResiliencyPolicyArn = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateApp(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("source_arns", "terraform_source") {
+		sourceARNs := d.Get("source_arns").(*schema.Set)
+		terraformSources := d.Get("terraform_source").(*schema.Set)
+
+		if sourceARNs.Len() > 0 || terraformSources.Len() > 0 {
+			if err := appImportResources(ctx, conn, d.Id(), sourceARNs, terraformSources, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else {
+			if err := appDeleteInputSources(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteApp(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// appImportResources replaces the resources in the application's draft version with those
+// from the specified sources and publishes the draft so that assessments use them.
+func appImportResources(ctx context.Context, conn *resiliencehub.Client, arn string, sourceARNs, terraformSources *schema.Set, timeout time.Duration) error {
+	input := &resiliencehub.ImportResourcesToDraftAppVersionInput{
+		AppArn:         aws.String(arn),
+		ImportStrategy: awstypes.ResourceImportStrategyTypeReplaceAll,
+	}
+
+	if sourceARNs.Len() > 0 {
+		input.SourceArns = flex.ExpandStringValueSet(sourceARNs)
+	}
+
+	for _, v := range terraformSources.List() {
+		input.TerraformSources = append(input.TerraformSources, awstypes.TerraformSource{
+			S3StateFileUrl: aws.String(v.(map[string]interface{})["s3_state_file_url"].(string)),
+		})
+	}
+
+	_, err := conn.ImportResourcesToDraftAppVersion(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("importing resources to Resilience Hub App (%s): %w", arn, err)
+	}
+
+	if _, err := waitDraftAppVersionResourcesImported(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for Resilience Hub App (%s) resource import: %w", arn, err)
+	}
+
+	return appPublishVersion(ctx, conn, arn)
+}
+
+// appDeleteInputSources removes all input sources from the application's draft version
+// and publishes the draft.
+func appDeleteInputSources(ctx context.Context, conn *resiliencehub.Client, arn string) error {
+	inputSources, err := findAppInputSourcesByTwoPartKey(ctx, conn, arn, appVersionDraft)
+
+	if err != nil {
+		return fmt.Errorf("reading Resilience Hub App (%s) input sources: %w", arn, err)
+	}
+
+	for _, v := range inputSources {
+		input := &resiliencehub.DeleteAppInputSourceInput{
+			AppArn:          aws.String(arn),
+			SourceArn:       v.SourceArn,
+			TerraformSource: v.TerraformSource,
+		}
+
+		_, err := conn.DeleteAppInputSource(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting Resilience Hub App (%s) input source: %w", arn, err)
+		}
+	}
+
+	return appPublishVersion(ctx, conn, arn)
+}
+
+func appPublishVersion(ctx context.Context, conn *resiliencehub.Client, arn string) error {
+	input := &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(arn),
+	}
+
+	_, err := conn.PublishAppVersion(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("publishing Resilience Hub App (%s) version: %w", arn, err)
+	}
+
+	return nil
+}
+
+func findAppByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.App, error) {
+	input := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func findAppInputSourcesByTwoPartKey(ctx context.Context, conn *resiliencehub.Client, arn, version string) ([]awstypes.AppInputSource, error) {
+	input := &resiliencehub.ListAppInputSourcesInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(version),
+	}
+	var output []awstypes.AppInputSource
+
+	pages := resiliencehub.NewListAppInputSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AppInputSources...)
+	}
+
+	return output, nil
+}
+
+func findDraftAppVersionResourcesImportStatusByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	input := &resiliencehub.DescribeDraftAppVersionResourcesImportStatusInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDraftAppVersionResourcesImportStatus(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusApp(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusDraftAppVersionResourcesImport(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDraftAppVersionResourcesImportStatusByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppStatusTypeActive, awstypes.AppStatusTypeDeleting),
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDraftAppVersionResourcesImported(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceImportStatusTypePending, awstypes.ResourceImportStatusTypeInProgress),
+		Target:  enum.Slice(awstypes.ResourceImportStatusTypeSuccess),
+		Refresh: statusDraftAppVersionResourcesImport(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput); ok {
+		if output.Status == awstypes.ResourceImportStatusTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+// Exports for use in tests only.
+var (
+	FindAppByARN              = findAppByARN
+	FindResiliencyPolicyByARN = findResiliencyPolicyByARN
+
+	ResourceApp              = resourceApp
+	ResourceResiliencyPolicy = resourceResiliencyPolicy
+)
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_resiliencehub_app", &resource.Sweeper{
+		Name: "aws_resiliencehub_app",
+		F:    sweepApps,
+	})
+
+	resource.AddTestSweepers("aws_resiliencehub_resiliency_policy", &resource.Sweeper{
+		Name:         "aws_resiliencehub_resiliency_policy",
+		F:            sweepResiliencyPolicies,
+		Dependencies: []string{"aws_resiliencehub_app"},
+	})
+}
+
+func sweepApps(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.ResilienceHubClient(ctx)
+	input := &resiliencehub.ListAppsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := resiliencehub.NewListAppsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Resilience Hub App sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Resilience Hub Apps (%s): %w", region, err)
+		}
+
+		for _, v := range page.AppSummaries {
+			r := resourceApp()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.AppArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resilience Hub Apps (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepResiliencyPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.ResilienceHubClient(ctx)
+	input := &resiliencehub.ListResiliencyPoliciesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := resiliencehub.NewListResiliencyPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Resilience Hub Resiliency Policy sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Resilience Hub Resiliency Policies (%s): %w", region, err)
+		}
+
+		for _, v := range page.ResiliencyPolicies {
+			r := resourceResiliencyPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.PolicyArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resilience Hub Resiliency Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_intelligent_tiering_configurations", name="Bucket Intelligent-Tiering Configurations")
+func resourceBucketIntelligentTieringConfigurations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketIntelligentTieringConfigurationsPut,
+		ReadWithoutTimeout:   resourceBucketIntelligentTieringConfigurationsRead,
+		UpdateWithoutTimeout: resourceBucketIntelligentTieringConfigurationsPut,
+		DeleteWithoutTimeout: resourceBucketIntelligentTieringConfigurationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceBucketIntelligentTieringConfigurationsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrFilter: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrPrefix: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrTags: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.IntelligentTieringStatusEnabled,
+							ValidateDiagFunc: enum.Validate[types.IntelligentTieringStatus](),
+						},
+						"tiering": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_tier": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.IntelligentTieringAccessTier](),
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketIntelligentTieringConfigurationsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+
+	var configs []types.IntelligentTieringConfiguration
+	if d.IsNewResource() {
+		// Wait for a newly created bucket to become visible before listing its configurations.
+		outputRaw, err := tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+			return findIntelligentTieringConfigurations(ctx, conn, bucket)
+		})
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "IntelligentTieringConfiguration is not valid, expected CreateBucketConfiguration") {
+			err = errDirectoryBucket(err)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Intelligent-Tiering Configurations: %s", bucket, err)
+		}
+
+		configs = outputRaw.([]types.IntelligentTieringConfiguration)
+	} else {
+		var err error
+		configs, err = findIntelligentTieringConfigurations(ctx, conn, bucket)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Intelligent-Tiering Configurations: %s", bucket, err)
+		}
+	}
+
+	existing := make(map[string]types.IntelligentTieringConfiguration, len(configs))
+	for _, config := range configs {
+		existing[aws.ToString(config.Id)] = config
+	}
+
+	desired, err := expandIntelligentTieringConfigurations(ctx, d.Get(names.AttrConfiguration).(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Only write the configurations that have changed; Status changes are applied in place.
+	for _, config := range desired {
+		name := aws.ToString(config.Id)
+
+		if old, ok := existing[name]; ok && intelligentTieringConfigurationEqual(ctx, old, config) {
+			continue
+		}
+
+		input := &s3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          aws.String(bucket),
+			Id:                              aws.String(name),
+			IntelligentTieringConfiguration: &config,
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+			return conn.PutBucketIntelligentTieringConfiguration(ctx, input)
+		}, errCodeNoSuchBucket)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting S3 Bucket (%s) Intelligent-Tiering Configuration (%s): %s", bucket, name, err)
+		}
+	}
+
+	// Remove any configurations that are no longer in the desired set.
+	for name := range existing {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+
+		if err := deleteIntelligentTieringConfiguration(ctx, conn, bucket, name); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Intelligent-Tiering Configuration (%s): %s", bucket, name, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(bucket)
+	}
+
+	return append(diags, resourceBucketIntelligentTieringConfigurationsRead(ctx, d, meta)...)
+}
+
+func resourceBucketIntelligentTieringConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	configs, err := findIntelligentTieringConfigurations(ctx, conn, d.Id())
+
+	if err == nil && len(configs) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Intelligent-Tiering Configurations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Intelligent-Tiering Configurations (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, d.Id())
+	if err := d.Set(names.AttrConfiguration, flattenIntelligentTieringConfigurations(ctx, configs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBucketIntelligentTieringConfigurationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	configs, err := findIntelligentTieringConfigurations(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Intelligent-Tiering Configurations (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Intelligent-Tiering Configurations: %s", d.Id())
+	for _, config := range configs {
+		name := aws.ToString(config.Id)
+
+		if err := deleteIntelligentTieringConfiguration(ctx, conn, d.Id(), name); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Intelligent-Tiering Configuration (%s): %s", d.Id(), name, err)
+		}
+	}
+
+	return diags
+}
+
+func deleteIntelligentTieringConfiguration(ctx context.Context, conn *s3.Client, bucket, name string) error {
+	_, err := conn.DeleteBucketIntelligentTieringConfiguration(ctx, &s3.DeleteBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchConfiguration) {
+		return nil
+	}
+
+	return err
+}
+
+func findIntelligentTieringConfigurations(ctx context.Context, conn *s3.Client, bucket string) ([]types.IntelligentTieringConfiguration, error) {
+	input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: aws.String(bucket),
+	}
+	var output []types.IntelligentTieringConfiguration
+
+	for {
+		page, err := conn.ListBucketIntelligentTieringConfigurations(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.IntelligentTieringConfigurationList...)
+
+		if !aws.ToBool(page.IsTruncated) {
+			break
+		}
+
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return output, nil
+}
+
+// resourceBucketIntelligentTieringConfigurationsCustomizeDiff acts as a plan-time validation
+// that configuration names are unique. Configurations are keyed by name, so a duplicate would
+// silently overwrite another configuration on every apply.
+func resourceBucketIntelligentTieringConfigurationsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get(names.AttrConfiguration).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Names that are unknown at plan time are checked during apply.
+		name := tfMap[names.AttrName].(string)
+
+		if name == "" {
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("duplicate Intelligent-Tiering configuration name (%s)", name)
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	return nil
+}
+
+// intelligentTieringConfigurationEqual returns whether two configurations with the same ID are equivalent.
+func intelligentTieringConfigurationEqual(ctx context.Context, a, b types.IntelligentTieringConfiguration) bool {
+	if a.Status != b.Status {
+		return false
+	}
+
+	if !reflect.DeepEqual(flattenIntelligentTieringFilter(ctx, a.Filter), flattenIntelligentTieringFilter(ctx, b.Filter)) {
+		return false
+	}
+
+	if len(a.Tierings) != len(b.Tierings) {
+		return false
+	}
+
+	tierings := make(map[types.IntelligentTieringAccessTier]int32, len(a.Tierings))
+	for _, v := range a.Tierings {
+		tierings[v.AccessTier] = aws.ToInt32(v.Days)
+	}
+
+	for _, v := range b.Tierings {
+		if days, ok := tierings[v.AccessTier]; !ok || days != aws.ToInt32(v.Days) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandIntelligentTieringConfigurations(ctx context.Context, tfList []interface{}) (map[string]types.IntelligentTieringConfiguration, error) {
+	apiObjects := make(map[string]types.IntelligentTieringConfiguration, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+
+		if _, ok := apiObjects[name]; ok {
+			return nil, fmt.Errorf("duplicate Intelligent-Tiering configuration name (%s)", name)
+		}
+
+		apiObject := types.IntelligentTieringConfiguration{
+			Id:     aws.String(name),
+			Status: types.IntelligentTieringStatus(tfMap[names.AttrStatus].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Filter = expandIntelligentTieringFilter(ctx, v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["tiering"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Tierings = expandTierings(v.List())
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+func flattenIntelligentTieringConfigurations(ctx context.Context, apiObjects []types.IntelligentTieringConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName:   aws.ToString(apiObject.Id),
+			names.AttrStatus: apiObject.Status,
+			"tiering":        flattenTierings(apiObject.Tierings),
+		}
+
+		if apiObject.Filter != nil {
+			tfMap[names.AttrFilter] = []interface{}{flattenIntelligentTieringFilter(ctx, apiObject.Filter)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
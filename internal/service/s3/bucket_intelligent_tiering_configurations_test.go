@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketIntelligentTieringConfigurations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []types.IntelligentTieringConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configurations.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_basic(rName, "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						names.AttrName:   rName + "-1",
+						names.AttrStatus: "Enabled",
+						"tiering.#":      acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						names.AttrName:    rName + "-2",
+						names.AttrStatus:  "Enabled",
+						"filter.#":        acctest.Ct1,
+						"filter.0.prefix": "logs/",
+						"tiering.#":       acctest.Ct2,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_basic(rName, "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						names.AttrName:   rName + "-1",
+						names.AttrStatus: "Disabled",
+					}),
+				),
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_single(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.name", rName+"-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfigurations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []types.IntelligentTieringConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configurations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_single(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketIntelligentTieringConfigurations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfigurations_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationsConfig_duplicateName(rName),
+				ExpectError: regexache.MustCompile(`duplicate Intelligent-Tiering configuration name`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketIntelligentTieringConfigurationsExists(ctx context.Context, n string, v *[]types.IntelligentTieringConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindIntelligentTieringConfigurations(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("S3 Bucket Intelligent-Tiering Configurations %s not found", rs.Primary.ID)
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckBucketIntelligentTieringConfigurationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_intelligent_tiering_configurations" {
+				continue
+			}
+
+			output, err := tfs3.FindIntelligentTieringConfigurations(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("S3 Bucket Intelligent-Tiering Configurations %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketIntelligentTieringConfigurationsConfig_basic(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  configuration {
+    name   = "%[1]s-1"
+    status = %[2]q
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 180
+    }
+  }
+
+  configuration {
+    name = "%[1]s-2"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    tiering {
+      access_tier = "ARCHIVE_ACCESS"
+      days        = 90
+    }
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 180
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, status)
+}
+
+func testAccBucketIntelligentTieringConfigurationsConfig_single(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  configuration {
+    name = "%[1]s-1"
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 180
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketIntelligentTieringConfigurationsConfig_duplicateName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  configuration {
+    name = %[1]q
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 180
+    }
+  }
+
+  configuration {
+    name = %[1]q
+
+    tiering {
+      access_tier = "ARCHIVE_ACCESS"
+      days        = 90
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
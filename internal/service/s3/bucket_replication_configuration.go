@@ -5,6 +5,8 @@ package s3
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -309,6 +311,8 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
 	}
 }
 
@@ -471,6 +475,61 @@ func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket s
 	return output.ReplicationConfiguration, nil
 }
 
+// resourceBucketReplicationConfigurationCustomizeDiff acts as a plan-time validation
+// to prevent errors from the S3 API that are otherwise only reported during apply.
+func resourceBucketReplicationConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var hasFilter, hasNoFilter bool
+
+	for i, tfMapRaw := range d.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// An empty 'filter {}' block expands to a list with a single nil element.
+		if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 {
+			hasFilter = true
+		} else {
+			hasNoFilter = true
+
+			if v, ok := tfMap["delete_marker_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				return fmt.Errorf("rule[%d]: delete_marker_replication requires filter; to apply the rule to all objects, specify an empty filter {} block", i)
+			}
+		}
+
+		v, ok := tfMap[names.AttrDestination].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+		destination := v[0].(map[string]interface{})
+
+		if v, ok := destination["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v[0].(map[string]interface{})[names.AttrStatus].(string) != string(types.ReplicationTimeStatusEnabled) {
+				continue
+			}
+
+			// https://docs.aws.amazon.com/AmazonS3/latest/userguide/replication-time-control.html#enabling-replication-time-control.
+			var metricsStatus string
+			if v, ok := destination["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				// Status is empty if not yet known.
+				if metricsStatus = v[0].(map[string]interface{})[names.AttrStatus].(string); metricsStatus == "" {
+					continue
+				}
+			}
+
+			if metricsStatus != string(types.MetricsStatusEnabled) {
+				return fmt.Errorf("rule[%d]: destination.metrics must be enabled when destination.replication_time is enabled", i)
+			}
+		}
+	}
+
+	if hasFilter && hasNoFilter {
+		return errors.New("all rules must specify filter when any rule specifies filter; to apply a rule to all objects, specify an empty filter {} block")
+	}
+
+	return nil
+}
+
 func expandReplicationRules(ctx context.Context, l []interface{}) []types.ReplicationRule {
 	var rules []types.ReplicationRule
 
@@ -503,6 +503,52 @@ func TestAccS3BucketReplicationConfiguration_replicationTimeControl(t *testing.T
 	})
 }
 
+func TestAccS3BucketReplicationConfiguration_replicationTimeControlWithoutMetrics(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_rtcWithoutMetrics(rName),
+				ExpectError: regexache.MustCompile(`destination.metrics must be enabled when destination.replication_time is enabled`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_deleteMarkerReplicationWithoutFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_deleteMarkerReplicationWithoutFilter(rName),
+				ExpectError: regexache.MustCompile(`delete_marker_replication requires filter`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketReplicationConfiguration_replicaModifications(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1431,6 +1477,63 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`)
 }
 
+func testAccBucketReplicationConfigurationConfig_rtcWithoutMetrics(rName string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      replication_time {
+        status = "Enabled"
+        time {
+          minutes = 15
+        }
+      }
+    }
+  }
+}`)
+}
+
+func testAccBucketReplicationConfigurationConfig_deleteMarkerReplicationWithoutFilter(rName string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "foobar"
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+    }
+  }
+}`)
+}
+
 func testAccBucketReplicationConfigurationConfig_replicaMods(rName string) string {
 	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
 resource "aws_s3_bucket_replication_configuration" "test" {
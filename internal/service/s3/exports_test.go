@@ -10,6 +10,7 @@ var (
 	ResourceBucketAnalyticsConfiguration            = resourceBucketAnalyticsConfiguration
 	ResourceBucketCorsConfiguration                 = resourceBucketCorsConfiguration
 	ResourceBucketIntelligentTieringConfiguration   = resourceBucketIntelligentTieringConfiguration
+	ResourceBucketIntelligentTieringConfigurations  = resourceBucketIntelligentTieringConfigurations
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = resourceBucketLifecycleConfiguration
 	ResourceBucketLogging                           = resourceBucketLogging
@@ -44,6 +45,7 @@ var (
 	FindBucketWebsite                     = findBucketWebsite
 	FindCORSRules                         = findCORSRules
 	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
+	FindIntelligentTieringConfigurations  = findIntelligentTieringConfigurations
 	FindInventoryConfiguration            = findInventoryConfiguration
 	FindLifecycleRules                    = findLifecycleRules
 	FindLoggingEnabled                    = findLoggingEnabled
@@ -114,6 +114,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_intelligent_tiering_configuration",
 			Name:     "Bucket Intelligent-Tiering Configuration",
 		},
+		{
+			Factory:  resourceBucketIntelligentTieringConfigurations,
+			TypeName: "aws_s3_bucket_intelligent_tiering_configurations",
+			Name:     "Bucket Intelligent-Tiering Configurations",
+		},
 		{
 			Factory:  resourceBucketInventory,
 			TypeName: "aws_s3_bucket_inventory",
@@ -103,6 +103,16 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
 									names.AttrPrefix: {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -116,6 +126,25 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
 						names.AttrStatus: {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -382,6 +411,10 @@ func expandLifecycleRule(ctx context.Context, tfMap map[string]interface{}) *typ
 		apiObject.ID = aws.String(v)
 	}
 
+	if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NoncurrentVersionExpiration = expandNoncurrentVersionExpiration(v)
+	}
+
 	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
 		apiObject.Status = types.ExpirationStatus(v)
 	}
@@ -427,6 +460,57 @@ func expandLifecycleRuleFilter(ctx context.Context, tfList []interface{}) *types
 		}
 	}
 
+	objectSizeGreaterThan, _ := tfMap["object_size_greater_than"].(int)
+	objectSizeLessThan, _ := tfMap["object_size_less_than"].(int)
+
+	if objectSizeGreaterThan == 0 && objectSizeLessThan == 0 {
+		return apiObject
+	}
+
+	// A filter with more than one predicate must be expressed with the And operator.
+	if apiObject.And == nil && (apiObject.Prefix != nil || apiObject.Tag != nil || (objectSizeGreaterThan > 0 && objectSizeLessThan > 0)) {
+		apiObject.And = &types.LifecycleRuleAndOperator{
+			Prefix: apiObject.Prefix,
+		}
+		if apiObject.Tag != nil {
+			apiObject.And.Tags = []types.S3Tag{*apiObject.Tag}
+		}
+		apiObject.Prefix = nil
+		apiObject.Tag = nil
+	}
+
+	if apiObject.And != nil {
+		apiObject.And.ObjectSizeGreaterThan = int64(objectSizeGreaterThan)
+		apiObject.And.ObjectSizeLessThan = int64(objectSizeLessThan)
+	} else {
+		apiObject.ObjectSizeGreaterThan = int64(objectSizeGreaterThan)
+		apiObject.ObjectSizeLessThan = int64(objectSizeLessThan)
+	}
+
+	return apiObject
+}
+
+func expandNoncurrentVersionExpiration(tfList []interface{}) *types.NoncurrentVersionExpiration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &types.NoncurrentVersionExpiration{}
+
+	if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v != 0 {
+		apiObject.NewerNoncurrentVersions = int32(v)
+	}
+
+	if v, ok := tfMap["noncurrent_days"].(int); ok && v != 0 {
+		apiObject.NoncurrentDays = int32(v)
+	}
+
 	return apiObject
 }
 
@@ -490,6 +574,10 @@ func flattenLifecycleRule(ctx context.Context, apiObject types.LifecycleRule) ma
 		tfMap[names.AttrID] = aws.ToString(v)
 	}
 
+	if v := apiObject.NoncurrentVersionExpiration; v != nil {
+		tfMap["noncurrent_version_expiration"] = flattenNoncurrentVersionExpiration(v)
+	}
+
 	return tfMap
 }
 
@@ -508,6 +596,9 @@ func flattenLifecycleRuleFilter(ctx context.Context, apiObject *types.LifecycleR
 		if v := apiObject.And.Tags; v != nil {
 			tfMap[names.AttrTags] = keyValueTagsS3(ctx, v).IgnoreAWS().Map()
 		}
+
+		tfMap["object_size_greater_than"] = apiObject.And.ObjectSizeGreaterThan
+		tfMap["object_size_less_than"] = apiObject.And.ObjectSizeLessThan
 	} else {
 		if v := apiObject.Prefix; v != nil {
 			tfMap[names.AttrPrefix] = aws.ToString(v)
@@ -516,6 +607,22 @@ func flattenLifecycleRuleFilter(ctx context.Context, apiObject *types.LifecycleR
 		if v := apiObject.Tag; v != nil {
 			tfMap[names.AttrTags] = keyValueTagsS3(ctx, []types.S3Tag{*v}).IgnoreAWS().Map()
 		}
+
+		tfMap["object_size_greater_than"] = apiObject.ObjectSizeGreaterThan
+		tfMap["object_size_less_than"] = apiObject.ObjectSizeLessThan
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNoncurrentVersionExpiration(apiObject *types.NoncurrentVersionExpiration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"newer_noncurrent_versions": apiObject.NewerNoncurrentVersions,
+		"noncurrent_days":           apiObject.NoncurrentDays,
 	}
 
 	return []interface{}{tfMap}
@@ -291,6 +291,51 @@ func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_prefix(t *testing.T
 	})
 }
 
+func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_objectSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleFilterObjectSize(rName, 500, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":                          acctest.Ct1,
+						"filter.0.object_size_greater_than": "500",
+						"filter.0.object_size_less_than":    acctest.Ct0,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleFilterObjectSize(rName, 500, 64000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":                          acctest.Ct1,
+						"filter.0.object_size_greater_than": "500",
+						"filter.0.object_size_less_than":    "64000",
+						"filter.0.prefix":                   "test/",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ControlBucketLifecycleConfiguration_RuleFilter_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -391,6 +436,50 @@ func TestAccS3ControlBucketLifecycleConfiguration_Rule_id(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlBucketLifecycleConfiguration_Rule_noncurrentVersionExpiration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleNoncurrentVersionExpiration(rName, 30, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"noncurrent_version_expiration.#":                           acctest.Ct1,
+						"noncurrent_version_expiration.0.newer_noncurrent_versions": acctest.Ct2,
+						"noncurrent_version_expiration.0.noncurrent_days":           "30",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_ruleNoncurrentVersionExpiration(rName, 90, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"noncurrent_version_expiration.#":                           acctest.Ct1,
+						"noncurrent_version_expiration.0.newer_noncurrent_versions": "5",
+						"noncurrent_version_expiration.0.noncurrent_days":           "90",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ControlBucketLifecycleConfiguration_Rule_status(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -626,6 +715,70 @@ resource "aws_s3control_bucket_lifecycle_configuration" "test" {
 `, rName, prefix)
 }
 
+func testAccBucketLifecycleConfigurationConfig_ruleFilterObjectSize(rName string, greaterThan, lessThan int) string {
+	if lessThan == 0 {
+		return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3control_bucket.test.arn
+
+  rule {
+    expiration {
+      days = 365
+    }
+
+    filter {
+      object_size_greater_than = %[2]d
+    }
+
+    id = "test"
+  }
+}
+`, rName, greaterThan)
+	}
+
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3control_bucket.test.arn
+
+  rule {
+    expiration {
+      days = 365
+    }
+
+    filter {
+      object_size_greater_than = %[2]d
+      object_size_less_than    = %[3]d
+      prefix                   = "test/"
+    }
+
+    id = "test"
+  }
+}
+`, rName, greaterThan, lessThan)
+}
+
 func testAccBucketLifecycleConfigurationConfig_ruleFilterTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
@@ -721,6 +874,34 @@ resource "aws_s3control_bucket_lifecycle_configuration" "test" {
 `, rName, id)
 }
 
+func testAccBucketLifecycleConfigurationConfig_ruleNoncurrentVersionExpiration(rName string, noncurrentDays, newerNoncurrentVersions int) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_s3control_bucket" "test" {
+  bucket     = %[1]q
+  outpost_id = data.aws_outposts_outpost.test.id
+}
+
+resource "aws_s3control_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3control_bucket.test.arn
+
+  rule {
+    id = "test"
+
+    noncurrent_version_expiration {
+      newer_noncurrent_versions = %[3]d
+      noncurrent_days           = %[2]d
+    }
+  }
+}
+`, rName, noncurrentDays, newerNoncurrentVersions)
+}
+
 func testAccBucketLifecycleConfigurationConfig_ruleStatus(rName, status string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
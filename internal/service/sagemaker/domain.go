@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -37,6 +38,12 @@ func ResourceDomain() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"app_network_access_type": {
 				Type:         schema.TypeString,
@@ -1046,7 +1053,7 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(domainID)
 
-	if _, err := WaitDomainInService(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitDomainInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SageMaker Domain (%s): waiting for completion: %s", d.Id(), err)
 	}
 
@@ -1127,7 +1134,7 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker Domain: %s", err)
 		}
 
-		if _, err := WaitDomainInService(ctx, conn, d.Id()); err != nil {
+		if _, err := WaitDomainInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Domain (%s) to update: %s", d.Id(), err)
 		}
 	}
@@ -1153,7 +1160,7 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if _, err := WaitDomainDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitDomainDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		if !tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
 			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Domain (%s) to delete: %s", d.Id(), err)
 		}
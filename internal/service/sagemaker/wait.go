@@ -24,8 +24,6 @@ const (
 	ImageDeletedTimeout                = 10 * time.Minute
 	ImageVersionCreatedTimeout         = 10 * time.Minute
 	ImageVersionDeletedTimeout         = 10 * time.Minute
-	DomainInServiceTimeout             = 10 * time.Minute
-	DomainDeletedTimeout               = 10 * time.Minute
 	FeatureGroupCreatedTimeout         = 20 * time.Minute
 	FeatureGroupDeletedTimeout         = 10 * time.Minute
 	UserProfileInServiceTimeout        = 10 * time.Minute
@@ -265,7 +263,7 @@ func WaitImageVersionDeleted(ctx context.Context, conn *sagemaker.SageMaker, nam
 }
 
 // WaitDomainInService waits for a Domain to return InService
-func WaitDomainInService(ctx context.Context, conn *sagemaker.SageMaker, domainID string) (*sagemaker.DescribeDomainOutput, error) {
+func WaitDomainInService(ctx context.Context, conn *sagemaker.SageMaker, domainID string, timeout time.Duration) (*sagemaker.DescribeDomainOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			sagemaker.DomainStatusPending,
@@ -273,7 +271,7 @@ func WaitDomainInService(ctx context.Context, conn *sagemaker.SageMaker, domainI
 		},
 		Target:  []string{sagemaker.DomainStatusInService},
 		Refresh: StatusDomain(ctx, conn, domainID),
-		Timeout: DomainInServiceTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -290,14 +288,14 @@ func WaitDomainInService(ctx context.Context, conn *sagemaker.SageMaker, domainI
 }
 
 // WaitDomainDeleted waits for a Domain to return Deleted
-func WaitDomainDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainID string) (*sagemaker.DescribeDomainOutput, error) {
+func WaitDomainDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainID string, timeout time.Duration) (*sagemaker.DescribeDomainOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			sagemaker.DomainStatusDeleting,
 		},
 		Target:  []string{},
 		Refresh: StatusDomain(ctx, conn, domainID),
-		Timeout: DomainDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
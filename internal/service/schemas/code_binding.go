@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/schemas/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_schemas_code_binding", name="Code Binding")
+func resourceCodeBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCodeBindingCreate,
+		ReadWithoutTimeout:   resourceCodeBindingRead,
+		DeleteWithoutTimeout: resourceCodeBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(codeBindingLanguage_Values(), false),
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCodeBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchemasClient(ctx)
+
+	schemaName := d.Get("schema_name").(string)
+	registryName := d.Get("registry_name").(string)
+	language := d.Get("language").(string)
+	input := &schemas.PutCodeBindingInput{
+		Language:     aws.String(language),
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(schemaName),
+	}
+
+	if v, ok := d.GetOk("schema_version"); ok {
+		input.SchemaVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.PutCodeBinding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EventBridge Schemas Code Binding (%s/%s/%s): %s", schemaName, registryName, language, err)
+	}
+
+	d.SetId(codeBindingCreateResourceID(schemaName, registryName, aws.ToString(output.SchemaVersion), language))
+
+	if _, err := waitCodeBindingCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EventBridge Schemas Code Binding (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCodeBindingRead(ctx, d, meta)...)
+}
+
+func resourceCodeBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchemasClient(ctx)
+
+	schemaName, registryName, schemaVersion, language, err := codeBindingParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findCodeBindingByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Schemas Code Binding (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Schemas Code Binding (%s): %s", d.Id(), err)
+	}
+
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set("language", language)
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.ToTime(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("registry_name", registryName)
+	d.Set("schema_name", schemaName)
+	d.Set("schema_version", schemaVersion)
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
+
+func resourceCodeBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Code bindings can't be deleted; they are removed with their schema.
+	log.Printf("[WARN] EventBridge Schemas Code Binding (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+const codeBindingResourceIDSeparator = "/"
+
+func codeBindingCreateResourceID(schemaName, registryName, schemaVersion, language string) string {
+	parts := []string{schemaName, registryName, schemaVersion, language}
+	id := strings.Join(parts, codeBindingResourceIDSeparator)
+
+	return id
+}
+
+func codeBindingParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, codeBindingResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SCHEMA_NAME%[2]sREGISTRY_NAME%[2]sSCHEMA_VERSION%[2]sLANGUAGE", id, codeBindingResourceIDSeparator)
+}
+
+func findCodeBindingByID(ctx context.Context, conn *schemas.Client, id string) (*schemas.DescribeCodeBindingOutput, error) {
+	schemaName, registryName, schemaVersion, language, err := codeBindingParseResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &schemas.DescribeCodeBindingInput{
+		Language:      aws.String(language),
+		RegistryName:  aws.String(registryName),
+		SchemaName:    aws.String(schemaName),
+		SchemaVersion: aws.String(schemaVersion),
+	}
+
+	return findCodeBinding(ctx, conn, input)
+}
+
+func findCodeBinding(ctx context.Context, conn *schemas.Client, input *schemas.DescribeCodeBindingInput) (*schemas.DescribeCodeBindingOutput, error) {
+	output, err := conn.DescribeCodeBinding(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findCodeBindingSource(ctx context.Context, conn *schemas.Client, input *schemas.GetCodeBindingSourceInput) ([]byte, error) {
+	output, err := conn.GetCodeBindingSource(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Body) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Body, nil
+}
+
+func statusCodeBinding(ctx context.Context, conn *schemas.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCodeBindingByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCodeBindingCreated(ctx context.Context, conn *schemas.Client, id string, timeout time.Duration) (*schemas.DescribeCodeBindingOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CodeGenerationStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.CodeGenerationStatusCreateComplete),
+		Refresh: statusCodeBinding(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*schemas.DescribeCodeBindingOutput); ok {
+		if output.Status == awstypes.CodeGenerationStatusCreateFailed {
+			tfresource.SetLastError(err, errors.New("code generation failed"))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_schemas_code_binding", name="Code Binding")
+func dataSourceCodeBinding() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCodeBindingRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(codeBindingLanguage_Values(), false),
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCodeBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchemasClient(ctx)
+
+	schemaName := d.Get("schema_name").(string)
+	registryName := d.Get("registry_name").(string)
+	language := d.Get("language").(string)
+	input := &schemas.DescribeCodeBindingInput{
+		Language:     aws.String(language),
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(schemaName),
+	}
+
+	if v, ok := d.GetOk("schema_version"); ok {
+		input.SchemaVersion = aws.String(v.(string))
+	}
+
+	output, err := findCodeBinding(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Schemas Code Binding (%s/%s/%s): %s", schemaName, registryName, language, err)
+	}
+
+	schemaVersion := aws.ToString(output.SchemaVersion)
+	body, err := findCodeBindingSource(ctx, conn, &schemas.GetCodeBindingSourceInput{
+		Language:      aws.String(language),
+		RegistryName:  aws.String(registryName),
+		SchemaName:    aws.String(schemaName),
+		SchemaVersion: aws.String(schemaVersion),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EventBridge Schemas Code Binding (%s/%s/%s) source: %s", schemaName, registryName, language, err)
+	}
+
+	d.SetId(codeBindingCreateResourceID(schemaName, registryName, schemaVersion, language))
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.ToTime(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("schema_version", schemaVersion)
+	d.Set(names.AttrSource, itypes.Base64Encode(body))
+	d.Set(names.AttrStatus, output.Status)
+
+	return diags
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchemasCodeBindingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_schemas_code_binding.test"
+	resourceName := "aws_schemas_code_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchemasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeBindingDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreationDate, resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schema_version", resourceName, "schema_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrSource),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "CREATE_COMPLETE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodeBindingDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCodeBindingConfig_basic(rName, "TypeScript3"), `
+data "aws_schemas_code_binding" "test" {
+  registry_name = aws_schemas_code_binding.test.registry_name
+  schema_name   = aws_schemas_code_binding.test.schema_name
+  language      = aws_schemas_code_binding.test.language
+}
+`)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfschemas "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchemasCodeBinding_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v schemas.DescribeCodeBindingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_schemas_code_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchemasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Code bindings are deleted with their schema.
+		CheckDestroy: testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeBindingConfig_basic(rName, "Go1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeBindingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "language", "Go1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "registry_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schema_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schema_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "CREATE_COMPLETE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodeBindingConfig_basic(rName, "Python36"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeBindingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "language", "Python36"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "CREATE_COMPLETE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCodeBindingExists(ctx context.Context, n string, v *schemas.DescribeCodeBindingOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchemasClient(ctx)
+
+		output, err := tfschemas.FindCodeBindingByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCodeBindingConfig_basic(rName, language string) string {
+	return acctest.ConfigCompose(testAccSchemaConfig_basic(rName), fmt.Sprintf(`
+resource "aws_schemas_code_binding" "test" {
+  registry_name = aws_schemas_schema.test.registry_name
+  schema_name   = aws_schemas_schema.test.name
+  language      = %[1]q
+}
+`, language))
+}
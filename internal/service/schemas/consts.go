@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas
+
+const (
+	codeBindingLanguageGo1         = "Go1"
+	codeBindingLanguageJava8       = "Java8"
+	codeBindingLanguagePython36    = "Python36"
+	codeBindingLanguageTypeScript3 = "TypeScript3"
+)
+
+func codeBindingLanguage_Values() []string {
+	return []string{
+		codeBindingLanguageGo1,
+		codeBindingLanguageJava8,
+		codeBindingLanguagePython36,
+		codeBindingLanguageTypeScript3,
+	}
+}
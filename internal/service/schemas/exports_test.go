@@ -5,11 +5,13 @@ package schemas
 
 // Exports for use in tests only.
 var (
+	ResourceCodeBinding    = resourceCodeBinding
 	ResourceDiscoverer     = resourceDiscoverer
 	ResourceRegistry       = resourceRegistry
 	ResourceRegistryPolicy = resourceRegistryPolicy
 	ResourceSchema         = resourceSchema
 
+	FindCodeBindingByID      = findCodeBindingByID
 	FindDiscovererByID       = findDiscovererByID
 	FindRegistryByName       = findRegistryByName
 	FindRegistryPolicyByName = findRegistryPolicyByName
@@ -21,11 +21,22 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCodeBinding,
+			TypeName: "aws_schemas_code_binding",
+			Name:     "Code Binding",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceCodeBinding,
+			TypeName: "aws_schemas_code_binding",
+			Name:     "Code Binding",
+		},
 		{
 			Factory:  resourceDiscoverer,
 			TypeName: "aws_schemas_discoverer",
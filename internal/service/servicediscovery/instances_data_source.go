@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicediscovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_service_discovery_instances", name="Instances")
+func dataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"health_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.HealthStatusFilter](),
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx)
+
+	namespaceName, serviceName := d.Get("namespace_name").(string), d.Get("service_name").(string)
+	input := &servicediscovery.DiscoverInstancesInput{
+		NamespaceName: aws.String(namespaceName),
+		ServiceName:   aws.String(serviceName),
+	}
+
+	if v, ok := d.GetOk("health_status"); ok {
+		input.HealthStatus = awstypes.HealthStatusFilter(v.(string))
+	}
+
+	if v, ok := d.GetOk("query_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.QueryParameters = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	output, err := findInstances(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "discovering Service Discovery Instances (%s/%s): %s", namespaceName, serviceName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespaceName, serviceName))
+	if err := d.Set("instances", flattenHTTPInstanceSummaries(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
+
+	return diags
+}
+
+func findInstances(ctx context.Context, conn *servicediscovery.Client, input *servicediscovery.DiscoverInstancesInput) ([]awstypes.HttpInstanceSummary, error) {
+	output, err := conn.DiscoverInstances(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Instances, nil
+}
+
+func flattenHTTPInstanceSummaries(apiObjects []awstypes.HttpInstanceSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":         apiObject.Attributes,
+			"health_status":      string(apiObject.HealthStatus),
+			names.AttrInstanceID: aws.ToString(apiObject.InstanceId),
+		})
+	}
+
+	return tfList
+}
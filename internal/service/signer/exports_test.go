@@ -5,7 +5,9 @@ package signer
 
 // Exports for use in tests only.
 var (
-	FindPermissionByTwoPartKey = findPermissionByTwoPartKey
-	FindSigningJobByID         = findSigningJobByID
-	FindSigningProfileByName   = findSigningProfileByName
+	FindPermissionByTwoPartKey         = findPermissionByTwoPartKey
+	FindSigningJobByID                 = findSigningJobByID
+	FindSigningJobRevocationByID       = findSigningJobRevocationByID
+	FindSigningProfileByName           = findSigningProfileByName
+	FindSigningProfileRevocationByName = findSigningProfileRevocationByName
 )
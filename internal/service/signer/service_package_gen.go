@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSigningJob,
 			TypeName: "aws_signer_signing_job",
 		},
+		{
+			Factory:  dataSourceSigningJobs,
+			TypeName: "aws_signer_signing_jobs",
+			Name:     "Signing Jobs",
+		},
 		{
 			Factory:  DataSourceSigningProfile,
 			TypeName: "aws_signer_signing_profile",
@@ -42,6 +47,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSigningJob,
 			TypeName: "aws_signer_signing_job",
 		},
+		{
+			Factory:  resourceSigningJobRevocation,
+			TypeName: "aws_signer_signing_job_revocation",
+			Name:     "Signing Job Revocation",
+		},
 		{
 			Factory:  ResourceSigningProfile,
 			TypeName: "aws_signer_signing_profile",
@@ -54,6 +64,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSigningProfilePermission,
 			TypeName: "aws_signer_signing_profile_permission",
 		},
+		{
+			Factory:  resourceSigningProfileRevocation,
+			TypeName: "aws_signer_signing_profile_revocation",
+			Name:     "Signing Profile Revocation",
+		},
 	}
 }
 
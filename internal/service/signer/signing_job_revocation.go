@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_signer_signing_job_revocation", name="Signing Job Revocation")
+func resourceSigningJobRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSigningJobRevocationCreate,
+		ReadWithoutTimeout:   resourceSigningJobRevocationRead,
+		DeleteWithoutTimeout: resourceSigningJobRevocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"revoked_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revoked_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSigningJobRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	jobID := d.Get("job_id").(string)
+	input := &signer.RevokeSignatureInput{
+		JobId:  aws.String(jobID),
+		Reason: aws.String(d.Get("reason").(string)),
+	}
+
+	if v, ok := d.GetOk("job_owner"); ok {
+		input.JobOwner = aws.String(v.(string))
+	}
+
+	_, err := conn.RevokeSignature(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "revoking Signer Signing Job (%s) signature: %s", jobID, err)
+	}
+
+	d.SetId(jobID)
+
+	return append(diags, resourceSigningJobRevocationRead(ctx, d, meta)...)
+}
+
+func resourceSigningJobRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	output, err := findSigningJobRevocationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Signer Signing Job Revocation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Job Revocation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("job_id", output.JobId)
+	d.Set("job_owner", output.JobOwner)
+	d.Set("reason", output.RevocationRecord.Reason)
+	if v := output.RevocationRecord.RevokedAt; v != nil {
+		d.Set("revoked_at", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("revoked_by", output.RevocationRecord.RevokedBy)
+
+	return diags
+}
+
+func resourceSigningJobRevocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Revocation is permanent, so just remove the resource from state.
+	log.Printf("[WARN] Signer Signing Job (%s) signature revocation cannot be undone, removing from state only", d.Id())
+
+	return diags
+}
+
+func findSigningJobRevocationByID(ctx context.Context, conn *signer.Client, id string) (*signer.DescribeSigningJobOutput, error) {
+	output, err := findSigningJobByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.RevocationRecord == nil {
+		return nil, &retry.NotFoundError{
+			Message: "signature not revoked",
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsigner "github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+)
+
+func TestAccSignerSigningJobRevocation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_signer_signing_job_revocation.test"
+	jobResourceName := "aws_signer_signing_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningJobRevocationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSigningJobRevocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "job_id", jobResourceName, "job_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_owner", jobResourceName, "job_owner"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "testing"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_by"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSigningJobRevocationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
+
+		_, err := tfsigner.FindSigningJobRevocationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSigningJobRevocationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSigningJobConfig_basic(rName), `
+resource "aws_signer_signing_job_revocation" "test" {
+  job_id = aws_signer_signing_job.test.job_id
+  reason = "testing"
+}
+`)
+}
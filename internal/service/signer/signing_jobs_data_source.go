@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_signer_signing_jobs", name="Signing Jobs")
+func dataSourceSigningJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSigningJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"is_revoked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"job_invoker": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_revoked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_invoker": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"profile_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"profile_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signature_expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"platform_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"requested_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.SigningStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceSigningJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	input := &signer.ListSigningJobsInput{
+		IsRevoked: d.Get("is_revoked").(bool),
+	}
+
+	if v, ok := d.GetOk("job_invoker"); ok {
+		input.JobInvoker = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("platform_id"); ok {
+		input.PlatformId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("requested_by"); ok {
+		input.RequestedBy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = types.SigningStatus(v.(string))
+	}
+
+	jobs, err := findSigningJobs(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Jobs: %s", err)
+	}
+
+	var ids []string
+	for _, v := range jobs {
+		ids = append(ids, aws.ToString(v.JobId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrIDs, ids)
+	if err := d.Set("jobs", flattenSigningJobs(jobs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting jobs: %s", err)
+	}
+
+	return diags
+}
+
+func findSigningJobs(ctx context.Context, conn *signer.Client, input *signer.ListSigningJobsInput) ([]types.SigningJob, error) {
+	var output []types.SigningJob
+
+	pages := signer.NewListSigningJobsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Jobs...)
+	}
+
+	return output, nil
+}
+
+func flattenSigningJobs(apiObjects []types.SigningJob) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"is_revoked":      apiObject.IsRevoked,
+			"job_id":          aws.ToString(apiObject.JobId),
+			"job_invoker":     aws.ToString(apiObject.JobInvoker),
+			"job_owner":       aws.ToString(apiObject.JobOwner),
+			"platform_id":     aws.ToString(apiObject.PlatformId),
+			"profile_name":    aws.ToString(apiObject.ProfileName),
+			"profile_version": aws.ToString(apiObject.ProfileVersion),
+			names.AttrStatus:  string(apiObject.Status),
+		}
+
+		if v := apiObject.CreatedAt; v != nil {
+			tfMap[names.AttrCreatedAt] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.SignatureExpiresAt; v != nil {
+			tfMap["signature_expires_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSignerSigningJobsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_signer_signing_jobs.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningJobsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "ids.#", 1),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "jobs.#", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccSigningJobsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSigningJobConfig_basic(rName), `
+data "aws_signer_signing_jobs" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  status      = "Succeeded"
+
+  depends_on = [aws_signer_signing_job.test]
+}
+`)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_signer_signing_profile_revocation", name="Signing Profile Revocation")
+func resourceSigningProfileRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSigningProfileRevocationCreate,
+		ReadWithoutTimeout:   resourceSigningProfileRevocationRead,
+		DeleteWithoutTimeout: resourceSigningProfileRevocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"effective_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"profile_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"profile_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"revoked_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revoked_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSigningProfileRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	effectiveTime, _ := time.Parse(time.RFC3339, d.Get("effective_time").(string))
+	name := d.Get("profile_name").(string)
+	input := &signer.RevokeSigningProfileInput{
+		EffectiveTime:  aws.Time(effectiveTime),
+		ProfileName:    aws.String(name),
+		ProfileVersion: aws.String(d.Get("profile_version").(string)),
+		Reason:         aws.String(d.Get("reason").(string)),
+	}
+
+	_, err := conn.RevokeSigningProfile(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "revoking Signer Signing Profile (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceSigningProfileRevocationRead(ctx, d, meta)...)
+}
+
+func resourceSigningProfileRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	output, err := findSigningProfileRevocationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Signer Signing Profile Revocation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Profile Revocation (%s): %s", d.Id(), err)
+	}
+
+	if v := output.RevocationRecord.RevocationEffectiveFrom; v != nil {
+		d.Set("effective_time", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("profile_name", output.ProfileName)
+	d.Set("profile_version", output.ProfileVersion)
+	if v := output.RevocationRecord.RevokedAt; v != nil {
+		d.Set("revoked_at", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("revoked_by", output.RevocationRecord.RevokedBy)
+
+	return diags
+}
+
+func resourceSigningProfileRevocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Revocation is permanent, so just remove the resource from state.
+	log.Printf("[WARN] Signer Signing Profile (%s) revocation cannot be undone, removing from state only", d.Id())
+
+	return diags
+}
+
+func findSigningProfileRevocationByName(ctx context.Context, conn *signer.Client, name string) (*signer.GetSigningProfileOutput, error) {
+	output, err := findSigningProfileByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status != types.SigningProfileStatusRevoked || output.RevocationRecord == nil {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
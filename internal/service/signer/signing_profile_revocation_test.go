@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsigner "github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+)
+
+func TestAccSignerSigningProfileRevocation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	effectiveTime := time.Now().UTC().Format(time.RFC3339)
+	resourceName := "aws_signer_signing_profile_revocation.test"
+	profileResourceName := "aws_signer_signing_profile.test_sp"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileRevocationConfig_basic(rName, effectiveTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSigningProfileRevocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "effective_time", effectiveTime),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_name", profileResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_version", profileResourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "testing"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_by"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reason"},
+			},
+		},
+	})
+}
+
+func testAccCheckSigningProfileRevocationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
+
+		_, err := tfsigner.FindSigningProfileRevocationByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSigningProfileRevocationConfig_basic(rName, effectiveTime string) string {
+	return acctest.ConfigCompose(testAccSigningProfileConfig_basic(rName), fmt.Sprintf(`
+resource "aws_signer_signing_profile_revocation" "test" {
+  profile_name    = aws_signer_signing_profile.test_sp.name
+  profile_version = aws_signer_signing_profile.test_sp.version
+  reason          = "testing"
+  effective_time  = %[1]q
+}
+`, effectiveTime))
+}
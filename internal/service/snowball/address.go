@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snowball
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_snowball_address", name="Address")
+func resourceAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddressCreate,
+		ReadWithoutTimeout:   resourceAddressRead,
+		DeleteWithoutTimeout: resourceAddressDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"city": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"company": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"country": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"is_restricted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"landmark": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"postal_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"prefecture_or_district": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"state_or_province": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"street1": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"street2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			"street3": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenAtLeast(1),
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.AddressType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn(ctx)
+
+	address := &snowball.Address{
+		City:            aws.String(d.Get("city").(string)),
+		Country:         aws.String(d.Get("country").(string)),
+		Name:            aws.String(d.Get(names.AttrName).(string)),
+		PhoneNumber:     aws.String(d.Get("phone_number").(string)),
+		PostalCode:      aws.String(d.Get("postal_code").(string)),
+		StateOrProvince: aws.String(d.Get("state_or_province").(string)),
+		Street1:         aws.String(d.Get("street1").(string)),
+	}
+
+	if v, ok := d.GetOk("company"); ok {
+		address.Company = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("landmark"); ok {
+		address.Landmark = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefecture_or_district"); ok {
+		address.PrefectureOrDistrict = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street2"); ok {
+		address.Street2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street3"); ok {
+		address.Street3 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrType); ok {
+		address.Type = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAddressWithContext(ctx, &snowball.CreateAddressInput{
+		Address: address,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Snow Family Address: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AddressId))
+
+	return append(diags, resourceAddressRead(ctx, d, meta)...)
+}
+
+func resourceAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn(ctx)
+
+	address, err := findAddressByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Address (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snow Family Address (%s): %s", d.Id(), err)
+	}
+
+	d.Set("city", address.City)
+	d.Set("company", address.Company)
+	d.Set("country", address.Country)
+	d.Set("is_restricted", address.IsRestricted)
+	d.Set("landmark", address.Landmark)
+	d.Set(names.AttrName, address.Name)
+	d.Set("phone_number", address.PhoneNumber)
+	d.Set("postal_code", address.PostalCode)
+	d.Set("prefecture_or_district", address.PrefectureOrDistrict)
+	d.Set("state_or_province", address.StateOrProvince)
+	d.Set("street1", address.Street1)
+	d.Set("street2", address.Street2)
+	d.Set("street3", address.Street3)
+	d.Set(names.AttrType, address.Type)
+
+	return diags
+}
+
+func resourceAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Addresses cannot be deleted.
+	log.Printf("[WARN] Snow Family Address (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func findAddressByID(ctx context.Context, conn *snowball.Snowball, id string) (*snowball.Address, error) {
+	input := &snowball.DescribeAddressInput{
+		AddressId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAddressWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Address == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Address, nil
+}
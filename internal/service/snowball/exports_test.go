@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snowball
+
+// Exports for use in tests only.
+var (
+	ResourceAddress = resourceAddress
+	ResourceJob     = resourceJob
+
+	FindAddressByID = findAddressByID
+	FindJobByID     = findJobByID
+)
@@ -0,0 +1,703 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snowball
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_snowball_job", name="Job")
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(40, 40),
+			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(39, 39),
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"forwarding_address_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(40, 40),
+			},
+			"job_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			names.AttrKMSKeyARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_states_to_notify": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(snowball.JobState_Values(), false),
+							},
+						},
+						"notify_all": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrSNSTopicARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrResources: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2_ami_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"snowball_ami_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"lambda_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_trigger": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event_resource_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"key_range": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"begin_marker": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenAtLeast(1),
+												},
+												"end_marker": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_capacity_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Capacity_Values(), false),
+			},
+			"snowball_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+		},
+
+		CustomizeDiff: resourceJobCustomizeDiff,
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn(ctx)
+
+	input := &snowball.CreateJobInput{}
+
+	if v, ok := d.GetOk("address_id"); ok {
+		input.AddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_id"); ok {
+		input.ClusterId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_type"); ok {
+		input.JobType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyARN); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrResources); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrRoleARN); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("shipping_option"); ok {
+		input.ShippingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_type"); ok {
+		input.SnowballType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Snow Family Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn(ctx)
+
+	job, err := findJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snow Family Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address_id", job.AddressId)
+	d.Set("cluster_id", job.ClusterId)
+	if job.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.TimeValue(job.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set(names.AttrDescription, job.Description)
+	d.Set("forwarding_address_id", job.ForwardingAddressId)
+	d.Set("job_state", job.JobState)
+	d.Set("job_type", job.JobType)
+	d.Set(names.AttrKMSKeyARN, job.KmsKeyARN)
+	if job.Notification != nil {
+		if err := d.Set("notification", []interface{}{flattenNotification(job.Notification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting notification: %s", err)
+		}
+	} else {
+		d.Set("notification", nil)
+	}
+	if job.Resources != nil {
+		if err := d.Set(names.AttrResources, []interface{}{flattenJobResource(job.Resources)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+		}
+	} else {
+		d.Set(names.AttrResources, nil)
+	}
+	d.Set(names.AttrRoleARN, job.RoleARN)
+	if job.ShippingDetails != nil {
+		d.Set("shipping_option", job.ShippingDetails.ShippingOption)
+	}
+	d.Set("snowball_capacity_preference", job.SnowballCapacityPreference)
+	d.Set("snowball_id", job.SnowballId)
+	d.Set("snowball_type", job.SnowballType)
+
+	return diags
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn(ctx)
+
+	input := &snowball.UpdateJobInput{
+		JobId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("address_id") {
+		input.AddressId = aws.String(d.Get("address_id").(string))
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
+	if d.HasChange("forwarding_address_id") {
+		input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+	}
+
+	if d.HasChange("notification") {
+		if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Notification = &snowball.Notification{}
+		}
+	}
+
+	if d.HasChange(names.AttrResources) {
+		if v, ok := d.GetOk(names.AttrResources); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange(names.AttrRoleARN) {
+		input.RoleARN = aws.String(d.Get(names.AttrRoleARN).(string))
+	}
+
+	if d.HasChange("shipping_option") {
+		input.ShippingOption = aws.String(d.Get("shipping_option").(string))
+	}
+
+	if d.HasChange("snowball_capacity_preference") {
+		input.SnowballCapacityPreference = aws.String(d.Get("snowball_capacity_preference").(string))
+	}
+
+	_, err := conn.UpdateJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Snow Family Job (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn(ctx)
+
+	// Completed jobs cannot be cancelled and are kept by the service.
+	if d.Get("job_state").(string) == snowball.JobStateComplete {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Snow Family Job: %s", d.Id())
+	_, err := conn.CancelJobWithContext(ctx, &snowball.CancelJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Snow Family Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceJobCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("cluster_id") {
+		return nil
+	}
+
+	// Jobs for a node in a cluster inherit their attributes from the cluster.
+	if d.Get("cluster_id").(string) != "" {
+		return nil
+	}
+
+	var errs []error
+
+	for _, key := range []string{"address_id", "job_type", "shipping_option"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		if d.Get(key).(string) == "" {
+			errs = append(errs, fmt.Errorf("%s is required when cluster_id is not set", key))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func findJobByID(ctx context.Context, conn *snowball.Snowball, id string) (*snowball.JobMetadata, error) {
+	input := &snowball.DescribeJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.JobMetadata.JobState); state == snowball.JobStateCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.JobMetadata, nil
+}
+
+func expandNotification(tfMap map[string]interface{}) *snowball.Notification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.Notification{}
+
+	if v, ok := tfMap["job_states_to_notify"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobStatesToNotify = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["notify_all"].(bool); ok {
+		apiObject.NotifyAll = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrSNSTopicARN].(string); ok && v != "" {
+		apiObject.SnsTopicARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenNotification(apiObject *snowball.Notification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_states_to_notify": aws.StringValueSlice(apiObject.JobStatesToNotify),
+		"notify_all":           aws.BoolValue(apiObject.NotifyAll),
+		names.AttrSNSTopicARN:  aws.StringValue(apiObject.SnsTopicARN),
+	}
+
+	return tfMap
+}
+
+func expandJobResource(tfMap map[string]interface{}) *snowball.JobResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.JobResource{}
+
+	if v, ok := tfMap["ec2_ami_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Ec2AmiResources = expandEC2AMIResources(v)
+	}
+
+	if v, ok := tfMap["lambda_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LambdaResources = expandLambdaResources(v)
+	}
+
+	if v, ok := tfMap["s3_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3Resources = expandS3Resources(v)
+	}
+
+	return apiObject
+}
+
+func expandEC2AMIResources(tfList []interface{}) []*snowball.Ec2AmiResource {
+	var apiObjects []*snowball.Ec2AmiResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.Ec2AmiResource{
+			AmiId: aws.String(tfMap["ami_id"].(string)),
+		}
+
+		if v, ok := tfMap["snowball_ami_id"].(string); ok && v != "" {
+			apiObject.SnowballAmiId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLambdaResources(tfList []interface{}) []*snowball.LambdaResource {
+	var apiObjects []*snowball.LambdaResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.LambdaResource{
+			LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+		}
+
+		if v, ok := tfMap["event_trigger"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				eventTrigger := &snowball.EventTriggerDefinition{}
+
+				if v, ok := tfMap["event_resource_arn"].(string); ok && v != "" {
+					eventTrigger.EventResourceARN = aws.String(v)
+				}
+
+				apiObject.EventTriggers = append(apiObject.EventTriggers, eventTrigger)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Resources(tfList []interface{}) []*snowball.S3Resource {
+	var apiObjects []*snowball.S3Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.S3Resource{
+			BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			keyRange := &snowball.KeyRange{}
+
+			if v, ok := tfMap["begin_marker"].(string); ok && v != "" {
+				keyRange.BeginMarker = aws.String(v)
+			}
+
+			if v, ok := tfMap["end_marker"].(string); ok && v != "" {
+				keyRange.EndMarker = aws.String(v)
+			}
+
+			apiObject.KeyRange = keyRange
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenJobResource(apiObject *snowball.JobResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	var ec2AMIResources []interface{}
+	for _, v := range apiObject.Ec2AmiResources {
+		if v == nil {
+			continue
+		}
+
+		ec2AMIResources = append(ec2AMIResources, map[string]interface{}{
+			"ami_id":          aws.StringValue(v.AmiId),
+			"snowball_ami_id": aws.StringValue(v.SnowballAmiId),
+		})
+	}
+	tfMap["ec2_ami_resource"] = ec2AMIResources
+
+	var lambdaResources []interface{}
+	for _, v := range apiObject.LambdaResources {
+		if v == nil {
+			continue
+		}
+
+		var eventTriggers []interface{}
+		for _, v := range v.EventTriggers {
+			if v == nil {
+				continue
+			}
+
+			eventTriggers = append(eventTriggers, map[string]interface{}{
+				"event_resource_arn": aws.StringValue(v.EventResourceARN),
+			})
+		}
+
+		lambdaResources = append(lambdaResources, map[string]interface{}{
+			"event_trigger": eventTriggers,
+			"lambda_arn":    aws.StringValue(v.LambdaArn),
+		})
+	}
+	tfMap["lambda_resource"] = lambdaResources
+
+	var s3Resources []interface{}
+	for _, v := range apiObject.S3Resources {
+		if v == nil {
+			continue
+		}
+
+		s3Resource := map[string]interface{}{
+			"bucket_arn": aws.StringValue(v.BucketArn),
+		}
+
+		if v := v.KeyRange; v != nil && (v.BeginMarker != nil || v.EndMarker != nil) {
+			s3Resource["key_range"] = []interface{}{map[string]interface{}{
+				"begin_marker": aws.StringValue(v.BeginMarker),
+				"end_marker":   aws.StringValue(v.EndMarker),
+			}}
+		}
+
+		s3Resources = append(s3Resources, s3Resource)
+	}
+	tfMap["s3_resource"] = s3Resources
+
+	return tfMap
+}
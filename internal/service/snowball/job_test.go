@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snowball_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsnowball "github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSnowballJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SnowballServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "address_id", "aws_snowball_address.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", snowball.JobStateNew),
+					resource.TestCheckResourceAttr(resourceName, "job_type", snowball.JobTypeImport),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.s3_resource.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "resources.0.s3_resource.0.bucket_arn", "aws_s3_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "shipping_option", snowball.ShippingOptionSecondDay),
+					resource.TestCheckResourceAttr(resourceName, "snowball_type", snowball.TypeSnc1Ssd),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_basic(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnowballJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SnowballServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsnowball.ResourceJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSnowballJob_notification(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SnowballServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_notification(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.job_states_to_notify.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notification.0.job_states_to_notify.*", snowball.JobStateComplete),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notification.0.job_states_to_notify.*", snowball.JobStateInTransitToCustomer),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.notify_all", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "notification.0.sns_topic_arn", "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSnowballJob_requiredWithoutCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SnowballServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_noAddress(rName),
+				ExpectError: regexache.MustCompile(`address_id is required when cluster_id is not set`),
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_snowball_job" {
+				continue
+			}
+
+			_, err := tfsnowball.FindJobByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Snow Family Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn(ctx)
+
+		_, err := tfsnowball.FindJobByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAddressConfig_basic(rName), fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["importexport.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "s3:GetBucketLocation",
+      "s3:GetBucketPolicy",
+      "s3:ListBucketMultipartUploads",
+      "s3:PutObject",
+      "s3:AbortMultipartUpload",
+      "s3:ListMultipartUploadParts",
+      "s3:PutObjectAcl",
+    ]
+    resources = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = aws_iam_role.test.name
+  policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName))
+}
+
+func testAccJobConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_snowball_job" "test" {
+  address_id      = aws_snowball_address.test.id
+  description     = %[1]q
+  job_type        = "IMPORT"
+  role_arn        = aws_iam_role.test.arn
+  shipping_option = "SECOND_DAY"
+  snowball_type   = "SNC1_SSD"
+
+  resources {
+    s3_resource {
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, description))
+}
+
+func testAccJobConfig_notification(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_snowball_job" "test" {
+  address_id      = aws_snowball_address.test.id
+  job_type        = "IMPORT"
+  role_arn        = aws_iam_role.test.arn
+  shipping_option = "SECOND_DAY"
+  snowball_type   = "SNC1_SSD"
+
+  notification {
+    job_states_to_notify = ["InTransitToCustomer", "Complete"]
+    sns_topic_arn        = aws_sns_topic.test.arn
+  }
+
+  resources {
+    s3_resource {
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccJobConfig_noAddress(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), `
+resource "aws_snowball_job" "test" {
+  job_type        = "IMPORT"
+  role_arn        = aws_iam_role.test.arn
+  shipping_option = "SECOND_DAY"
+  snowball_type   = "SNC1_SSD"
+
+  resources {
+    s3_resource {
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+}
+`)
+}
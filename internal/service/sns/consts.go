@@ -88,6 +88,7 @@ const (
 	topicAttributeNameContentBasedDeduplication            = "ContentBasedDeduplication"
 	topicAttributeNameDeliveryPolicy                       = "DeliveryPolicy"
 	topicAttributeNameDisplayName                          = "DisplayName"
+	topicAttributeNameFIFOThroughputScope                  = "FifoThroughputScope"
 	topicAttributeNameFIFOTopic                            = "FifoTopic"
 	topicAttributeNameFirehoseFailureFeedbackRoleARN       = "FirehoseFailureFeedbackRoleArn"
 	topicAttributeNameFirehoseSuccessFeedbackRoleARN       = "FirehoseSuccessFeedbackRoleArn"
@@ -136,3 +137,15 @@ func topicTracingConfig_Values() []string {
 		topicTracingConfigPassThrough,
 	}
 }
+
+const (
+	topicFIFOThroughputScopeMessageGroup = "MessageGroup"
+	topicFIFOThroughputScopeTopic        = "Topic"
+)
+
+func topicFIFOThroughputScope_Values() []string {
+	return []string{
+		topicFIFOThroughputScopeMessageGroup,
+		topicFIFOThroughputScopeTopic,
+	}
+}
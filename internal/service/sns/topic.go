@@ -91,6 +91,12 @@ var (
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"fifo_throughput_scope": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(topicFIFOThroughputScope_Values(), false),
+		},
 		"fifo_topic": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -211,30 +217,31 @@ var (
 		"application_failure_feedback_role_arn":    topicAttributeNameApplicationFailureFeedbackRoleARN,
 		"application_success_feedback_role_arn":    topicAttributeNameApplicationSuccessFeedbackRoleARN,
 		"application_success_feedback_sample_rate": topicAttributeNameApplicationSuccessFeedbackSampleRate,
-		"archive_policy":                           topicAttributeNameArchivePolicy,
-		names.AttrARN:                              topicAttributeNameTopicARN,
-		"beginning_archive_time":                   topicAttributeNameBeginningArchiveTime,
-		"content_based_deduplication":              topicAttributeNameContentBasedDeduplication,
-		"delivery_policy":                          topicAttributeNameDeliveryPolicy,
-		names.AttrDisplayName:                      topicAttributeNameDisplayName,
-		"fifo_topic":                               topicAttributeNameFIFOTopic,
-		"firehose_failure_feedback_role_arn":       topicAttributeNameFirehoseFailureFeedbackRoleARN,
-		"firehose_success_feedback_role_arn":       topicAttributeNameFirehoseSuccessFeedbackRoleARN,
-		"firehose_success_feedback_sample_rate":    topicAttributeNameFirehoseSuccessFeedbackSampleRate,
-		"http_failure_feedback_role_arn":           topicAttributeNameHTTPFailureFeedbackRoleARN,
-		"http_success_feedback_role_arn":           topicAttributeNameHTTPSuccessFeedbackRoleARN,
-		"http_success_feedback_sample_rate":        topicAttributeNameHTTPSuccessFeedbackSampleRate,
-		"kms_master_key_id":                        topicAttributeNameKMSMasterKeyId,
-		"lambda_failure_feedback_role_arn":         topicAttributeNameLambdaFailureFeedbackRoleARN,
-		"lambda_success_feedback_role_arn":         topicAttributeNameLambdaSuccessFeedbackRoleARN,
-		"lambda_success_feedback_sample_rate":      topicAttributeNameLambdaSuccessFeedbackSampleRate,
-		names.AttrOwner:                            topicAttributeNameOwner,
-		names.AttrPolicy:                           topicAttributeNamePolicy,
-		"signature_version":                        topicAttributeNameSignatureVersion,
-		"sqs_failure_feedback_role_arn":            topicAttributeNameSQSFailureFeedbackRoleARN,
-		"sqs_success_feedback_role_arn":            topicAttributeNameSQSSuccessFeedbackRoleARN,
-		"sqs_success_feedback_sample_rate":         topicAttributeNameSQSSuccessFeedbackSampleRate,
-		"tracing_config":                           topicAttributeNameTracingConfig,
+		"archive_policy":                        topicAttributeNameArchivePolicy,
+		names.AttrARN:                           topicAttributeNameTopicARN,
+		"beginning_archive_time":                topicAttributeNameBeginningArchiveTime,
+		"content_based_deduplication":           topicAttributeNameContentBasedDeduplication,
+		"delivery_policy":                       topicAttributeNameDeliveryPolicy,
+		names.AttrDisplayName:                   topicAttributeNameDisplayName,
+		"fifo_throughput_scope":                 topicAttributeNameFIFOThroughputScope,
+		"fifo_topic":                            topicAttributeNameFIFOTopic,
+		"firehose_failure_feedback_role_arn":    topicAttributeNameFirehoseFailureFeedbackRoleARN,
+		"firehose_success_feedback_role_arn":    topicAttributeNameFirehoseSuccessFeedbackRoleARN,
+		"firehose_success_feedback_sample_rate": topicAttributeNameFirehoseSuccessFeedbackSampleRate,
+		"http_failure_feedback_role_arn":        topicAttributeNameHTTPFailureFeedbackRoleARN,
+		"http_success_feedback_role_arn":        topicAttributeNameHTTPSuccessFeedbackRoleARN,
+		"http_success_feedback_sample_rate":     topicAttributeNameHTTPSuccessFeedbackSampleRate,
+		"kms_master_key_id":                     topicAttributeNameKMSMasterKeyId,
+		"lambda_failure_feedback_role_arn":      topicAttributeNameLambdaFailureFeedbackRoleARN,
+		"lambda_success_feedback_role_arn":      topicAttributeNameLambdaSuccessFeedbackRoleARN,
+		"lambda_success_feedback_sample_rate":   topicAttributeNameLambdaSuccessFeedbackSampleRate,
+		names.AttrOwner:                         topicAttributeNameOwner,
+		names.AttrPolicy:                        topicAttributeNamePolicy,
+		"signature_version":                     topicAttributeNameSignatureVersion,
+		"sqs_failure_feedback_role_arn":         topicAttributeNameSQSFailureFeedbackRoleARN,
+		"sqs_success_feedback_role_arn":         topicAttributeNameSQSSuccessFeedbackRoleARN,
+		"sqs_success_feedback_sample_rate":      topicAttributeNameSQSSuccessFeedbackSampleRate,
+		"tracing_config":                        topicAttributeNameTracingConfig,
 	}, topicSchema).WithIAMPolicyAttribute(names.AttrPolicy).WithMissingSetToNil("*")
 )
 
@@ -407,6 +414,7 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 	fifoTopic := diff.Get("fifo_topic").(bool)
 	archivePolicy := diff.Get("archive_policy").(string)
 	contentBasedDeduplication := diff.Get("content_based_deduplication").(bool)
+	fifoThroughputScope := diff.Get("fifo_throughput_scope").(string)
 
 	if diff.Id() == "" {
 		// Create.
@@ -431,6 +439,9 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		if contentBasedDeduplication {
 			return errors.New("content-based deduplication can only be set for FIFO topics")
 		}
+		if fifoThroughputScope != "" {
+			return errors.New("FIFO throughput scope can only be set for FIFO topics")
+		}
 	}
 
 	return nil
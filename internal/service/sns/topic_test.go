@@ -526,6 +526,60 @@ func TestAccSNSTopic_fifoWithArchivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_fifoThroughputScope(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_fifoThroughputScope(rName, "Topic"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "fifo_throughput_scope", "Topic"),
+					resource.TestCheckResourceAttr(resourceName, "fifo_topic", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicConfig_fifoThroughputScope(rName, "MessageGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "fifo_throughput_scope", "MessageGroup"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_fifoExpectThroughputScopeError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicConfig_expectFIFOThroughputScopeError(rName),
+				ExpectError: regexache.MustCompile(`FIFO throughput scope can only be set for FIFO topics`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_fifoExpectArchivePolicyError(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1004,6 +1058,25 @@ resource "aws_sns_topic" "test" {
 `, rName, policy)
 }
 
+func testAccTopicConfig_fifoThroughputScope(rName, scope string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name                  = "%[1]s.fifo"
+  fifo_topic            = true
+  fifo_throughput_scope = %[2]q
+}
+`, rName, scope)
+}
+
+func testAccTopicConfig_expectFIFOThroughputScopeError(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name                  = %[1]q
+  fifo_throughput_scope = "Topic"
+}
+`, rName)
+}
+
 func testAccTopicConfig_expectArchivePolicyError(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -51,3 +51,10 @@ const (
 	errCodeQueueDeletedRecently  = "AWS.SimpleQueueService.QueueDeletedRecently"
 	errCodeInvalidAttributeValue = "InvalidAttributeValue"
 )
+
+const (
+	messageMoveTaskStatusCancelled  = "CANCELLED"
+	messageMoveTaskStatusCancelling = "CANCELLING"
+	messageMoveTaskStatusCompleted  = "COMPLETED"
+	messageMoveTaskStatusRunning    = "RUNNING"
+)
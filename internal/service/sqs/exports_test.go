@@ -5,11 +5,13 @@ package sqs
 
 // Exports for use in tests only.
 var (
+	ResourceMessageMoveTask         = resourceMessageMoveTask
 	ResourceQueue                   = resourceQueue
 	ResourceQueuePolicy             = resourceQueuePolicy
 	ResourceQueueRedriveAllowPolicy = resourceQueueRedriveAllowPolicy
 	ResourceQueueRedrivePolicy      = resourceQueueRedrivePolicy
 
+	FindMessageMoveTaskByID  = findMessageMoveTaskByID
 	FindQueueAttributesByURL = findQueueAttributesByURL
 
 	DefaultQueueDelaySeconds                  = defaultQueueDelaySeconds
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	messageMoveTaskResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_sqs_message_move_task", name="Message Move Task")
+func resourceMessageMoveTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMessageMoveTaskCreate,
+		ReadWithoutTimeout:   resourceMessageMoveTaskRead,
+		DeleteWithoutTimeout: resourceMessageMoveTaskDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"approximate_number_of_messages_moved": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"approximate_number_of_messages_to_move": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrDestinationARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_number_of_messages_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"started_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_handle": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMessageMoveTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	sourceARN := d.Get("source_arn").(string)
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(sourceARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrDestinationARN); ok {
+		input.DestinationArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_number_of_messages_per_second"); ok {
+		input.MaxNumberOfMessagesPerSecond = aws.Int32(int32(v.(int)))
+	}
+
+	// Allow for clock skew when matching a task that has finished before it is first listed.
+	startedAfter := time.Now().Add(-1 * time.Minute).UnixMilli()
+	output, err := conn.StartMessageMoveTask(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting SQS Message Move Task (%s): %s", sourceARN, err)
+	}
+
+	taskHandle := aws.ToString(output.TaskHandle)
+	d.Set("task_handle", taskHandle)
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findMessageMoveTaskByTaskHandle(ctx, conn, sourceARN, taskHandle, startedAfter)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Message Move Task (%s): %s", sourceARN, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{sourceARN, strconv.FormatInt(outputRaw.(*types.ListMessageMoveTasksResultEntry).StartedTimestamp, 10)}, messageMoveTaskResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitMessageMoveTaskCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SQS Message Move Task (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMessageMoveTaskRead(ctx, d, meta)...)
+}
+
+func resourceMessageMoveTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	sourceARN, startedTimestamp, err := messageMoveTaskParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tasks, err := findMessageMoveTasksBySourceARN(ctx, conn, sourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Message Move Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Message Move Task (%s): %s", d.Id(), err)
+	}
+
+	task, err := tfresource.AssertSingleValueResult(tfslices.Filter(tasks, func(v types.ListMessageMoveTasksResultEntry) bool {
+		return v.StartedTimestamp == startedTimestamp
+	}))
+
+	// SQS only lists the most recent tasks for a source queue. A task that has
+	// aged out of that list keeps its last known state rather than being recreated,
+	// which would move messages again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Message Move Task (%s) no longer listed, keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Message Move Task (%s): %s", d.Id(), err)
+	}
+
+	d.Set("approximate_number_of_messages_moved", task.ApproximateNumberOfMessagesMoved)
+	d.Set("approximate_number_of_messages_to_move", task.ApproximateNumberOfMessagesToMove)
+	d.Set(names.AttrDestinationARN, task.DestinationArn)
+	d.Set("failure_reason", task.FailureReason)
+	d.Set("max_number_of_messages_per_second", task.MaxNumberOfMessagesPerSecond)
+	d.Set("source_arn", task.SourceArn)
+	d.Set("started_timestamp", time.UnixMilli(task.StartedTimestamp).UTC().Format(time.RFC3339))
+	d.Set(names.AttrStatus, task.Status)
+
+	return diags
+}
+
+func resourceMessageMoveTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	task, err := findMessageMoveTaskByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Message Move Task (%s): %s", d.Id(), err)
+	}
+
+	// Only a running task can be cancelled; finished tasks are simply removed from state.
+	if aws.ToString(task.Status) != messageMoveTaskStatusRunning {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling SQS Message Move Task: %s", d.Id())
+	_, err = conn.CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: task.TaskHandle,
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling SQS Message Move Task (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMessageMoveTaskCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SQS Message Move Task (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func messageMoveTaskParseResourceID(id string) (string, int64, error) {
+	parts, err := flex.ExpandResourceId(id, messageMoveTaskResourceIDPartCount, false)
+	if err != nil {
+		return "", 0, err
+	}
+
+	startedTimestamp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return parts[0], startedTimestamp, nil
+}
+
+func findMessageMoveTaskByID(ctx context.Context, conn *sqs.Client, id string) (*types.ListMessageMoveTasksResultEntry, error) {
+	sourceARN, startedTimestamp, err := messageMoveTaskParseResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return findMessageMoveTask(ctx, conn, sourceARN, func(v *types.ListMessageMoveTasksResultEntry) bool {
+		return v.StartedTimestamp == startedTimestamp
+	})
+}
+
+// findMessageMoveTaskByTaskHandle returns the task started with the specified handle.
+// The handle is only listed while a task is running; a source queue has at most one running task,
+// so a task that has already finished is the most recent one started after startedAfter.
+func findMessageMoveTaskByTaskHandle(ctx context.Context, conn *sqs.Client, sourceARN, taskHandle string, startedAfter int64) (*types.ListMessageMoveTasksResultEntry, error) {
+	tasks, err := findMessageMoveTasksBySourceARN(ctx, conn, sourceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range tasks {
+		if aws.ToString(v.TaskHandle) == taskHandle {
+			return &v, nil
+		}
+	}
+
+	// Tasks are listed most recent first.
+	if len(tasks) > 0 && tasks[0].StartedTimestamp >= startedAfter && aws.ToString(tasks[0].Status) != messageMoveTaskStatusRunning {
+		return &tasks[0], nil
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func findMessageMoveTask(ctx context.Context, conn *sqs.Client, sourceARN string, filter tfslices.Predicate[*types.ListMessageMoveTasksResultEntry]) (*types.ListMessageMoveTasksResultEntry, error) {
+	output, err := findMessageMoveTasksBySourceARN(ctx, conn, sourceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v types.ListMessageMoveTasksResultEntry) bool {
+		return filter(&v)
+	}))
+}
+
+func findMessageMoveTasksBySourceARN(ctx context.Context, conn *sqs.Client, sourceARN string) ([]types.ListMessageMoveTasksResultEntry, error) {
+	input := &sqs.ListMessageMoveTasksInput{
+		MaxResults: aws.Int32(10),
+		SourceArn:  aws.String(sourceARN),
+	}
+
+	output, err := conn.ListMessageMoveTasks(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Results, nil
+}
+
+func statusMessageMoveTask(ctx context.Context, conn *sqs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMessageMoveTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitMessageMoveTaskCompleted(ctx context.Context, conn *sqs.Client, id string, timeout time.Duration) (*types.ListMessageMoveTasksResultEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{messageMoveTaskStatusRunning},
+		Target:     []string{messageMoveTaskStatusCompleted},
+		Refresh:    statusMessageMoveTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.ListMessageMoveTasksResultEntry); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMessageMoveTaskCancelled(ctx context.Context, conn *sqs.Client, id string, timeout time.Duration) (*types.ListMessageMoveTasksResultEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{messageMoveTaskStatusCancelling, messageMoveTaskStatusRunning},
+		Target:     []string{messageMoveTaskStatusCancelled, messageMoveTaskStatusCompleted},
+		Refresh:    statusMessageMoveTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.ListMessageMoveTasksResultEntry); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
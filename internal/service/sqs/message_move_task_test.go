@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSMessageMoveTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var task types.ListMessageMoveTasksResultEntry
+	resourceName := "aws_sqs_message_move_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageMoveTaskConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMessageMoveTaskExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "approximate_number_of_messages_moved", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestinationARN, ""),
+					resource.TestCheckResourceAttr(resourceName, "max_number_of_messages_per_second", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_sqs_queue.test_dlq", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "started_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSMessageMoveTask_destinationAndVelocity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var task types.ListMessageMoveTasksResultEntry
+	resourceName := "aws_sqs_message_move_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageMoveTaskConfig_destinationAndVelocity(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMessageMoveTaskExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDestinationARN, "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "max_number_of_messages_per_second", acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMessageMoveTaskExists(ctx context.Context, n string, v *types.ListMessageMoveTasksResultEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		output, err := tfsqs.FindMessageMoveTaskByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMessageMoveTaskConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test_dlq" {
+  name = "%[1]s_dlq"
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.test_dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+`, rName)
+}
+
+func testAccMessageMoveTaskConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMessageMoveTaskConfig_base(rName), `
+resource "aws_sqs_message_move_task" "test" {
+  source_arn = aws_sqs_queue.test_dlq.arn
+
+  depends_on = [aws_sqs_queue.test]
+}
+`)
+}
+
+func testAccMessageMoveTaskConfig_destinationAndVelocity(rName string, maxNumberOfMessagesPerSecond int) string {
+	return acctest.ConfigCompose(testAccMessageMoveTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_sqs_message_move_task" "test" {
+  source_arn                        = aws_sqs_queue.test_dlq.arn
+  destination_arn                   = aws_sqs_queue.test.arn
+  max_number_of_messages_per_second = %[1]d
+}
+`, maxNumberOfMessagesPerSecond))
+}
@@ -41,6 +41,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceMessageMoveTask,
+			TypeName: "aws_sqs_message_move_task",
+			Name:     "Message Move Task",
+		},
 		{
 			Factory:  resourceQueue,
 			TypeName: "aws_sqs_queue",
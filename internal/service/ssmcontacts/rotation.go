@@ -10,6 +10,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -86,9 +87,15 @@ func (r *resourceRotation) Schema(ctx context.Context, request resource.SchemaRe
 					Attributes: map[string]schema.Attribute{
 						"number_of_on_calls": schema.Int64Attribute{
 							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
 						},
 						"recurrence_multiplier": schema.Int64Attribute{
 							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 100),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -99,6 +106,9 @@ func (r *resourceRotation) Schema(ctx context.Context, request resource.SchemaRe
 								Attributes: map[string]schema.Attribute{
 									"day_of_month": schema.Int64Attribute{
 										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 31),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -170,9 +180,15 @@ func handOffTimeSchema(ctx context.Context, size *int) schema.ListNestedBlock {
 			Attributes: map[string]schema.Attribute{
 				"hour_of_day": schema.Int64Attribute{
 					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 23),
+					},
 				},
 				"minute_of_hour": schema.Int64Attribute{
 					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 59),
+					},
 				},
 			},
 		},
@@ -405,6 +405,31 @@ func testAccRotation_tags(t *testing.T) {
 	})
 }
 
+func testAccRotation_invalidHandOffTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotationConfig_recurrenceDailySettingsHandOffTime(rName, 24, 0),
+				ExpectError: regexache.MustCompile(`Attribute recurrence\[0\].daily_settings\[0\].hour_of_day value must be between 0 and 23`),
+			},
+			{
+				Config:      testAccRotationConfig_recurrenceDailySettingsHandOffTime(rName, 9, 60),
+				ExpectError: regexache.MustCompile(`Attribute recurrence\[0\].daily_settings\[0\].minute_of_hour value must be between 0 and 59`),
+			},
+		},
+	})
+}
+
 func testAccCheckRotationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
@@ -623,6 +648,30 @@ resource "aws_ssmcontacts_rotation" "test" {
 }`, rName))
 }
 
+func testAccRotationConfig_recurrenceDailySettingsHandOffTime(rName string, hourOfDay, minuteOfHour int) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_base(rName, 1),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = aws_ssmcontacts_contact.test[*].arn
+
+  name = %[1]q
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+    daily_settings {
+      hour_of_day    = %[2]d
+      minute_of_hour = %[3]d
+    }
+  }
+
+  time_zone_id = "Australia/Sydney"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}`, rName, hourOfDay, minuteOfHour))
+}
+
 func testAccRotationConfig_recurrenceOneMonthlySetting(rName string) string {
 	return acctest.ConfigCompose(
 		testAccRotationConfig_base(rName, 1),
@@ -58,6 +58,7 @@ func TestAccSSMContacts_serial(t *testing.T) {
 			"startTime":          testAccRotation_startTime,
 			"contactIds":         testAccRotation_contactIds,
 			"recurrence":         testAccRotation_recurrence,
+			"invalidHandOffTime": testAccRotation_invalidHandOffTime,
 			"tags":               testAccRotation_tags,
 		},
 		"RotationDataSource": {
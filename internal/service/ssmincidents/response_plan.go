@@ -98,8 +98,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"chat_channel": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Set: schema.HashString,
 			},
 			names.AttrDisplayName: {
 				Type:     schema.TypeString,
@@ -108,8 +111,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"engagements": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Set: schema.HashString,
 			},
 			"incident_template": {
 				Type:     schema.TypeList,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -68,6 +69,27 @@ func resourceFileSystemAssociation() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"endpoint_network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addresses": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+						},
+					},
+				},
+			},
 			"gateway_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -125,6 +147,10 @@ func resourceFileSystemAssociationCreate(ctx context.Context, d *schema.Resource
 		input.CacheAttributes = expandFileSystemAssociationCacheAttributes(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("endpoint_network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EndpointNetworkConfiguration = expandEndpointNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	output, err := conn.AssociateFileSystemWithContext(ctx, input)
 
 	if err != nil {
@@ -164,6 +190,13 @@ func resourceFileSystemAssociationRead(ctx context.Context, d *schema.ResourceDa
 	if err := d.Set("cache_attributes", flattenFileSystemAssociationCacheAttributes(filesystem.CacheAttributes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cache_attributes: %s", err)
 	}
+	if filesystem.EndpointNetworkConfiguration != nil {
+		if err := d.Set("endpoint_network_configuration", []interface{}{flattenEndpointNetworkConfiguration(filesystem.EndpointNetworkConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting endpoint_network_configuration: %s", err)
+		}
+	} else {
+		d.Set("endpoint_network_configuration", nil)
+	}
 
 	setTagsOut(ctx, filesystem.Tags)
 
@@ -251,3 +284,31 @@ func flattenFileSystemAssociationCacheAttributes(ca *storagegateway.CacheAttribu
 
 	return []interface{}{m}
 }
+
+func expandEndpointNetworkConfiguration(tfMap map[string]interface{}) *storagegateway.EndpointNetworkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &storagegateway.EndpointNetworkConfiguration{}
+
+	if v, ok := tfMap["ip_addresses"].([]interface{}); ok && len(v) > 0 {
+		apiObject.IpAddresses = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenEndpointNetworkConfiguration(apiObject *storagegateway.EndpointNetworkConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IpAddresses; v != nil {
+		tfMap["ip_addresses"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
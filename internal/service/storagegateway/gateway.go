@@ -71,6 +71,54 @@ func resourceGateway() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(51200),
 			},
+			"bandwidth_rate_limit_interval": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average_download_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(102400),
+						},
+						"average_upload_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(51200),
+						},
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"end_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"end_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"start_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
 			names.AttrCloudWatchLogGroupARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -167,6 +215,21 @@ func resourceGateway() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IntBetween(0, 59),
 						},
+						"software_update_preferences": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"automatic_update_policy": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(storagegateway.AutomaticUpdatePolicy_Values(), false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -480,6 +543,19 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 				return sdkdiag.AppendErrorf(diags, "setting Bandwidth Rate Limit: %s", err)
 			}
 		}
+
+		if v, ok := d.GetOk("bandwidth_rate_limit_interval"); ok && v.(*schema.Set).Len() > 0 {
+			input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+				BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(v.(*schema.Set).List()),
+				GatewayARN:                  aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
@@ -618,6 +694,25 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 			d.Set("average_download_rate_limit_in_bits_per_sec", bandwidthOutput.AverageDownloadRateLimitInBitsPerSec)
 			d.Set("average_upload_rate_limit_in_bits_per_sec", bandwidthOutput.AverageUploadRateLimitInBitsPerSec)
 		}
+
+		bandwidthScheduleOutput, err := conn.DescribeBandwidthRateLimitScheduleWithContext(ctx, &storagegateway.DescribeBandwidthRateLimitScheduleInput{
+			GatewayARN: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "not supported") ||
+			tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "not valid") {
+			err = nil
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+
+		if bandwidthScheduleOutput != nil {
+			if err := d.Set("bandwidth_rate_limit_interval", flattenBandwidthRateLimitIntervals(bandwidthScheduleOutput.BandwidthRateLimitIntervals)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting bandwidth_rate_limit_interval: %s", err)
+			}
+		}
 	}
 
 	maintenanceStartTimeOutput, err := conn.DescribeMaintenanceStartTimeWithContext(ctx, &storagegateway.DescribeMaintenanceStartTimeInput{
@@ -783,6 +878,20 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("bandwidth_rate_limit_interval") {
+		// An empty list of intervals removes the schedule.
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(d.Get("bandwidth_rate_limit_interval").(*schema.Set).List()),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
@@ -880,6 +989,10 @@ func expandUpdateMaintenanceStartTimeInput(tfMap map[string]interface{}) *storag
 		apiObject.MinuteOfHour = aws.Int64(int64(v))
 	}
 
+	if v, ok := tfMap["software_update_preferences"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SoftwareUpdatePreferences = expandSoftwareUpdatePreferences(v[0].(map[string]interface{}))
+	}
+
 	return apiObject
 }
 
@@ -906,9 +1019,97 @@ func flattenDescribeMaintenanceStartTimeOutput(apiObject *storagegateway.Describ
 		tfMap["minute_of_hour"] = aws.Int64Value(v)
 	}
 
+	if v := apiObject.SoftwareUpdatePreferences; v != nil {
+		tfMap["software_update_preferences"] = []interface{}{flattenSoftwareUpdatePreferences(v)}
+	}
+
+	return tfMap
+}
+
+func expandSoftwareUpdatePreferences(tfMap map[string]interface{}) *storagegateway.SoftwareUpdatePreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &storagegateway.SoftwareUpdatePreferences{}
+
+	if v, ok := tfMap["automatic_update_policy"].(string); ok && v != "" {
+		apiObject.AutomaticUpdatePolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSoftwareUpdatePreferences(apiObject *storagegateway.SoftwareUpdatePreferences) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutomaticUpdatePolicy; v != nil {
+		tfMap["automatic_update_policy"] = aws.StringValue(v)
+	}
+
 	return tfMap
 }
 
+func expandBandwidthRateLimitIntervals(tfList []interface{}) []*storagegateway.BandwidthRateLimitInterval {
+	apiObjects := make([]*storagegateway.BandwidthRateLimitInterval, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &storagegateway.BandwidthRateLimitInterval{
+			DaysOfWeek:        flex.ExpandInt64Set(tfMap["days_of_week"].(*schema.Set)),
+			EndHourOfDay:      aws.Int64(int64(tfMap["end_hour_of_day"].(int))),
+			EndMinuteOfHour:   aws.Int64(int64(tfMap["end_minute_of_hour"].(int))),
+			StartHourOfDay:    aws.Int64(int64(tfMap["start_hour_of_day"].(int))),
+			StartMinuteOfHour: aws.Int64(int64(tfMap["start_minute_of_hour"].(int))),
+		}
+
+		if v, ok := tfMap["average_download_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageDownloadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["average_upload_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageUploadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBandwidthRateLimitIntervals(apiObjects []*storagegateway.BandwidthRateLimitInterval) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"average_download_rate_limit_in_bits_per_sec": aws.Int64Value(apiObject.AverageDownloadRateLimitInBitsPerSec),
+			"average_upload_rate_limit_in_bits_per_sec":   aws.Int64Value(apiObject.AverageUploadRateLimitInBitsPerSec),
+			"days_of_week":         flex.FlattenInt64Set(apiObject.DaysOfWeek),
+			"end_hour_of_day":      aws.Int64Value(apiObject.EndHourOfDay),
+			"end_minute_of_hour":   aws.Int64Value(apiObject.EndMinuteOfHour),
+			"start_hour_of_day":    aws.Int64Value(apiObject.StartHourOfDay),
+			"start_minute_of_hour": aws.Int64Value(apiObject.StartMinuteOfHour),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 // The API returns multiple responses for a missing gateway
 func IsErrGatewayNotFound(err error) bool {
 	if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway was not found.") {
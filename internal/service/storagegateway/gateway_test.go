@@ -872,6 +872,101 @@ func TestAccStorageGatewayGateway_maintenanceStartTime(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayGateway_maintenanceStartTimeSoftwareUpdatePreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_maintenanceStartTimeSoftwareUpdatePreferences(rName, storagegateway.AutomaticUpdatePolicyEmergencyVersionsOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.0.automatic_update_policy", storagegateway.AutomaticUpdatePolicyEmergencyVersionsOnly),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_maintenanceStartTimeSoftwareUpdatePreferences(rName, storagegateway.AutomaticUpdatePolicyAllVersions),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.0.automatic_update_policy", storagegateway.AutomaticUpdatePolicyAllVersions),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayGateway_bandwidthRateLimitSchedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitSchedule(rName, 102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bandwidth_rate_limit_interval.*", map[string]string{
+						"average_download_rate_limit_in_bits_per_sec": "102400",
+						"average_upload_rate_limit_in_bits_per_sec":   "102400",
+						"days_of_week.#":       acctest.Ct2,
+						"end_hour_of_day":      "17",
+						"end_minute_of_hour":   "59",
+						"start_hour_of_day":    "9",
+						"start_minute_of_hour": acctest.Ct0,
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitSchedule(rName, 2*102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bandwidth_rate_limit_interval.*", map[string]string{
+						"average_download_rate_limit_in_bits_per_sec": "204800",
+						"average_upload_rate_limit_in_bits_per_sec":   "204800",
+					}),
+				),
+			},
+			{
+				Config: testAccGatewayConfig_typeCached(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGatewayDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
@@ -1495,3 +1590,45 @@ resource "aws_storagegateway_gateway" "test" {
 }
 `, rName, hourOfDay, minuteOfHour, dayOfWeek, dayOfMonth))
 }
+
+func testAccGatewayConfig_maintenanceStartTimeSoftwareUpdatePreferences(rName, policy string) string {
+	return acctest.ConfigCompose(testAcc_TapeAndVolumeGatewayBase(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "CACHED"
+
+  maintenance_start_time {
+    hour_of_day    = 22
+    minute_of_hour = 0
+    day_of_week    = 3
+
+    software_update_preferences {
+      automatic_update_policy = %[2]q
+    }
+  }
+}
+`, rName, policy))
+}
+
+func testAccGatewayConfig_bandwidthRateLimitSchedule(rName string, rate int) string {
+	return acctest.ConfigCompose(testAcc_TapeAndVolumeGatewayBase(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "CACHED"
+
+  bandwidth_rate_limit_interval {
+    average_download_rate_limit_in_bits_per_sec = %[2]d
+    average_upload_rate_limit_in_bits_per_sec   = %[2]d
+    days_of_week                                = [1, 2]
+    start_hour_of_day                           = 9
+    start_minute_of_hour                        = 0
+    end_hour_of_day                             = 17
+    end_minute_of_hour                          = 59
+  }
+}
+`, rName, rate))
+}
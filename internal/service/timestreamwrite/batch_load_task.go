@@ -0,0 +1,804 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_timestreamwrite_batch_load_task", name="Batch Load Task")
+func resourceBatchLoadTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBatchLoadTaskCreate,
+		ReadWithoutTimeout:   resourceBatchLoadTaskRead,
+		// Batch load tasks cannot be deleted.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_model_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_model": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"data_model_configuration.0.data_model", "data_model_configuration.0.data_model_s3_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_column": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"source_column": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"measure_name_column": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mixed_measure_mapping": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"measure_value_type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.MeasureValueType](),
+												},
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingSchema(false),
+												"source_column": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"target_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"multi_measure_mappings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingSchema(true),
+												"target_multi_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"time_column": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"time_unit": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.TimeUnit](),
+									},
+								},
+							},
+						},
+						"data_model_s3_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucketName: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"object_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"data_source_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_separator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"escape_char": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"null_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"quote_char": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"trim_white_space": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"data_format": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.BatchLoadDataFormat](),
+						},
+						"data_source_s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucketName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"record_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"report_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"report_s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucketName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"encryption_option": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3EncryptionOption](),
+									},
+									names.AttrKMSKeyID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"target_database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func multiMeasureAttributeMappingSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: !required,
+		Required: required,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"measure_value_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: enum.Validate[types.ScalarMeasureValueType](),
+				},
+				"source_column": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"target_multi_measure_attribute_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceBatchLoadTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamWriteClient(ctx)
+
+	input := &timestreamwrite.CreateBatchLoadTaskInput{
+		DataSourceConfiguration: expandDataSourceConfiguration(d.Get("data_source_configuration").([]interface{})),
+		ReportConfiguration:     expandReportConfiguration(d.Get("report_configuration").([]interface{})),
+		TargetDatabaseName:      aws.String(d.Get("target_database_name").(string)),
+		TargetTableName:         aws.String(d.Get("target_table_name").(string)),
+	}
+
+	if v, ok := d.GetOk("data_model_configuration"); ok {
+		input.DataModelConfiguration = expandDataModelConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("record_version"); ok {
+		input.RecordVersion = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.CreateBatchLoadTask(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Timestream Batch Load Task: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.TaskId))
+
+	if _, err := waitBatchLoadTaskSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Timestream Batch Load Task (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBatchLoadTaskRead(ctx, d, meta)...)
+}
+
+func resourceBatchLoadTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamWriteClient(ctx)
+
+	task, err := findBatchLoadTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream Batch Load Task %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Timestream Batch Load Task (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("data_model_configuration", flattenDataModelConfiguration(task.DataModelConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_model_configuration: %s", err)
+	}
+	if err := d.Set("data_source_configuration", flattenDataSourceConfiguration(task.DataSourceConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_source_configuration: %s", err)
+	}
+	d.Set("error_message", task.ErrorMessage)
+	d.Set("record_version", task.RecordVersion)
+	if err := d.Set("report_configuration", flattenReportConfiguration(task.ReportConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting report_configuration: %s", err)
+	}
+	d.Set("target_database_name", task.TargetDatabaseName)
+	d.Set("target_table_name", task.TargetTableName)
+	d.Set("task_status", task.TaskStatus)
+
+	return diags
+}
+
+func findBatchLoadTaskByID(ctx context.Context, conn *timestreamwrite.Client, id string) (*types.BatchLoadTaskDescription, error) {
+	input := &timestreamwrite.DescribeBatchLoadTaskInput{
+		TaskId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBatchLoadTask(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BatchLoadTaskDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BatchLoadTaskDescription, nil
+}
+
+func statusBatchLoadTask(ctx context.Context, conn *timestreamwrite.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBatchLoadTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.TaskStatus), nil
+	}
+}
+
+func waitBatchLoadTaskSucceeded(ctx context.Context, conn *timestreamwrite.Client, id string, timeout time.Duration) (*types.BatchLoadTaskDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.BatchLoadStatusCreated, types.BatchLoadStatusInProgress, types.BatchLoadStatusPendingResume),
+		Target:  enum.Slice(types.BatchLoadStatusSucceeded),
+		Refresh: statusBatchLoadTask(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.BatchLoadTaskDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDataSourceConfiguration(tfList []interface{}) *types.DataSourceConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.DataSourceConfiguration{
+		DataFormat: types.BatchLoadDataFormat(tfMap["data_format"].(string)),
+	}
+
+	if v, ok := tfMap["csv_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CsvConfiguration = &types.CsvConfiguration{}
+
+		if v, ok := tfMap["column_separator"].(string); ok && v != "" {
+			apiObject.CsvConfiguration.ColumnSeparator = aws.String(v)
+		}
+
+		if v, ok := tfMap["escape_char"].(string); ok && v != "" {
+			apiObject.CsvConfiguration.EscapeChar = aws.String(v)
+		}
+
+		if v, ok := tfMap["null_value"].(string); ok && v != "" {
+			apiObject.CsvConfiguration.NullValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["quote_char"].(string); ok && v != "" {
+			apiObject.CsvConfiguration.QuoteChar = aws.String(v)
+		}
+
+		if v, ok := tfMap["trim_white_space"].(bool); ok && v {
+			apiObject.CsvConfiguration.TrimWhiteSpace = aws.Bool(v)
+		}
+	}
+
+	if v, ok := tfMap["data_source_s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DataSourceS3Configuration = &types.DataSourceS3Configuration{
+			BucketName: aws.String(tfMap[names.AttrBucketName].(string)),
+		}
+
+		if v, ok := tfMap["object_key_prefix"].(string); ok && v != "" {
+			apiObject.DataSourceS3Configuration.ObjectKeyPrefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDataSourceConfiguration(apiObject *types.DataSourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"data_format": apiObject.DataFormat,
+	}
+
+	if v := apiObject.CsvConfiguration; v != nil {
+		tfMap["csv_configuration"] = []interface{}{map[string]interface{}{
+			"column_separator": aws.ToString(v.ColumnSeparator),
+			"escape_char":      aws.ToString(v.EscapeChar),
+			"null_value":       aws.ToString(v.NullValue),
+			"quote_char":       aws.ToString(v.QuoteChar),
+			"trim_white_space": aws.ToBool(v.TrimWhiteSpace),
+		}}
+	}
+
+	if v := apiObject.DataSourceS3Configuration; v != nil {
+		tfMap["data_source_s3_configuration"] = []interface{}{map[string]interface{}{
+			names.AttrBucketName: aws.ToString(v.BucketName),
+			"object_key_prefix":  aws.ToString(v.ObjectKeyPrefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandReportConfiguration(tfList []interface{}) *types.ReportConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.ReportConfiguration{}
+
+	if v, ok := tfMap["report_s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ReportS3Configuration = &types.ReportS3Configuration{
+			BucketName: aws.String(tfMap[names.AttrBucketName].(string)),
+		}
+
+		if v, ok := tfMap["encryption_option"].(string); ok && v != "" {
+			apiObject.ReportS3Configuration.EncryptionOption = types.S3EncryptionOption(v)
+		}
+
+		if v, ok := tfMap[names.AttrKMSKeyID].(string); ok && v != "" {
+			apiObject.ReportS3Configuration.KmsKeyId = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_key_prefix"].(string); ok && v != "" {
+			apiObject.ReportS3Configuration.ObjectKeyPrefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenReportConfiguration(apiObject *types.ReportConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReportS3Configuration; v != nil {
+		tfMap["report_s3_configuration"] = []interface{}{map[string]interface{}{
+			names.AttrBucketName: aws.ToString(v.BucketName),
+			"encryption_option":  v.EncryptionOption,
+			names.AttrKMSKeyID:   aws.ToString(v.KmsKeyId),
+			"object_key_prefix":  aws.ToString(v.ObjectKeyPrefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandDataModelConfiguration(tfList []interface{}) *types.DataModelConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.DataModelConfiguration{}
+
+	if v, ok := tfMap["data_model"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataModel = expandDataModel(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["data_model_s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DataModelS3Configuration = &types.DataModelS3Configuration{}
+
+		if v, ok := tfMap[names.AttrBucketName].(string); ok && v != "" {
+			apiObject.DataModelS3Configuration.BucketName = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_key"].(string); ok && v != "" {
+			apiObject.DataModelS3Configuration.ObjectKey = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandDataModel(tfMap map[string]interface{}) *types.DataModel {
+	apiObject := &types.DataModel{}
+
+	for _, tfMapRaw := range tfMap["dimension_mapping"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		mapping := types.DimensionMapping{}
+
+		if v, ok := tfMap["destination_column"].(string); ok && v != "" {
+			mapping.DestinationColumn = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_column"].(string); ok && v != "" {
+			mapping.SourceColumn = aws.String(v)
+		}
+
+		apiObject.DimensionMappings = append(apiObject.DimensionMappings, mapping)
+	}
+
+	if v, ok := tfMap["measure_name_column"].(string); ok && v != "" {
+		apiObject.MeasureNameColumn = aws.String(v)
+	}
+
+	for _, tfMapRaw := range tfMap["mixed_measure_mapping"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		mapping := types.MixedMeasureMapping{
+			MeasureValueType:              types.MeasureValueType(tfMap["measure_value_type"].(string)),
+			MultiMeasureAttributeMappings: expandMultiMeasureAttributeMappings(tfMap["multi_measure_attribute_mapping"].([]interface{})),
+		}
+
+		if v, ok := tfMap["measure_name"].(string); ok && v != "" {
+			mapping.MeasureName = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_column"].(string); ok && v != "" {
+			mapping.SourceColumn = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_measure_name"].(string); ok && v != "" {
+			mapping.TargetMeasureName = aws.String(v)
+		}
+
+		apiObject.MixedMeasureMappings = append(apiObject.MixedMeasureMappings, mapping)
+	}
+
+	if v, ok := tfMap["multi_measure_mappings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MultiMeasureMappings = &types.MultiMeasureMappings{
+			MultiMeasureAttributeMappings: expandMultiMeasureAttributeMappings(tfMap["multi_measure_attribute_mapping"].([]interface{})),
+		}
+
+		if v, ok := tfMap["target_multi_measure_name"].(string); ok && v != "" {
+			apiObject.MultiMeasureMappings.TargetMultiMeasureName = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["time_column"].(string); ok && v != "" {
+		apiObject.TimeColumn = aws.String(v)
+	}
+
+	if v, ok := tfMap["time_unit"].(string); ok && v != "" {
+		apiObject.TimeUnit = types.TimeUnit(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiMeasureAttributeMappings(tfList []interface{}) []types.MultiMeasureAttributeMapping {
+	var apiObjects []types.MultiMeasureAttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MultiMeasureAttributeMapping{
+			SourceColumn: aws.String(tfMap["source_column"].(string)),
+		}
+
+		if v, ok := tfMap["measure_value_type"].(string); ok && v != "" {
+			apiObject.MeasureValueType = types.ScalarMeasureValueType(v)
+		}
+
+		if v, ok := tfMap["target_multi_measure_attribute_name"].(string); ok && v != "" {
+			apiObject.TargetMultiMeasureAttributeName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDataModelConfiguration(apiObject *types.DataModelConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataModel; v != nil {
+		tfMap["data_model"] = []interface{}{flattenDataModel(v)}
+	}
+
+	if v := apiObject.DataModelS3Configuration; v != nil {
+		tfMap["data_model_s3_configuration"] = []interface{}{map[string]interface{}{
+			names.AttrBucketName: aws.ToString(v.BucketName),
+			"object_key":         aws.ToString(v.ObjectKey),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDataModel(apiObject *types.DataModel) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"measure_name_column": aws.ToString(apiObject.MeasureNameColumn),
+		"time_column":         aws.ToString(apiObject.TimeColumn),
+		"time_unit":           apiObject.TimeUnit,
+	}
+
+	var dimensionMappings []interface{}
+
+	for _, v := range apiObject.DimensionMappings {
+		dimensionMappings = append(dimensionMappings, map[string]interface{}{
+			"destination_column": aws.ToString(v.DestinationColumn),
+			"source_column":      aws.ToString(v.SourceColumn),
+		})
+	}
+
+	tfMap["dimension_mapping"] = dimensionMappings
+
+	var mixedMeasureMappings []interface{}
+
+	for _, v := range apiObject.MixedMeasureMappings {
+		mixedMeasureMappings = append(mixedMeasureMappings, map[string]interface{}{
+			"measure_name":                    aws.ToString(v.MeasureName),
+			"measure_value_type":              v.MeasureValueType,
+			"multi_measure_attribute_mapping": flattenMultiMeasureAttributeMappings(v.MultiMeasureAttributeMappings),
+			"source_column":                   aws.ToString(v.SourceColumn),
+			"target_measure_name":             aws.ToString(v.TargetMeasureName),
+		})
+	}
+
+	tfMap["mixed_measure_mapping"] = mixedMeasureMappings
+
+	if v := apiObject.MultiMeasureMappings; v != nil {
+		tfMap["multi_measure_mappings"] = []interface{}{map[string]interface{}{
+			"multi_measure_attribute_mapping": flattenMultiMeasureAttributeMappings(v.MultiMeasureAttributeMappings),
+			"target_multi_measure_name":       aws.ToString(v.TargetMultiMeasureName),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenMultiMeasureAttributeMappings(apiObjects []types.MultiMeasureAttributeMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"measure_value_type":                  apiObject.MeasureValueType,
+			"source_column":                       aws.ToString(apiObject.SourceColumn),
+			"target_multi_measure_attribute_name": aws.ToString(apiObject.TargetMultiMeasureAttributeName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamwrite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreamwrite "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTimestreamWriteBatchLoadTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.BatchLoadTaskDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamwrite_batch_load_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamWriteServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchLoadTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchLoadTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_model_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "data_model_configuration.0.data_model.0.dimension_mapping.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "data_model_configuration.0.data_model.0.multi_measure_mappings.0.multi_measure_attribute_mapping.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "data_source_configuration.0.data_format", "CSV"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source_configuration.0.data_source_s3_configuration.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "report_configuration.0.report_s3_configuration.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database_name", "aws_timestreamwrite_table.test", names.AttrDatabaseName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_table_name", "aws_timestreamwrite_table.test", names.AttrTableName),
+					resource.TestCheckResourceAttr(resourceName, "task_status", "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBatchLoadTaskExists(ctx context.Context, n string, v *types.BatchLoadTaskDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamWriteClient(ctx)
+
+		output, err := tftimestreamwrite.FindBatchLoadTaskByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBatchLoadTaskConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/data.csv"
+  content = <<EOT
+time,host,cpu
+1700000000000,host1,12.5
+1700000060000,host1,13.5
+EOT
+}
+
+resource "aws_timestreamwrite_batch_load_task" "test" {
+  target_database_name = aws_timestreamwrite_table.test.database_name
+  target_table_name    = aws_timestreamwrite_table.test.table_name
+
+  data_source_configuration {
+    data_format = "CSV"
+
+    data_source_s3_configuration {
+      bucket_name       = aws_s3_bucket.test.bucket
+      object_key_prefix = "data/"
+    }
+  }
+
+  data_model_configuration {
+    data_model {
+      time_column = "time"
+      time_unit   = "MILLISECONDS"
+
+      dimension_mapping {
+        source_column      = "host"
+        destination_column = "host"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "metrics"
+
+        multi_measure_attribute_mapping {
+          source_column      = "cpu"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+
+  report_configuration {
+    report_s3_configuration {
+      bucket_name       = aws_s3_bucket.test.bucket
+      object_key_prefix = "reports"
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName))
+}
@@ -5,9 +5,11 @@ package timestreamwrite
 
 // Exports for use in tests only.
 var (
-	ResourceDatabase = resourceDatabase
-	ResourceTable    = resourceTable
+	ResourceBatchLoadTask = resourceBatchLoadTask
+	ResourceDatabase      = resourceDatabase
+	ResourceTable         = resourceTable
 
+	FindBatchLoadTaskByID = findBatchLoadTaskByID
 	FindDatabaseByName    = findDatabaseByName
 	FindTableByTwoPartKey = findTableByTwoPartKey
 
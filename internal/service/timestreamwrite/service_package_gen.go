@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBatchLoadTask,
+			TypeName: "aws_timestreamwrite_batch_load_task",
+			Name:     "Batch Load Task",
+		},
 		{
 			Factory:  resourceDatabase,
 			TypeName: "aws_timestreamwrite_database",
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -70,10 +71,13 @@ func resourceAgreement() *schema.Resource {
 			"server_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			names.AttrStatus: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AgreementStatusType](),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -101,6 +105,10 @@ func resourceAgreementCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = awstypes.AgreementStatusType(v.(string))
+	}
+
 	output, err := conn.CreateAgreement(ctx, input)
 
 	if err != nil {
@@ -182,6 +190,10 @@ func resourceAgreementUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.PartnerProfileId = aws.String(d.Get("partner_profile_id").(string))
 		}
 
+		if d.HasChange(names.AttrStatus) {
+			input.Status = awstypes.AgreementStatusType(d.Get(names.AttrStatus).(string))
+		}
+
 		_, err := conn.UpdateAgreement(ctx, input)
 
 		if err != nil {
@@ -147,6 +147,46 @@ func testAccAgreement_tags(t *testing.T) {
 	})
 }
 
+func testAccAgreement_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedAgreement
+	resourceName := "aws_transfer_agreement.test"
+	baseDirectory := "/DOC-EXAMPLE-BUCKET/home/mydirectory"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TransferEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgreementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgreementConfig_status(rName, baseDirectory, string(awstypes.AgreementStatusTypeInactive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AgreementStatusTypeInactive)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAgreementConfig_status(rName, baseDirectory, string(awstypes.AgreementStatusTypeActive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgreementExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AgreementStatusTypeActive)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAgreementExists(ctx context.Context, n string, v *awstypes.DescribedAgreement) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -257,6 +297,19 @@ resource "aws_transfer_agreement" "test" {
 `, baseDirectory))
 }
 
+func testAccAgreementConfig_status(rName, baseDirectory, status string) string {
+	return acctest.ConfigCompose(testAccAgreementConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_agreement" "test" {
+  access_role        = aws_iam_role.test.arn
+  base_directory     = %[1]q
+  local_profile_id   = aws_transfer_profile.local.profile_id
+  partner_profile_id = aws_transfer_profile.partner.profile_id
+  server_id          = aws_transfer_server.test.id
+  status             = %[2]q
+}
+`, baseDirectory, status))
+}
+
 func testAccAgreementConfig_tags1(rName, baseDirectory, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAgreementConfig_base(rName), fmt.Sprintf(`
 resource "aws_transfer_agreement" "test" {
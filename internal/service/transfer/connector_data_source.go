@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_transfer_connector", name="Connector")
+// @Tags
+func dataSourceConnector() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConnectorRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"as2_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encryption_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_profile_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mdn_response": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mdn_signing_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partner_profile_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logging_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sftp_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trusted_host_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"user_secret_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	connectorID := d.Get("connector_id").(string)
+	output, err := findConnectorByID(ctx, conn, connectorID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Connector (%s): %s", connectorID, err)
+	}
+
+	d.SetId(aws.ToString(output.ConnectorId))
+	d.Set("access_role", output.AccessRole)
+	d.Set(names.AttrARN, output.Arn)
+	if err := d.Set("as2_config", flattenAs2ConnectorConfig(output.As2Config)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting as2_config: %s", err)
+	}
+	d.Set("connector_id", output.ConnectorId)
+	d.Set("logging_role", output.LoggingRole)
+	d.Set("security_policy_name", output.SecurityPolicyName)
+	if err := d.Set("sftp_config", flattenSftpConnectorConfig(output.SftpConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sftp_config: %s", err)
+	}
+	d.Set(names.AttrURL, output.Url)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
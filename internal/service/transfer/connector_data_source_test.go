@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTransferConnectorDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_transfer_connector.test"
+	dataSourceName := "data.aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TransferEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorDataSourceConfig_basic(rName, "http://www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_role", resourceName, "access_role"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "as2_config.#", resourceName, "as2_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "as2_config.0.local_profile_id", resourceName, "as2_config.0.local_profile_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connector_id", resourceName, "connector_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_policy_name", resourceName, "security_policy_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrURL, resourceName, names.AttrURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectorDataSourceConfig_basic(rName, url string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, url), `
+data "aws_transfer_connector" "test" {
+  connector_id = aws_transfer_connector.test.connector_id
+}
+`)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_transfer_connector_test", name="Connector Test")
+func dataSourceConnectorTest() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConnectorTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trusted_host_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceConnectorTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	connectorID := d.Get("connector_id").(string)
+	connector, err := findConnectorByID(ctx, conn, connectorID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Connector (%s): %s", connectorID, err)
+	}
+
+	output, err := testConnectionByConnectorID(ctx, conn, connectorID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Connector (%s) connection: %s", connectorID, err)
+	}
+
+	d.SetId(aws.ToString(connector.ConnectorId))
+	d.Set("connector_id", connector.ConnectorId)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+	if v := connector.SftpConfig; v != nil {
+		d.Set("trusted_host_keys", v.TrustedHostKeys)
+	}
+	d.Set(names.AttrURL, connector.Url)
+
+	return diags
+}
+
+func testConnectionByConnectorID(ctx context.Context, conn *transfer.Client, id string) (*transfer.TestConnectionOutput, error) {
+	input := &transfer.TestConnectionInput{
+		ConnectorId: aws.String(id),
+	}
+
+	output, err := conn.TestConnection(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
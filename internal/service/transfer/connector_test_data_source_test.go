@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTransferConnectorTestDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_transfer_connector.test"
+	dataSourceName := "data.aws_transfer_connector_test.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDNt3kA/dBkS6ZyU/sVDiGMuWJQaRPmLNbs/25K/e/fIl07ZWUgqqsFkcycLLMNFGD30Cmgp6XCXfNlIjzFWhNam+4cBb4DPpvieUw44VgsHK5JQy3JKlUfglmH5rs4G5pLiVfZpFU6jqvTsu4mE1CHCP0sXJlJhGxMG3QbsqYWNKiqGFEhuzGMs6fQlMkNiXsFoDmh33HAcXCbaFSC7V7xIqT1hlKu0iOL+GNjMj4R3xy0o3jafhO4MG2s3TwCQQCyaa5oyjL8iP8p3L9yp6cbIcXaS72SIgbCSGCyrcQPIKP2lJJHvE1oVWzLVBhR4eSzrlFDv7K4IErzaJmHqdiz" // nosemgrep:ci.ssh-key
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TransferEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The connector points at a server that does not exist, so the test reports an error status.
+				Config: testAccConnectorTestDataSourceConfig_basic(rName, "sftp://s-fakeserver.server.transfer.test.amazonaws.com", publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "connector_id", resourceName, "connector_id"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ERROR"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatusMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "trusted_host_keys.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "trusted_host_keys.*", publicKey),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrURL, resourceName, names.AttrURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectorTestDataSourceConfig_basic(rName, url, publicKey string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_sftpConfig(rName, url, publicKey), `
+data "aws_transfer_connector_test" "test" {
+  connector_id = aws_transfer_connector.test.connector_id
+}
+`)
+}
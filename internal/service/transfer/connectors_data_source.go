@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_transfer_connectors", name="Connectors")
+func dataSourceConnectors() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConnectorsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceConnectorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	connectors, err := findConnectors(ctx, conn, &transfer.ListConnectorsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Connectors: %s", err)
+	}
+
+	var arns, ids []string
+
+	for _, v := range connectors {
+		arns = append(arns, aws.ToString(v.Arn))
+		ids = append(ids, aws.ToString(v.ConnectorId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrIDs, ids)
+
+	return diags
+}
+
+func findConnectors(ctx context.Context, conn *transfer.Client, input *transfer.ListConnectorsInput) ([]awstypes.ListedConnector, error) {
+	var output []awstypes.ListedConnector
+
+	pages := transfer.NewListConnectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Connectors...)
+	}
+
+	return output, nil
+}
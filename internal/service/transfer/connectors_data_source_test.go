@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTransferConnectorsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_transfer_connector.test"
+	dataSourceName := "data.aws_transfer_connectors.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TransferEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorsDataSourceConfig_basic(rName, "http://www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "arns.#", 1),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "ids.#", 1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectorsDataSourceConfig_basic(rName, url string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, url), `
+data "aws_transfer_connectors" "test" {
+  depends_on = [aws_transfer_connector.test]
+}
+`)
+}
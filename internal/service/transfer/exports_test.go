@@ -9,6 +9,7 @@ var (
 	ResourceAgreement   = resourceAgreement
 	ResourceCertificate = resourceCertificate
 	ResourceConnector   = resourceConnector
+	ResourceHostKey     = resourceHostKey
 	ResourceProfile     = resourceProfile
 	ResourceServer      = resourceServer
 	ResourceSSHKey      = resourceSSHKey
@@ -20,10 +21,13 @@ var (
 	FindAgreementByTwoPartKey    = findAgreementByTwoPartKey
 	FindCertificateByID          = findCertificateByID
 	FindConnectorByID            = findConnectorByID
+	FindHostKeyByTwoPartKey      = findHostKeyByTwoPartKey
 	FindProfileByID              = findProfileByID
 	FindServerByID               = findServerByID
 	FindTag                      = findTag
 	FindUserByTwoPartKey         = findUserByTwoPartKey
 	FindUserSSHKeyByThreePartKey = findUserSSHKeyByThreePartKey
 	FindWorkflowByID             = findWorkflowByID
+	RoleTrustPolicyAllowsService = roleTrustPolicyAllowsService
+	StopServer                   = stopServer
 )
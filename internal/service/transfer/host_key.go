@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_transfer_host_key", name="Host Key")
+// @Tags(identifierAttribute="arn")
+func resourceHostKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceHostKeyCreate,
+		ReadWithoutTimeout:   resourceHostKeyRead,
+		UpdateWithoutTimeout: resourceHostKeyUpdate,
+		DeleteWithoutTimeout: resourceHostKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"host_key_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 4096),
+			},
+			"host_key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validServerID,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceHostKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	serverID := d.Get("server_id").(string)
+	input := &transfer.ImportHostKeyInput{
+		HostKeyBody: aws.String(d.Get("host_key_body").(string)),
+		ServerId:    aws.String(serverID),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.ImportHostKey(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing Transfer Host Key: %s", err)
+	}
+
+	d.SetId(hostKeyCreateResourceID(serverID, aws.ToString(output.HostKeyId)))
+
+	return append(diags, resourceHostKeyRead(ctx, d, meta)...)
+}
+
+func resourceHostKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	serverID, hostKeyID, err := hostKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findHostKeyByTwoPartKey(ctx, conn, serverID, hostKeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Host Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Host Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("host_key_fingerprint", output.HostKeyFingerprint)
+	d.Set("host_key_id", output.HostKeyId)
+	d.Set("server_id", serverID)
+	d.Set(names.AttrType, output.Type)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceHostKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	serverID, hostKeyID, err := hostKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input := &transfer.UpdateHostKeyInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			HostKeyId:   aws.String(hostKeyID),
+			ServerId:    aws.String(serverID),
+		}
+
+		_, err := conn.UpdateHostKey(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Transfer Host Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceHostKeyRead(ctx, d, meta)...)
+}
+
+func resourceHostKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	serverID, hostKeyID, err := hostKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Transfer Host Key: %s", d.Id())
+	_, err = conn.DeleteHostKey(ctx, &transfer.DeleteHostKeyInput{
+		HostKeyId: aws.String(hostKeyID),
+		ServerId:  aws.String(serverID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Host Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const hostKeyResourceIDSeparator = "/"
+
+func hostKeyCreateResourceID(serverID, hostKeyID string) string {
+	parts := []string{serverID, hostKeyID}
+	id := strings.Join(parts, hostKeyResourceIDSeparator)
+
+	return id
+}
+
+func hostKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, hostKeyResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVERID%[2]sHOSTKEYID", id, hostKeyResourceIDSeparator)
+}
+
+func findHostKeyByTwoPartKey(ctx context.Context, conn *transfer.Client, serverID, hostKeyID string) (*awstypes.DescribedHostKey, error) {
+	input := &transfer.DescribeHostKeyInput{
+		HostKeyId: aws.String(hostKeyID),
+		ServerId:  aws.String(serverID),
+	}
+
+	output, err := conn.DescribeHostKey(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HostKey == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HostKey, nil
+}
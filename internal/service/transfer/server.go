@@ -5,15 +5,21 @@ package transfer
 
 import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -25,6 +31,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -44,6 +51,12 @@ func resourceServer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
 			customdiff.ForceNewIfChange("endpoint_details.0.vpc_id", func(_ context.Context, old, new, meta interface{}) bool {
@@ -54,6 +67,9 @@ func resourceServer() *schema.Resource {
 
 				return false
 			}),
+			customizeDiffServerIdentityProviderDetails,
+			customizeDiffServerLoggingRoleTrust,
+			customizeDiffServerProtocols,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -148,8 +164,9 @@ func resourceServer() *schema.Resource {
 				Computed: true,
 			},
 			"identity_provider_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
+				Type:     schema.TypeString,
+				Optional: true,
+				// UpdateServer does not support changing IdentityProviderType.
 				ForceNew:         true,
 				Default:          awstypes.IdentityProviderTypeServiceManaged,
 				ValidateDiagFunc: enum.Validate[awstypes.IdentityProviderType](),
@@ -216,7 +233,7 @@ func resourceServer() *schema.Resource {
 			"protocols": {
 				Type:     schema.TypeSet,
 				MinItems: 1,
-				MaxItems: 3,
+				MaxItems: 4,
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Schema{
@@ -267,6 +284,10 @@ func resourceServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"validate_logging_role_trust": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"workflow_details": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -458,7 +479,7 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 			ServerId: aws.String(d.Id()),
 		}
 
-		if err := updateServer(ctx, conn, input); err != nil {
+		if err := updateServer(ctx, conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -566,7 +587,7 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).TransferClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "validate_logging_role_trust") {
 		var newEndpointTypeVpc bool
 		var oldEndpointTypeVpc bool
 
@@ -579,6 +600,7 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 		var addressAllocationIDs []string
 		var offlineUpdate bool
+		var protocolDetails *awstypes.ProtocolDetails
 		var removeAddressAllocationIDs bool
 
 		input := &transfer.UpdateServerInput{
@@ -660,7 +682,7 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 					return sdkdiag.AppendErrorf(diags, "modifying Transfer Server (%s) VPC Endpoint (%s): %s", d.Id(), vpcEndpointID, err)
 				}
 
-				if _, err := tfec2.WaitVPCEndpointAvailable(ctx, conn, vpcEndpointID, tfec2.VPCEndpointCreationTimeout); err != nil {
+				if _, err := tfec2.WaitVPCEndpointAvailable(ctx, conn, vpcEndpointID, d.Timeout(schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for Transfer Server (%s) VPC Endpoint (%s) update: %s", d.Id(), vpcEndpointID, err)
 				}
 			}
@@ -718,7 +740,9 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.HasChange("protocol_details") {
-			input.ProtocolDetails = expandProtocolDetails(d.Get("protocol_details").([]interface{}))
+			// Changes to as2_transports, passive_ip, set_stat_option and tls_session_resumption_mode
+			// are supported while the server is ONLINE.
+			protocolDetails = expandProtocolDetails(d.Get("protocol_details").([]interface{}))
 		}
 
 		if d.HasChange("protocols") {
@@ -742,47 +766,87 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.WorkflowDetails = expandWorkflowDetails(d.Get("workflow_details").([]interface{}))
 		}
 
-		if offlineUpdate {
-			if err := stopServer(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
+		if offlineUpdate && protocolDetails != nil {
+			// Apply protocol details before stopping the server so that they are never part of an offline update.
+			input := &transfer.UpdateServerInput{
+				ProtocolDetails: protocolDetails,
+				ServerId:        aws.String(d.Id()),
+			}
+
+			if err := updateServer(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating protocol details: %s", err)
 			}
+		} else {
+			input.ProtocolDetails = protocolDetails
 		}
 
-		if removeAddressAllocationIDs {
-			input := &transfer.UpdateServerInput{
-				EndpointDetails: &awstypes.EndpointDetails{
-					AddressAllocationIds: []string{},
-				},
-				ServerId: aws.String(d.Id()),
+		// A server that is already stopped is updated in place and left stopped.
+		var restartServer bool
+
+		if offlineUpdate {
+			server, err := findServerByID(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s): %s", d.Id(), err)
 			}
 
-			if err := updateServer(ctx, conn, input); err != nil {
-				return sdkdiag.AppendErrorf(diags, "removing address allocation IDs: %s", err)
+			if server.State == awstypes.StateOnline {
+				if err := stopServer(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+
+				restartServer = true
 			}
 		}
 
-		if err := updateServer(ctx, conn, input); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
+		err := func() error {
+			if removeAddressAllocationIDs {
+				input := &transfer.UpdateServerInput{
+					EndpointDetails: &awstypes.EndpointDetails{
+						AddressAllocationIds: []string{},
+					},
+					ServerId: aws.String(d.Id()),
+				}
 
-		if len(addressAllocationIDs) > 0 {
-			input := &transfer.UpdateServerInput{
-				EndpointDetails: &awstypes.EndpointDetails{
-					AddressAllocationIds: addressAllocationIDs,
-				},
-				ServerId: aws.String(d.Id()),
+				if err := updateServer(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("removing address allocation IDs: %w", err)
+				}
 			}
 
-			if err := updateServer(ctx, conn, input); err != nil {
-				return sdkdiag.AppendErrorf(diags, "adding address allocation IDs: %s", err)
+			if err := updateServer(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
 			}
-		}
 
-		if offlineUpdate {
+			if len(addressAllocationIDs) > 0 {
+				input := &transfer.UpdateServerInput{
+					EndpointDetails: &awstypes.EndpointDetails{
+						AddressAllocationIds: addressAllocationIDs,
+					},
+					ServerId: aws.String(d.Id()),
+				}
+
+				if err := updateServer(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("adding address allocation IDs: %w", err)
+				}
+			}
+
+			return nil
+		}()
+
+		// Bring the server back online even if the update failed.
+		if restartServer {
 			if err := startServer(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
+				diags = sdkdiag.AppendFromErr(diags, err)
 			}
 		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	return append(diags, resourceServerRead(ctx, d, meta)...)
@@ -838,13 +902,223 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "deleting Transfer Server (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitServerDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitServerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Transfer Server (%s) delete: %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+// customizeDiffServerIdentityProviderDetails ensures that directory_id is only set for, and always set for,
+// servers with an identity_provider_type of AWS_DIRECTORY_SERVICE.
+// Changing directory_id between directories is done in place via UpdateServer.
+func customizeDiffServerIdentityProviderDetails(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("identity_provider_type") || !d.NewValueKnown("directory_id") {
+		return nil
+	}
+
+	identityProviderType := awstypes.IdentityProviderType(d.Get("identity_provider_type").(string))
+	directoryID := d.Get("directory_id").(string)
+
+	switch identityProviderType {
+	case awstypes.IdentityProviderTypeAwsDirectoryService:
+		if directoryID == "" {
+			return fmt.Errorf(`"directory_id" is required when "identity_provider_type" is %q`, identityProviderType)
+		}
+	default:
+		if directoryID != "" {
+			return fmt.Errorf(`"directory_id" can only be set when "identity_provider_type" is %q`, awstypes.IdentityProviderTypeAwsDirectoryService)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffServerProtocols enforces the endpoint, identity provider and certificate requirements of each protocol.
+// See https://docs.aws.amazon.com/transfer/latest/userguide/API_CreateServer.html#TransferFamily-CreateServer-request-Protocols.
+func customizeDiffServerProtocols(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{names.AttrCertificate, names.AttrDomain, "endpoint_details", names.AttrEndpointType, "identity_provider_type", "protocol_details", "protocols"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	protocols := flex.ExpandStringyValueSet[awstypes.Protocol](d.Get("protocols").(*schema.Set))
+	if len(protocols) == 0 {
+		protocols = []awstypes.Protocol{awstypes.ProtocolSftp}
+	}
+
+	endpointType := awstypes.EndpointType(d.Get(names.AttrEndpointType).(string))
+	identityProviderType := awstypes.IdentityProviderType(d.Get("identity_provider_type").(string))
+
+	for _, protocol := range protocols {
+		switch protocol {
+		case awstypes.ProtocolAs2:
+			if endpointType != awstypes.EndpointTypeVpc {
+				return fmt.Errorf(`"endpoint_type" must be %q when "protocols" includes %q`, awstypes.EndpointTypeVpc, protocol)
+			}
+
+			if domain := awstypes.Domain(d.Get(names.AttrDomain).(string)); domain != awstypes.DomainS3 {
+				return fmt.Errorf(`"domain" must be %q when "protocols" includes %q`, awstypes.DomainS3, protocol)
+			}
+		case awstypes.ProtocolFtp, awstypes.ProtocolFtps:
+			if endpointType != awstypes.EndpointTypeVpc {
+				return fmt.Errorf(`"endpoint_type" must be %q when "protocols" includes %q`, awstypes.EndpointTypeVpc, protocol)
+			}
+
+			switch identityProviderType {
+			case awstypes.IdentityProviderTypeApiGateway, awstypes.IdentityProviderTypeAwsDirectoryService, awstypes.IdentityProviderTypeAwsLambda:
+			default:
+				return fmt.Errorf(`"identity_provider_type" must be one of %q, %q or %q when "protocols" includes %q`, awstypes.IdentityProviderTypeApiGateway, awstypes.IdentityProviderTypeAwsDirectoryService, awstypes.IdentityProviderTypeAwsLambda, protocol)
+			}
+
+			if protocol == awstypes.ProtocolFtps && d.Get(names.AttrCertificate).(string) == "" {
+				return fmt.Errorf(`"certificate" is required when "protocols" includes %q`, protocol)
+			}
+
+			if protocol == awstypes.ProtocolFtp {
+				if v, ok := d.GetOk("endpoint_details.0.address_allocation_ids"); ok && v.(*schema.Set).Len() > 0 {
+					return fmt.Errorf(`"endpoint_details.0.address_allocation_ids" cannot be set when "protocols" includes %q`, protocol)
+				}
+			}
+		}
+	}
+
+	// protocol_details is Optional+Computed, so only the configured value is checked.
+	if v := d.GetRawConfig().GetAttr("protocol_details"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v := v.Index(cty.NumberIntVal(0)).GetAttr("as2_transports"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			if !slices.Contains(protocols, awstypes.ProtocolAs2) {
+				return fmt.Errorf(`"protocol_details.0.as2_transports" can only be set when "protocols" includes %q`, awstypes.ProtocolAs2)
+			}
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffServerLoggingRoleTrust optionally checks at plan time that logging_role can be assumed by AWS Transfer Family.
+// Otherwise a misconfigured trust policy only surfaces as an opaque error from CreateServer or UpdateServer.
+func customizeDiffServerLoggingRoleTrust(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_logging_role_trust").(bool) || !d.NewValueKnown("logging_role") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("logging_role", "validate_logging_role_trust") {
+		return nil
+	}
+
+	roleARN := d.Get("logging_role").(string)
+	if roleARN == "" {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(roleARN)
+	if err != nil {
+		return fmt.Errorf(`parsing "logging_role" (%s): %w`, roleARN, err)
+	}
+
+	resource := strings.TrimPrefix(parsedARN.Resource, "role/")
+	roleName := resource[strings.LastIndex(resource, "/")+1:]
+
+	role, err := tfiam.FindRoleByName(ctx, meta.(*conns.AWSClient).IAMClient(ctx), roleName)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf(`"logging_role" (%s) does not exist`, roleARN)
+	}
+
+	if err != nil {
+		return fmt.Errorf(`reading IAM Role (%s) to validate "logging_role" trust policy: %w`, roleName, err)
+	}
+
+	policy, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return fmt.Errorf("decoding IAM Role (%s) trust policy: %w", roleName, err)
+	}
+
+	ok, err := roleTrustPolicyAllowsService(policy, serverServicePrincipal)
+	if err != nil {
+		return fmt.Errorf("parsing IAM Role (%s) trust policy: %w", roleName, err)
+	}
+
+	if !ok {
+		return fmt.Errorf(`"logging_role" (%[1]s) trust policy does not allow %[2]q to assume the role; add a statement with "Effect": "Allow", "Principal": {"Service": %[2]q} and "Action": "sts:AssumeRole"`, roleARN, serverServicePrincipal)
+	}
+
+	return nil
+}
+
+const serverServicePrincipal = "transfer.amazonaws.com"
+
+// roleTrustPolicyAllowsService reports whether a role trust policy has an Allow statement letting the service principal call sts:AssumeRole.
+// Conditions are not evaluated.
+func roleTrustPolicyAllowsService(policy, service string) (bool, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	type statement struct {
+		Action    json.RawMessage
+		Effect    string
+		Principal json.RawMessage
+	}
+	var statements []statement
+
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var v statement
+		if err := json.Unmarshal(doc.Statement, &v); err != nil {
+			return false, err
+		}
+		statements = []statement{v}
+	}
+
+	for _, v := range statements {
+		if v.Effect != "Allow" {
+			continue
+		}
+
+		if !slices.ContainsFunc(stringOrStringSlice(v.Action), func(action string) bool {
+			return action == "*" || strings.EqualFold(action, "sts:*") || strings.EqualFold(action, "sts:AssumeRole")
+		}) {
+			continue
+		}
+
+		var principal struct {
+			Service json.RawMessage
+		}
+		if err := json.Unmarshal(v.Principal, &principal); err != nil {
+			// "Principal": "*".
+			if slices.Contains(stringOrStringSlice(v.Principal), "*") {
+				return true, nil
+			}
+			continue
+		}
+
+		if slices.Contains(stringOrStringSlice(principal.Service), service) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func stringOrStringSlice(raw json.RawMessage) []string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return []string{s}
+	}
+
+	var ss []string
+	if err := json.Unmarshal(raw, &ss); err == nil {
+		return ss
+	}
+
+	return nil
+}
+
 func stopServer(ctx context.Context, conn *transfer.Client, serverID string, timeout time.Duration) error {
 	input := &transfer.StopServerInput{
 		ServerId: aws.String(serverID),
@@ -877,7 +1151,7 @@ func startServer(ctx context.Context, conn *transfer.Client, serverID string, ti
 	return nil
 }
 
-func updateServer(ctx context.Context, conn *transfer.Client, input *transfer.UpdateServerInput) error {
+func updateServer(ctx context.Context, conn *transfer.Client, input *transfer.UpdateServerInput, timeout time.Duration) error {
 	// The Transfer API will return a state of ONLINE for a server before the
 	// underlying VPC Endpoint is available and attempting to update the server
 	// will return an error until that EC2 API process is complete:
@@ -885,7 +1159,7 @@ func updateServer(ctx context.Context, conn *transfer.Client, input *transfer.Up
 	// To prevent accessing the EC2 API directly to check the VPC Endpoint
 	// state, which can require confusing IAM permissions and have other
 	// eventual consistency consideration, we retry only via the Transfer API.
-	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ConflictException](ctx, tfec2.VPCEndpointCreationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ConflictException](ctx, timeout, func() (interface{}, error) {
 		return conn.UpdateServer(ctx, input)
 	}, "VPC Endpoint state is not yet available")
 
@@ -954,10 +1228,7 @@ func waitServerCreated(ctx context.Context, conn *transfer.Client, id string, ti
 	return nil, err
 }
 
-func waitServerDeleted(ctx context.Context, conn *transfer.Client, id string) (*awstypes.DescribedServer, error) {
-	const (
-		timeout = 10 * time.Minute
-	)
+func waitServerDeleted(ctx context.Context, conn *transfer.Client, id string, timeout time.Duration) (*awstypes.DescribedServer, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.StateOffline, awstypes.StateOnline, awstypes.StateStarting, awstypes.StateStopping, awstypes.StateStartFailed, awstypes.StateStopFailed),
 		Target:  []string{},
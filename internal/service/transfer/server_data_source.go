@@ -7,11 +7,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -34,8 +39,41 @@ func dataSourceServer() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrEndpoint: {
-				Type:     schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrEndpoint, "server_id"},
+			},
+			"endpoint_details": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_allocation_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVPCEndpointID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 			names.AttrEndpointType: {
 				Type:     schema.TypeString,
@@ -65,8 +103,11 @@ func dataSourceServer() *schema.Resource {
 				Computed: true,
 			},
 			"server_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validServerID,
+				ExactlyOneOf: []string{names.AttrEndpoint, "server_id"},
 			},
 			"structured_log_destinations": {
 				Type:     schema.TypeList,
@@ -87,18 +128,41 @@ func dataSourceServerRead(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).TransferClient(ctx)
 
-	serverID := d.Get("server_id").(string)
-	output, err := findServerByID(ctx, conn, serverID)
+	var output *awstypes.DescribedServer
+	var err error
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s): %s", serverID, err)
+	if v, ok := d.GetOk("server_id"); ok {
+		serverID := v.(string)
+		output, err = findServerByID(ctx, conn, serverID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s): %s", serverID, err)
+		}
+	} else {
+		endpoint := d.Get(names.AttrEndpoint).(string)
+		output, err = findServerByEndpoint(ctx, conn, endpoint)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s): %s", endpoint, err)
+		}
 	}
 
-	d.SetId(aws.ToString(output.ServerId))
+	serverID := aws.ToString(output.ServerId)
+	d.SetId(serverID)
 	d.Set(names.AttrARN, output.Arn)
 	d.Set(names.AttrCertificate, output.Certificate)
 	d.Set(names.AttrDomain, output.Domain)
-	d.Set(names.AttrEndpoint, meta.(*conns.AWSClient).RegionalHostname(ctx, fmt.Sprintf("%s.server.transfer", serverID)))
+	// Keep a configured custom hostname.
+	if _, ok := d.GetOk(names.AttrEndpoint); !ok {
+		d.Set(names.AttrEndpoint, meta.(*conns.AWSClient).RegionalHostname(ctx, fmt.Sprintf("%s.server.transfer", serverID)))
+	}
+	if output.EndpointDetails != nil {
+		if err := d.Set("endpoint_details", []interface{}{flattenEndpointDetails(output.EndpointDetails, nil)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting endpoint_details: %s", err)
+		}
+	} else {
+		d.Set("endpoint_details", nil)
+	}
 	d.Set(names.AttrEndpointType, output.EndpointType)
 	d.Set("identity_provider_type", output.IdentityProviderType)
 	if output.IdentityProviderDetails != nil {
@@ -109,6 +173,7 @@ func dataSourceServerRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("logging_role", output.LoggingRole)
 	d.Set("protocols", output.Protocols)
 	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("server_id", serverID)
 	d.Set("structured_log_destinations", output.StructuredLogDestinations)
 	if output.IdentityProviderDetails != nil {
 		d.Set(names.AttrURL, output.IdentityProviderDetails.Url)
@@ -118,3 +183,41 @@ func dataSourceServerRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	return diags
 }
+
+const customHostnameTagKey = "aws:transfer:customHostname"
+
+// findServerByEndpoint returns the server whose endpoint is the given hostname.
+// Both the regional hostname (s-0123456789abcdef0.server.transfer.<region>.amazonaws.com)
+// and a custom hostname set via the aws:transfer:customHostname tag are supported.
+func findServerByEndpoint(ctx context.Context, conn *transfer.Client, endpoint string) (*awstypes.DescribedServer, error) {
+	if m := regexache.MustCompile(`^(s-[0-9a-f]{17})\.server\.transfer\.`).FindStringSubmatch(endpoint); m != nil {
+		return findServerByID(ctx, conn, m[1])
+	}
+
+	servers, err := findServers(ctx, conn, &transfer.ListServersInput{}, tfslices.PredicateTrue[*awstypes.ListedServer]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.DescribedServer
+
+	for _, v := range servers {
+		// Tags are only returned by DescribeServer.
+		server, err := findServerByID(ctx, conn, aws.ToString(v.ServerId))
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := KeyValueTags(ctx, server.Tags).Map()[customHostnameTagKey]; ok && v == endpoint {
+			output = append(output, *server)
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
@@ -94,6 +94,54 @@ func testAccServerDataSource_apigateway(t *testing.T) {
 	})
 }
 
+func testAccServerDataSource_endpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_transfer_server.test"
+	datasourceName := "data.aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerDataSourceConfig_endpoint,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrEndpoint, resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(datasourceName, "server_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerDataSource_vpc(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_server.test"
+	datasourceName := "data.aws_transfer_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerDataSourceConfig_vpc(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "endpoint_details.#", resourceName, "endpoint_details.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "endpoint_details.0.subnet_ids.#", resourceName, "endpoint_details.0.subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "endpoint_details.0.vpc_endpoint_id", resourceName, "endpoint_details.0.vpc_endpoint_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "endpoint_details.0.vpc_id", resourceName, "endpoint_details.0.vpc_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrEndpointType, resourceName, names.AttrEndpointType),
+				),
+			},
+		},
+	})
+}
+
 const testAccServerDataSourceConfig_basic = `
 resource "aws_transfer_server" "test" {}
 
@@ -265,3 +313,19 @@ data "aws_transfer_server" "test" {
 }
 `, rName)
 }
+
+const testAccServerDataSourceConfig_endpoint = `
+resource "aws_transfer_server" "test" {}
+
+data "aws_transfer_server" "test" {
+  endpoint = aws_transfer_server.test.endpoint
+}
+`
+
+func testAccServerDataSourceConfig_vpc(rName string) string {
+	return acctest.ConfigCompose(testAccServerConfig_vpcUpdate(rName), `
+data "aws_transfer_server" "test" {
+  server_id = aws_transfer_server.test.id
+}
+`)
+}
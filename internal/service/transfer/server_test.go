@@ -8,12 +8,16 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	acmpca_types "github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -423,6 +427,7 @@ func testAccServer_vpcAddressAllocationIDs(t *testing.T) {
 				Config: testAccServerConfig_vpcAddressAllocationIdsUpdate(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServerExists(ctx, resourceName, &conf),
+					testAccCheckServerOnline(&conf),
 					resource.TestCheckResourceAttr(resourceName, "endpoint_details.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.address_allocation_ids.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_details.0.address_allocation_ids.*", eip2ResourceName, names.AttrID),
@@ -506,6 +511,39 @@ func testAccServer_vpcSecurityGroupIDs(t *testing.T) {
 	})
 }
 
+func testAccServer_vpcAddressAllocationIDsStopped(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	eip2ResourceName := "aws_eip.test.1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_vpcAddressAllocationIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &conf),
+					testAccCheckServerStop(ctx, &conf),
+				),
+			},
+			{
+				Config: testAccServerConfig_vpcAddressAllocationIdsUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &conf),
+					testAccCheckServerOffline(&conf),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.address_allocation_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_details.0.address_allocation_ids.*", eip2ResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccServer_vpcAddressAllocationIds_securityGroupIDs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.DescribedServer
@@ -903,6 +941,7 @@ func testAccServer_protocols(t *testing.T) {
 func testAccServer_protocolDetails(t *testing.T) {
 	ctx := acctest.Context(t)
 	var s awstypes.DescribedServer
+	var watcher serverStateWatcher
 	resourceName := "aws_transfer_server.test"
 
 	resource.Test(t, resource.TestCase{
@@ -929,9 +968,18 @@ func testAccServer_protocolDetails(t *testing.T) {
 				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
 			},
 			{
+				PreConfig: func() {
+					watcher.start(ctx, aws.ToString(s.ServerId))
+				},
 				Config: testAccServerConfig_protocolDetails("8.8.8.8", "ENABLE_NO_OP", "DISABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServerExists(ctx, resourceName, &s),
+					testAccCheckServerNotStopped(&s, &watcher),
 					resource.TestCheckResourceAttr(resourceName, "protocol_details.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.as2_transports.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.passive_ip", "8.8.8.8"),
@@ -939,6 +987,95 @@ func testAccServer_protocolDetails(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.tls_session_resumption_mode", "DISABLED"),
 				),
 			},
+			{
+				PreConfig: func() {
+					watcher.start(ctx, aws.ToString(s.ServerId))
+				},
+				Config: testAccServerConfig_protocolDetails("AUTO", "ENABLE_NO_OP", "DISABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &s),
+					testAccCheckServerNotStopped(&s, &watcher),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.passive_ip", "AUTO"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServer_protocolDetailsAS2(t *testing.T) {
+	ctx := acctest.Context(t)
+	var s awstypes.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_protocolDetailsAS2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &s),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEndpointType, "VPC"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "AS2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protocols.*", "SFTP"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "protocol_details.0.as2_transports.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protocol_details.0.as2_transports.*", "HTTP"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+			},
+		},
+	})
+}
+
+func testAccServer_protocolsInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerConfig_protocolsInvalid(`["AS2"]`, "PUBLIC", "SERVICE_MANAGED", "S3"),
+				ExpectError: regexache.MustCompile(`"endpoint_type" must be "VPC" when "protocols" includes "AS2"`),
+			},
+			{
+				Config:      testAccServerConfig_protocolsInvalid(`["AS2"]`, "VPC", "SERVICE_MANAGED", "EFS"),
+				ExpectError: regexache.MustCompile(`"domain" must be "S3" when "protocols" includes "AS2"`),
+			},
+			{
+				Config:      testAccServerConfig_protocolsInvalid(`["FTP"]`, "PUBLIC", "API_GATEWAY", "S3"),
+				ExpectError: regexache.MustCompile(`"endpoint_type" must be "VPC" when "protocols" includes "FTP"`),
+			},
+			{
+				Config:      testAccServerConfig_protocolsInvalid(`["FTP"]`, "VPC", "SERVICE_MANAGED", "S3"),
+				ExpectError: regexache.MustCompile(`"identity_provider_type" must be one of .* when "protocols" includes "FTP"`),
+			},
+			{
+				Config:      testAccServerConfig_protocolsInvalid(`["FTPS"]`, "VPC", "API_GATEWAY", "S3"),
+				ExpectError: regexache.MustCompile(`"certificate" is required when "protocols" includes "FTPS"`),
+			},
+			{
+				Config:      testAccServerConfig_protocolsInvalidAS2Transports(),
+				ExpectError: regexache.MustCompile(`"protocol_details.0.as2_transports" can only be set when "protocols" includes "AS2"`),
+			},
 		},
 	})
 }
@@ -982,6 +1119,21 @@ func testAccServer_s3StorageOptions(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
 			},
+			{
+				Config: testAccServerConfig_s3StorageOptions("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &s),
+					testAccCheckServerUpdateDirectoryListingOptimization(ctx, &s, awstypes.DirectoryListingOptimizationEnabled),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccServerConfig_s3StorageOptions("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &s),
+					resource.TestCheckResourceAttr(resourceName, "s3_storage_options.0.directory_listing_optimization", "DISABLED"),
+				),
+			},
 		},
 	})
 }
@@ -1082,6 +1234,170 @@ func testAccServer_directoryService(t *testing.T) {
 	})
 }
 
+func testAccServer_updateDirectoryService(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain1 := acctest.RandomDomainName()
+	domain2 := acctest.RandomDomainName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_directoryServiceUpdate(rName, domain1, domain2, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_type", "AWS_DIRECTORY_SERVICE"),
+				),
+			},
+			{
+				Config: testAccServerConfig_directoryServiceUpdate(rName, domain1, domain2, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test2", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_type", "AWS_DIRECTORY_SERVICE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServer_directoryIDInvalidIdentityProviderType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerConfig_directoryIDIdentityProviderType(rName, "SERVICE_MANAGED", "d-1234567890"),
+				ExpectError: regexache.MustCompile(`"directory_id" can only be set when "identity_provider_type" is "AWS_DIRECTORY_SERVICE"`),
+			},
+			{
+				Config:      testAccServerConfig_directoryIDIdentityProviderType(rName, "AWS_DIRECTORY_SERVICE", ""),
+				ExpectError: regexache.MustCompile(`"directory_id" is required when "identity_provider_type" is "AWS_DIRECTORY_SERVICE"`),
+			},
+		},
+	})
+}
+
+func testAccServer_loggingRoleTrust(t *testing.T) {
+	ctx := acctest.Context(t)
+	var s awstypes.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_loggingRoleTrustBase(rName, "ec2.amazonaws.com"),
+			},
+			{
+				Config:      testAccServerConfig_loggingRoleTrust(rName, "ec2.amazonaws.com"),
+				ExpectError: regexache.MustCompile(`trust policy does not allow "transfer.amazonaws.com" to assume the role`),
+			},
+			{
+				Config: testAccServerConfig_loggingRoleTrustBase(rName, "transfer.amazonaws.com"),
+			},
+			{
+				Config: testAccServerConfig_loggingRoleTrust(rName, "transfer.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &s),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_role", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "validate_logging_role_trust", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestRoleTrustPolicyAllowsService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		policy  string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "service principal string",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"transfer.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			want:   true,
+		},
+		{
+			name:   "service principal list and single statement",
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","transfer.amazonaws.com"]},"Action":["sts:AssumeRole","sts:TagSession"]}}`,
+			want:   true,
+		},
+		{
+			name:   "wildcard action",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"transfer.amazonaws.com"},"Action":"sts:*"}]}`,
+			want:   true,
+		},
+		{
+			name:   "other service",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:   "deny",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"transfer.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:   "other action",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"transfer.amazonaws.com"},"Action":"sts:AssumeRoleWithWebIdentity"}]}`,
+		},
+		{
+			name:   "AWS principal",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:    "invalid JSON",
+			policy:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tftransfer.RoleTrustPolicyAllowsService(testCase.policy, "transfer.amazonaws.com")
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("RoleTrustPolicyAllowsService() err %t, want %t: %v", got, want, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("RoleTrustPolicyAllowsService() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func testAccServer_forceDestroy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var s awstypes.DescribedServer
@@ -1403,6 +1719,119 @@ func testAccCheckServerExists(ctx context.Context, n string, v *awstypes.Describ
 	}
 }
 
+// testAccCheckServerOnline verifies that an in-place update left the server ONLINE.
+func testAccCheckServerOnline(v *awstypes.DescribedServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.State != awstypes.StateOnline {
+			return fmt.Errorf("Transfer Server (%s) state is %s, expected %s", aws.ToString(v.ServerId), v.State, awstypes.StateOnline)
+		}
+
+		return nil
+	}
+}
+
+// serverStateWatcher polls a server's state while a test step is applied.
+type serverStateWatcher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	states []awstypes.State
+}
+
+func (w *serverStateWatcher) start(ctx context.Context, serverID string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	w.states = nil
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(2 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			if output, err := tftransfer.FindServerByID(ctx, conn, serverID); err == nil {
+				w.states = append(w.states, output.State)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (w *serverStateWatcher) stop() []awstypes.State {
+	if w.cancel == nil {
+		return nil
+	}
+
+	w.cancel()
+	<-w.done
+	w.cancel = nil
+
+	return w.states
+}
+
+// testAccCheckServerNotStopped verifies that the server stayed ONLINE for the whole of an in-place update,
+// i.e. that the update did not stop and restart the server.
+func testAccCheckServerNotStopped(v *awstypes.DescribedServer, w *serverStateWatcher) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		states := w.stop()
+
+		if len(states) == 0 {
+			return fmt.Errorf("Transfer Server (%s) state was not observed during update", aws.ToString(v.ServerId))
+		}
+
+		for _, state := range states {
+			if state != awstypes.StateOnline {
+				return fmt.Errorf("Transfer Server (%s) was %s during update, expected it to remain %s", aws.ToString(v.ServerId), state, awstypes.StateOnline)
+			}
+		}
+
+		return testAccCheckServerOnline(v)(s)
+	}
+}
+
+// testAccCheckServerOffline verifies that an update left a stopped server OFFLINE.
+func testAccCheckServerOffline(v *awstypes.DescribedServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.State != awstypes.StateOffline {
+			return fmt.Errorf("Transfer Server (%s) state is %s, expected %s", aws.ToString(v.ServerId), v.State, awstypes.StateOffline)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckServerStop stops the server outside of Terraform.
+func testAccCheckServerStop(ctx context.Context, v *awstypes.DescribedServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
+
+		return tftransfer.StopServer(ctx, conn, aws.ToString(v.ServerId), 10*time.Minute)
+	}
+}
+
+// testAccCheckServerUpdateDirectoryListingOptimization changes directory listing optimization outside of Terraform.
+func testAccCheckServerUpdateDirectoryListingOptimization(ctx context.Context, v *awstypes.DescribedServer, directoryListingOptimization awstypes.DirectoryListingOptimization) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
+
+		_, err := conn.UpdateServer(ctx, &transfer.UpdateServerInput{
+			S3StorageOptions: &awstypes.S3StorageOptions{
+				DirectoryListingOptimization: directoryListingOptimization,
+			},
+			ServerId: v.ServerId,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckServerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
@@ -1756,6 +2185,92 @@ resource "aws_transfer_server" "test" {
 `, rName, domain, forceDestroy))
 }
 
+func testAccServerConfig_directoryServiceUpdate(rName, domain1, domain2, directoryResourceName string) string {
+	return acctest.ConfigCompose(
+		testAccServerConfig_vpcBase(rName),
+		testAccServerConfig_loggingRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_directory_service_directory" "test1" {
+  name     = %[2]q
+  password = "SuperSecretPassw0rd"
+
+  vpc_settings {
+    vpc_id = aws_vpc.test.id
+
+    subnet_ids = [
+      aws_subnet.test.id,
+      aws_subnet.test2.id
+    ]
+  }
+}
+
+resource "aws_directory_service_directory" "test2" {
+  name     = %[3]q
+  password = "SuperSecretPassw0rd"
+
+  vpc_settings {
+    vpc_id = aws_vpc.test.id
+
+    subnet_ids = [
+      aws_subnet.test.id,
+      aws_subnet.test2.id
+    ]
+  }
+}
+
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "AWS_DIRECTORY_SERVICE"
+  directory_id           = aws_directory_service_directory.%[4]s.id
+  logging_role           = aws_iam_role.test.arn
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, domain1, domain2, directoryResourceName))
+}
+
+func testAccServerConfig_directoryIDIdentityProviderType(rName, identityProviderType, directoryID string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  identity_provider_type = %[2]q
+  directory_id           = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, identityProviderType, directoryID)
+}
+
+func testAccServerConfig_loggingRoleTrustBase(rName, service string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = %[2]q
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName, service)
+}
+
+func testAccServerConfig_loggingRoleTrust(rName, service string) string {
+	return acctest.ConfigCompose(testAccServerConfig_loggingRoleTrustBase(rName, service), `
+resource "aws_transfer_server" "test" {
+  logging_role                = aws_iam_role.test.arn
+  validate_logging_role_trust = true
+}
+`)
+}
+
 func testAccServerConfig_forceDestroy(rName, publicKey string) string {
 	return fmt.Sprintf(`
 resource "aws_transfer_server" "test" {
@@ -2144,6 +2659,49 @@ resource "aws_transfer_server" "test" {
 `, passive_ip, set_stat_option, tls_session_resumption_mode)
 }
 
+func testAccServerConfig_protocolDetailsAS2(rName string) string {
+	return acctest.ConfigCompose(testAccServerConfig_vpcBase(rName), fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  protocols = ["AS2", "SFTP"]
+
+  protocol_details {
+    as2_transports = ["HTTP"]
+  }
+
+  endpoint_type = "VPC"
+  endpoint_details {
+    subnet_ids = [aws_subnet.test.id]
+    vpc_id     = aws_vpc.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccServerConfig_protocolsInvalid(protocols, endpointType, identityProviderType, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  domain                 = %[4]q
+  endpoint_type          = %[2]q
+  identity_provider_type = %[3]q
+  protocols              = %[1]s
+}
+`, protocols, endpointType, identityProviderType, domain)
+}
+
+func testAccServerConfig_protocolsInvalidAS2Transports() string {
+	return `
+resource "aws_transfer_server" "test" {
+  protocol_details {
+    as2_transports = ["HTTP"]
+  }
+}
+`
+}
+
 func testAccServerConfig_rootCA(domain string) string {
 	return fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "test" {
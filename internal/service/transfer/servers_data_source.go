@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_transfer_servers", name="Servers")
+func dataSourceServers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServersRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrEndpointType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EndpointType](),
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(enum.Values[awstypes.Protocol](), false),
+				},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	endpointType := awstypes.EndpointType(d.Get(names.AttrEndpointType).(string))
+	protocols := flex.ExpandStringValueSet(d.Get("protocols").(*schema.Set))
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	servers, err := findServers(ctx, conn, &transfer.ListServersInput{}, func(v *awstypes.ListedServer) bool {
+		return endpointType == "" || v.EndpointType == endpointType
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Servers: %s", err)
+	}
+
+	var arns, ids []string
+
+	for _, v := range servers {
+		serverID := aws.ToString(v.ServerId)
+
+		// Protocols and tags are only returned by DescribeServer.
+		if len(protocols) > 0 || len(tags) > 0 {
+			server, err := findServerByID(ctx, conn, serverID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s): %s", serverID, err)
+			}
+
+			if !serverHasProtocols(server, protocols) {
+				continue
+			}
+
+			if !KeyValueTags(ctx, server.Tags).ContainsAll(tags) {
+				continue
+			}
+		}
+
+		arns = append(arns, aws.ToString(v.Arn))
+		ids = append(ids, serverID)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrIDs, ids)
+
+	return diags
+}
+
+func serverHasProtocols(server *awstypes.DescribedServer, protocols []string) bool {
+	for _, v := range protocols {
+		if !slices.Contains(server.Protocols, awstypes.Protocol(v)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findServers(ctx context.Context, conn *transfer.Client, input *transfer.ListServersInput, filter tfslices.Predicate[*awstypes.ListedServer]) ([]awstypes.ListedServer, error) {
+	var output []awstypes.ListedServer
+
+	pages := transfer.NewListServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Servers {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
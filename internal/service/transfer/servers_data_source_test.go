@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccServersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_server.test.0"
+	dataSourceName := "data.aws_transfer_servers.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_transfer_servers.protocols", "ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccServersDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  count = 2
+
+  tags = {
+    Name  = %[1]q
+    Index = count.index
+  }
+}
+
+data "aws_transfer_servers" "test" {
+  endpoint_type = "PUBLIC"
+
+  tags = {
+    Name  = %[1]q
+    Index = "0"
+  }
+
+  depends_on = [aws_transfer_server.test]
+}
+
+data "aws_transfer_servers" "protocols" {
+  protocols = ["FTPS"]
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_transfer_server.test]
+}
+`, rName)
+}
@@ -25,11 +25,43 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceConnector,
+			TypeName: "aws_transfer_connector",
+			Name:     "Connector",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  dataSourceConnectorTest,
+			TypeName: "aws_transfer_connector_test",
+			Name:     "Connector Test",
+		},
+		{
+			Factory:  dataSourceConnectors,
+			TypeName: "aws_transfer_connectors",
+			Name:     "Connectors",
+		},
 		{
 			Factory:  dataSourceServer,
 			TypeName: "aws_transfer_server",
 			Name:     "Server",
 		},
+		{
+			Factory:  dataSourceServers,
+			TypeName: "aws_transfer_servers",
+			Name:     "Servers",
+		},
+		{
+			Factory:  dataSourceUser,
+			TypeName: "aws_transfer_user",
+			Name:     "User",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  dataSourceWorkflowExecutions,
+			TypeName: "aws_transfer_workflow_executions",
+			Name:     "Workflow Executions",
+		},
 	}
 }
 
@@ -64,6 +96,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceHostKey,
+			TypeName: "aws_transfer_host_key",
+			Name:     "Host Key",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceProfile,
 			TypeName: "aws_transfer_profile",
@@ -27,6 +27,7 @@ func resourceSSHKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSSHKeyCreate,
 		ReadWithoutTimeout:   resourceSSHKeyRead,
+		UpdateWithoutTimeout: resourceSSHKeyUpdate,
 		DeleteWithoutTimeout: resourceSSHKeyDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -37,13 +38,17 @@ func resourceSSHKey() *schema.Resource {
 			"body": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					old = cleanSSHKey(old)
 					new = cleanSSHKey(new)
 					return strings.Trim(old, "\n") == strings.Trim(new, "\n")
 				},
 			},
+			"delete_prior_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"server_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -61,6 +66,8 @@ func resourceSSHKey() *schema.Resource {
 				ValidateFunc: validUserName,
 			},
 		},
+
+		CustomizeDiff: customizeDiffSSHKeyBody,
 	}
 }
 
@@ -116,6 +123,39 @@ func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	if d.HasChange("body") {
+		serverID, userName, sshKeyID, err := sshKeyParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &transfer.ImportSshPublicKeyInput{
+			ServerId:         aws.String(serverID),
+			SshPublicKeyBody: aws.String(d.Get("body").(string)),
+			UserName:         aws.String(userName),
+		}
+
+		output, err := conn.ImportSshPublicKey(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "importing Transfer SSH Key: %s", err)
+		}
+
+		// The new key is in place, so track it before removing the one it rotates out.
+		d.SetId(sshKeyCreateResourceID(serverID, userName, aws.ToString(output.SshPublicKeyId)))
+
+		if err := deleteSSHKey(ctx, conn, serverID, userName, sshKeyID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting prior Transfer SSH Key (%s): %s", sshKeyCreateResourceID(serverID, userName, sshKeyID), err)
+		}
+	}
+
+	return append(diags, resourceSSHKeyRead(ctx, d, meta)...)
+}
+
 func resourceSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).TransferClient(ctx)
@@ -126,21 +166,39 @@ func resourceSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[DEBUG] Deleting Transfer SSH Key: %s", d.Id())
-	_, err = conn.DeleteSshPublicKey(ctx, &transfer.DeleteSshPublicKeyInput{
+	if err := deleteSSHKey(ctx, conn, serverID, userName, sshKeyID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer SSH Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func deleteSSHKey(ctx context.Context, conn *transfer.Client, serverID, userName, sshKeyID string) error {
+	_, err := conn.DeleteSshPublicKey(ctx, &transfer.DeleteSshPublicKeyInput{
 		UserName:       aws.String(userName),
 		ServerId:       aws.String(serverID),
 		SshPublicKeyId: aws.String(sshKeyID),
 	})
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return diags
+		return nil
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Transfer SSH Key (%s): %s", d.Id(), err)
+	return err
+}
+
+// customizeDiffSSHKeyBody replaces the resource when the key body changes, unless
+// delete_prior_keys is set, in which case the key is rotated in place.
+func customizeDiffSSHKeyBody(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("body") {
+		return nil
 	}
 
-	return diags
+	if !d.Get("delete_prior_keys").(bool) {
+		return d.ForceNew("body")
+	}
+
+	return d.SetNewComputed("ssh_key_id")
 }
 
 const sshKeyResourceIDSeparator = "/"
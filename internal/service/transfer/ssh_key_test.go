@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -46,9 +48,10 @@ func testAccSSHKey_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_prior_keys"},
 			},
 		},
 	})
@@ -82,6 +85,51 @@ func testAccSSHKey_disappears(t *testing.T) {
 	})
 }
 
+func testAccSSHKey_deletePriorKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 awstypes.SshPublicKey
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_ssh_key.test"
+	publicKey1, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+	publicKey2, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSSHKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHKeyConfig_deletePriorKeys(rName, publicKey1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSSHKeyExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "body", publicKey1),
+					resource.TestCheckResourceAttr(resourceName, "delete_prior_keys", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccSSHKeyConfig_deletePriorKeys(rName, publicKey2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSSHKeyExists(ctx, resourceName, &conf2),
+					testAccCheckSSHKeyRotated(ctx, resourceName, &conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "body", publicKey2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSSHKeyExists(ctx context.Context, n string, v *awstypes.SshPublicKey) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -103,6 +151,33 @@ func testAccCheckSSHKeyExists(ctx context.Context, n string, v *awstypes.SshPubl
 	}
 }
 
+func testAccCheckSSHKeyRotated(ctx context.Context, n string, before, after *awstypes.SshPublicKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if aws.ToString(before.SshPublicKeyId) == aws.ToString(after.SshPublicKeyId) {
+			return fmt.Errorf("Transfer SSH Key (%s) not rotated", rs.Primary.ID)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
+
+		_, _, err := tftransfer.FindUserSSHKeyByThreePartKey(ctx, conn, rs.Primary.Attributes["server_id"], rs.Primary.Attributes[names.AttrUserName], aws.ToString(before.SshPublicKeyId))
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("prior Transfer SSH Key %s still exists", aws.ToString(before.SshPublicKeyId))
+	}
+}
+
 func testAccCheckSSHKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
@@ -188,3 +263,43 @@ resource "aws_transfer_ssh_key" "test" {
 }
 `, rName, publicKey)
 }
+
+func testAccSSHKeyConfig_deletePriorKeys(rName, publicKey string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "SERVICE_MANAGED"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "transfer.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_transfer_user" "test" {
+  server_id = aws_transfer_server.test.id
+  user_name = "tftestuser"
+  role      = aws_iam_role.test.arn
+}
+
+resource "aws_transfer_ssh_key" "test" {
+  server_id         = aws_transfer_server.test.id
+  user_name         = aws_transfer_user.test.user_name
+  body              = "%[2]s"
+  delete_prior_keys = true
+}
+`, rName, publicKey)
+}
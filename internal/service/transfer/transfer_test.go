@@ -22,8 +22,13 @@ func TestAccTransfer_serial(t *testing.T) {
 		"Agreement": {
 			acctest.CtBasic:      testAccAgreement_basic,
 			acctest.CtDisappears: testAccAgreement_disappears,
+			"status":             testAccAgreement_status,
 			"tags":               testAccAgreement_tags,
 		},
+		"HostKey": {
+			acctest.CtBasic:      testAccHostKey_basic,
+			acctest.CtDisappears: testAccHostKey_disappears,
+		},
 		"Server": {
 			acctest.CtBasic:                   testAccServer_basic,
 			acctest.CtDisappears:              testAccServer_disappears,
@@ -32,19 +37,27 @@ func TestAccTransfer_serial(t *testing.T) {
 			"APIGatewayForceDestroy":          testAccServer_apiGateway_forceDestroy,
 			"AuthenticationLoginBanners":      testAccServer_authenticationLoginBanners,
 			"DataSourceBasic":                 testAccServerDataSource_basic,
+			"DataSourceEndpoint":              testAccServerDataSource_endpoint,
 			"DataSourceServiceManaged":        testAccServerDataSource_Service_managed,
 			"DataSourceAPIGateway":            testAccServerDataSource_apigateway,
+			"DataSourceServers":               testAccServersDataSource_basic,
+			"DataSourceVPC":                   testAccServerDataSource_vpc,
 			"DirectoryService":                testAccServer_directoryService,
+			"DirectoryIDInvalid":              testAccServer_directoryIDInvalidIdentityProviderType,
 			"Domain":                          testAccServer_domain,
 			"ForceDestroy":                    testAccServer_forceDestroy,
 			"HostKey":                         testAccServer_hostKey,
 			"LambdaFunction":                  testAccServer_lambdaFunction,
+			"LoggingRoleTrust":                testAccServer_loggingRoleTrust,
 			"Protocols":                       testAccServer_protocols,
 			"ProtocolDetails":                 testAccServer_protocolDetails,
+			"ProtocolDetailsAS2":              testAccServer_protocolDetailsAS2,
+			"ProtocolsInvalid":                testAccServer_protocolsInvalid,
 			"S3StorageOptions":                testAccServer_s3StorageOptions,
 			"SecurityPolicy":                  testAccServer_securityPolicy,
 			"SecurityPolicyFIPS":              testAccServer_securityPolicyFIPS,
 			"SftpAuthenticationMethods":       testAccServer_identityProviderType_sftpAuthenticationMethods,
+			"UpdateDirectoryService":          testAccServer_updateDirectoryService,
 			"UpdateSftpAuthenticationMethods": testAccServer_updateIdentityProviderType_sftpAuthenticationMethods,
 			"StructuredLogDestinations":       testAccServer_structuredLogDestinations,
 			"UpdateEndpointTypePublicToVPC":   testAccServer_updateEndpointType_publicToVPC,
@@ -56,6 +69,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"VPC":                                                    testAccServer_vpc,
 			"VPCAddressAllocationIDs":                                testAccServer_vpcAddressAllocationIDs,
 			"VPCAddressAllocationIDsSecurityGroupIDs":                testAccServer_vpcAddressAllocationIds_securityGroupIDs,
+			"VPCAddressAllocationIDsStopped":                         testAccServer_vpcAddressAllocationIDsStopped,
 			"VPCEndpointID":                                          testAccServer_vpcEndpointID,
 			"VPCSecurityGroupIDs":                                    testAccServer_vpcSecurityGroupIDs,
 			"Workflow":                                               testAccServer_workflowDetails,
@@ -63,6 +77,7 @@ func TestAccTransfer_serial(t *testing.T) {
 		"SSHKey": {
 			acctest.CtBasic:      testAccSSHKey_basic,
 			acctest.CtDisappears: testAccSSHKey_disappears,
+			"DeletePriorKeys":    testAccSSHKey_deletePriorKeys,
 		},
 		"Tag": {
 			acctest.CtBasic:      testAccTag_basic,
@@ -71,13 +86,17 @@ func TestAccTransfer_serial(t *testing.T) {
 			"System":             testAccTag_system,
 		},
 		"User": {
-			acctest.CtBasic:         testAccUser_basic,
-			acctest.CtDisappears:    testAccUser_disappears,
-			"tags":                  testAccUser_tags,
-			"HomeDirectoryMappings": testAccUser_homeDirectoryMappings,
-			"ModifyWithOptions":     testAccUser_modifyWithOptions,
-			"Posix":                 testAccUser_posix,
-			"UserNameValidation":    testAccUser_UserName_Validation,
+			acctest.CtBasic:                   testAccUser_basic,
+			acctest.CtDisappears:              testAccUser_disappears,
+			"tags":                            testAccUser_tags,
+			"DataSourceBasic":                 testAccUserDataSource_basic,
+			"DataSourcePosix":                 testAccUserDataSource_posix,
+			"HomeDirectoryMappings":           testAccUser_homeDirectoryMappings,
+			"HomeDirectoryMappingsValidation": testAccUser_homeDirectoryMappingsValidation,
+			"HomeDirectoryMappingsVerifyEFS":  testAccUser_homeDirectoryMappingsVerifyEFS,
+			"ModifyWithOptions":               testAccUser_modifyWithOptions,
+			"Posix":                           testAccUser_posix,
+			"UserNameValidation":              testAccUser_UserName_Validation,
 		},
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfefs "github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -61,7 +64,7 @@ func resourceUser() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"entry": {
+						names.AttrEntry: {
 							Type:         schema.TypeString,
 							Required:     true,
 							ValidateFunc: validation.StringLenBetween(0, 1024),
@@ -103,8 +106,12 @@ func resourceUser() *schema.Resource {
 						},
 						"secondary_gids": {
 							Type:     schema.TypeSet,
-							Elem:     &schema.Schema{Type: schema.TypeInt},
 							Optional: true,
+							MaxItems: 16,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validPOSIXID,
+							},
 						},
 						"uid": {
 							Type:     schema.TypeInt,
@@ -132,9 +139,16 @@ func resourceUser() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validUserName,
 			},
+			"verify_efs_home_directory_mappings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceUserHomeDirectoryMappingsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -164,6 +178,16 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.HomeDirectoryType = awstypes.HomeDirectoryType(v.(string))
 	}
 
+	if d.Get("verify_efs_home_directory_mappings").(bool) && input.HomeDirectoryType == awstypes.HomeDirectoryTypeLogical {
+		targets := tfslices.ApplyToAll(input.HomeDirectoryMappings, func(v awstypes.HomeDirectoryMapEntry) string {
+			return aws.ToString(v.Target)
+		})
+
+		if err := verifyEFSHomeDirectoryMappingTargets(ctx, meta.(*conns.AWSClient), serverID, targets); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Transfer User (%s): %s", id, err)
+		}
+	}
+
 	if v, ok := d.GetOk(names.AttrPolicy); ok {
 		policy, err := structure.NormalizeJsonString(v.(string))
 		if err != nil {
@@ -261,6 +285,16 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.HomeDirectoryType = awstypes.HomeDirectoryType(d.Get("home_directory_type").(string))
 		}
 
+		if d.HasChanges("home_directory_mappings", "home_directory_type", "verify_efs_home_directory_mappings") && d.Get("verify_efs_home_directory_mappings").(bool) && awstypes.HomeDirectoryType(d.Get("home_directory_type").(string)) == awstypes.HomeDirectoryTypeLogical {
+			targets := tfslices.ApplyToAll(expandHomeDirectoryMapEntries(d.Get("home_directory_mappings").([]interface{})), func(v awstypes.HomeDirectoryMapEntry) string {
+				return aws.ToString(v.Target)
+			})
+
+			if err := verifyEFSHomeDirectoryMappingTargets(ctx, meta.(*conns.AWSClient), serverID, targets); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Transfer User (%s): %s", d.Id(), err)
+			}
+		}
+
 		if d.HasChange(names.AttrPolicy) {
 			policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
 			if err != nil {
@@ -306,6 +340,95 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 
 const userResourceIDSeparator = "/"
 
+func resourceUserHomeDirectoryMappingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("home_directory_type") || !d.NewValueKnown("home_directory_mappings") {
+		return nil
+	}
+
+	tfList := d.Get("home_directory_mappings").([]interface{})
+
+	if v := awstypes.HomeDirectoryType(d.Get("home_directory_type").(string)); v != awstypes.HomeDirectoryTypeLogical {
+		if len(tfList) > 0 {
+			return fmt.Errorf("home_directory_mappings can only be configured when home_directory_type is %s, got %s", awstypes.HomeDirectoryTypeLogical, v)
+		}
+
+		return nil
+	}
+
+	entries := make(map[string]struct{})
+	var targets []string
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if key := fmt.Sprintf("home_directory_mappings.%d.entry", i); d.NewValueKnown(key) {
+			entry := tfMap[names.AttrEntry].(string)
+
+			if err := validHomeDirectoryMappingEntry(entry); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+
+			if _, ok := entries[entry]; ok {
+				return fmt.Errorf("%s: duplicate entry %q", key, entry)
+			}
+			entries[entry] = struct{}{}
+		}
+
+		if key := fmt.Sprintf("home_directory_mappings.%d.target", i); d.NewValueKnown(key) {
+			target := tfMap[names.AttrTarget].(string)
+
+			if err := validHomeDirectoryMappingTarget(target); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+
+			targets = append(targets, target)
+		}
+	}
+
+	// Server IDs and targets that are unknown at plan time are verified during apply.
+	if !d.Get("verify_efs_home_directory_mappings").(bool) || !d.NewValueKnown("server_id") {
+		return nil
+	}
+
+	return verifyEFSHomeDirectoryMappingTargets(ctx, meta.(*conns.AWSClient), d.Get("server_id").(string), targets)
+}
+
+// verifyEFSHomeDirectoryMappingTargets checks that the EFS file system referenced by each
+// target exists when the server uses the EFS domain.
+func verifyEFSHomeDirectoryMappingTargets(ctx context.Context, client *conns.AWSClient, serverID string, targets []string) error {
+	server, err := findServerByID(ctx, client.TransferClient(ctx), serverID)
+
+	if err != nil {
+		return fmt.Errorf("reading Transfer Server (%s): %w", serverID, err)
+	}
+
+	if server.Domain != awstypes.DomainEfs {
+		return nil
+	}
+
+	conn := client.EFSConn(ctx)
+
+	for _, target := range targets {
+		// EFS targets are of the form "/<file-system-id>/<path>".
+		fileSystemID, _, _ := strings.Cut(strings.TrimPrefix(target, "/"), "/")
+
+		_, err := tfefs.FindFileSystemByID(ctx, conn, fileSystemID)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("home_directory_mappings target %q: EFS File System (%s) not found", target, fileSystemID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EFS File System (%s): %w", fileSystemID, err)
+		}
+	}
+
+	return nil
+}
+
 func userCreateResourceID(serverID, userName string) string {
 	parts := []string{serverID, userName}
 	id := strings.Join(parts, userResourceIDSeparator)
@@ -392,7 +515,7 @@ func expandHomeDirectoryMapEntries(tfList []interface{}) []awstypes.HomeDirector
 		}
 
 		apiObject := awstypes.HomeDirectoryMapEntry{
-			Entry:  aws.String(tfMap["entry"].(string)),
+			Entry:  aws.String(tfMap[names.AttrEntry].(string)),
 			Target: aws.String(tfMap[names.AttrTarget].(string)),
 		}
 
@@ -407,7 +530,7 @@ func flattenHomeDirectoryMapEntries(apiObjects []awstypes.HomeDirectoryMapEntry)
 
 	for i, apiObject := range apiObjects {
 		tfList[i] = map[string]interface{}{
-			"entry":          aws.ToString(apiObject.Entry),
+			names.AttrEntry:  aws.ToString(apiObject.Entry),
 			names.AttrTarget: aws.ToString(apiObject.Target),
 		}
 	}
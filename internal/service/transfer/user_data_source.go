@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_transfer_user", name="User")
+// @Tags
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUserRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"home_directory": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"home_directory_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEntry: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTarget: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"home_directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"posix_profile": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"secondary_gids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"uid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrRole: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validServerID,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrUserName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validUserName,
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	serverID := d.Get("server_id").(string)
+	userName := d.Get(names.AttrUserName).(string)
+	id := userCreateResourceID(serverID, userName)
+	user, err := findUserByTwoPartKey(ctx, conn, serverID, userName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer User (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, user.Arn)
+	d.Set("home_directory", user.HomeDirectory)
+	if err := d.Set("home_directory_mappings", flattenHomeDirectoryMapEntries(user.HomeDirectoryMappings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting home_directory_mappings: %s", err)
+	}
+	d.Set("home_directory_type", user.HomeDirectoryType)
+	d.Set(names.AttrPolicy, user.Policy)
+	if err := d.Set("posix_profile", flattenPOSIXProfile(user.PosixProfile)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting posix_profile: %s", err)
+	}
+	d.Set(names.AttrRole, user.Role)
+	d.Set("server_id", serverID)
+	d.Set(names.AttrUserName, user.UserName)
+
+	setTagsOut(ctx, user.Tags)
+
+	return diags
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccUserDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_user.test"
+	dataSourceName := "data.aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "home_directory_mappings.#", resourceName, "home_directory_mappings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "home_directory_mappings.0.entry", resourceName, "home_directory_mappings.0.entry"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "home_directory_mappings.0.target", resourceName, "home_directory_mappings.0.target"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "home_directory_type", resourceName, "home_directory_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "posix_profile.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRole, resourceName, names.AttrRole),
+					resource.TestCheckResourceAttrPair(dataSourceName, "server_id", resourceName, "server_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrUserName, resourceName, names.AttrUserName),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserDataSource_posix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_user.test"
+	dataSourceName := "data.aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig_posix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "posix_profile.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "posix_profile.0.gid", resourceName, "posix_profile.0.gid"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "posix_profile.0.secondary_gids.#", resourceName, "posix_profile.0.secondary_gids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "posix_profile.0.uid", resourceName, "posix_profile.0.uid"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRole, resourceName, names.AttrRole),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_homeDirectoryMappings(rName, "/your-personal-report.pdf", "/bucket3/customized-reports/tftestuser.pdf"), `
+data "aws_transfer_user" "test" {
+  server_id = aws_transfer_user.test.server_id
+  user_name = aws_transfer_user.test.user_name
+}
+`)
+}
+
+func testAccUserDataSourceConfig_posix(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_posixUpdated(rName), `
+data "aws_transfer_user" "test" {
+  server_id = aws_transfer_user.test.server_id
+  user_name = aws_transfer_user.test.user_name
+}
+`)
+}
@@ -298,6 +298,85 @@ func testAccUser_homeDirectoryMappings(t *testing.T) {
 	})
 }
 
+func testAccUser_homeDirectoryMappingsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "your-personal-report.pdf", "/bucket3/customized-reports/tftestuser.pdf"),
+				ExpectError: regexache.MustCompile(`must be an absolute path`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "/your-personal-report.pdf", "bucket3/customized-reports/tftestuser.pdf"),
+				ExpectError: regexache.MustCompile(`must be an absolute path`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "/your-personal-report.pdf", "//customized-reports/tftestuser.pdf"),
+				ExpectError: regexache.MustCompile(`must begin with an S3 bucket name or EFS file system ID`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappingsUpdate(rName, "/report.pdf", "/bucket3/report1.pdf", "/report.pdf", "/bucket3/report2.pdf"),
+				ExpectError: regexache.MustCompile(`duplicate entry`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "/reports/", "/bucket3/reports"),
+				ExpectError: regexache.MustCompile(`must not end with "/"`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "/reports", "/Bucket_3/reports"),
+				ExpectError: regexache.MustCompile(`must begin with an S3 bucket name or EFS file system ID`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappingsPathType(rName),
+				ExpectError: regexache.MustCompile(`home_directory_mappings can only be configured when home_directory_type is LOGICAL`),
+			},
+		},
+	})
+}
+
+func testAccUser_homeDirectoryMappingsVerifyEFS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedUser
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_homeDirectoryMappingsVerifyEFSBase(rName),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappingsVerifyEFS(rName, `"/fs-00000000/home"`),
+				ExpectError: regexache.MustCompile(`EFS File System \(fs-00000000\) not found`),
+			},
+			{
+				Config: testAccUserConfig_homeDirectoryMappingsVerifyEFS(rName, `"/${aws_efs_file_system.test.id}/home"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "verify_efs_home_directory_mappings", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_efs_home_directory_mappings"},
+			},
+		},
+	})
+}
+
 func testAccCheckUserExists(ctx context.Context, n string, v *awstypes.DescribedUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -670,6 +749,26 @@ resource "aws_transfer_user" "test" {
 `, rName, entry1, target1, entry2, target2))
 }
 
+func testAccUserConfig_homeDirectoryMappingsPathType(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  home_directory_type = "PATH"
+  role                = aws_iam_role.test.arn
+  server_id           = aws_transfer_server.test.id
+  user_name           = "tftestuser"
+
+  home_directory_mappings {
+    entry  = "/reports"
+    target = "/bucket3/reports"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccUserConfig_homeDirectoryMappingsRemove(rName string) string {
 	return acctest.ConfigCompose(testAccUserConfig_base(rName), fmt.Sprintf(`
 resource "aws_transfer_user" "test" {
@@ -684,6 +783,50 @@ resource "aws_transfer_user" "test" {
 `, rName))
 }
 
+func testAccUserConfig_homeDirectoryMappingsVerifyEFSBase(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_baseRole(rName), fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  domain = "EFS"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_file_system" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccUserConfig_homeDirectoryMappingsVerifyEFS(rName, target string) string {
+	return acctest.ConfigCompose(testAccUserConfig_homeDirectoryMappingsVerifyEFSBase(rName), fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  home_directory_type                = "LOGICAL"
+  role                               = aws_iam_role.test.arn
+  server_id                          = aws_transfer_server.test.id
+  user_name                          = "tftestuser"
+  verify_efs_home_directory_mappings = true
+
+  home_directory_mappings {
+    entry  = "/"
+    target = %[2]s
+  }
+
+  posix_profile {
+    gid = 1000
+    uid = 1000
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, target))
+}
+
 func testAccUserConfig_posix(rName string) string {
 	return acctest.ConfigCompose(testAccUserConfig_baseRole(rName), fmt.Sprintf(`
 resource "aws_transfer_server" "test" {
@@ -5,6 +5,8 @@ package transfer
 
 import (
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 )
@@ -31,3 +33,54 @@ func validUserName(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// validPOSIXID validates a POSIX user or group ID, an unsigned 32-bit integer.
+// The comparison is done as int64 so that it also compiles for 32-bit targets.
+func validPOSIXID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	// https://docs.aws.amazon.com/transfer/latest/userguide/API_PosixProfile.html
+	if value < 0 || int64(value) > math.MaxUint32 {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and %d, got: %d", k, uint32(math.MaxUint32), value))
+	}
+
+	return
+}
+
+// validHomeDirectoryMappingEntry validates the entry of a LOGICAL home directory mapping.
+// The entry is the path visible to the user and must be absolute.
+func validHomeDirectoryMappingEntry(entry string) error {
+	// https://docs.aws.amazon.com/transfer/latest/userguide/logical-dir-mappings.html
+	if !strings.HasPrefix(entry, "/") {
+		return fmt.Errorf("entry %q must be an absolute path beginning with \"/\"", entry)
+	}
+
+	if strings.Contains(entry, "//") {
+		return fmt.Errorf("entry %q must not contain empty path segments", entry)
+	}
+
+	if len(entry) > 1 && strings.HasSuffix(entry, "/") {
+		return fmt.Errorf("entry %q must not end with \"/\"", entry)
+	}
+
+	return nil
+}
+
+// validHomeDirectoryMappingTarget validates the target of a LOGICAL home directory mapping.
+// The target must be of the form "/<bucket-or-file-system-id>/<path>".
+func validHomeDirectoryMappingTarget(target string) error {
+	if !strings.HasPrefix(target, "/") {
+		return fmt.Errorf("target %q must be an absolute path beginning with \"/\"", target)
+	}
+
+	// Both S3 bucket names and EFS file system IDs match this pattern.
+	if root, _, _ := strings.Cut(strings.TrimPrefix(target, "/"), "/"); !regexache.MustCompile(`^[0-9a-z][0-9a-z.-]{1,61}[0-9a-z]$`).MatchString(root) {
+		return fmt.Errorf("target %q must begin with an S3 bucket name or EFS file system ID", target)
+	}
+
+	if strings.Contains(target, "//") {
+		return fmt.Errorf("target %q must not contain empty path segments", target)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package transfer
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +40,10 @@ func resourceWorkflow() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceWorkflowStepsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -772,6 +777,52 @@ func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// workflowStepDetailsAttributes maps each step type to the configuration block that holds its details.
+var workflowStepDetailsAttributes = map[awstypes.WorkflowStepType]string{
+	awstypes.WorkflowStepTypeCopy:    "copy_step_details",
+	awstypes.WorkflowStepTypeCustom:  "custom_step_details",
+	awstypes.WorkflowStepTypeDecrypt: "decrypt_step_details",
+	awstypes.WorkflowStepTypeDelete:  "delete_step_details",
+	awstypes.WorkflowStepTypeTag:     "tag_step_details",
+}
+
+func resourceWorkflowStepsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"on_exception_steps", "steps"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+
+		for i, tfMapRaw := range d.Get(k).([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			stepType := awstypes.WorkflowStepType(tfMap[names.AttrType].(string))
+
+			for _, t := range enum.EnumValues[awstypes.WorkflowStepType]() {
+				attr, ok := workflowStepDetailsAttributes[t]
+				if !ok {
+					continue
+				}
+
+				key := fmt.Sprintf("%s.%d.%s", k, i, attr)
+				v, _ := tfMap[attr].([]interface{})
+
+				switch {
+				// All of a DELETE step's details are optional.
+				case t == stepType && t != awstypes.WorkflowStepTypeDelete && len(v) == 0:
+					return fmt.Errorf("%s is required for a %s step", key, stepType)
+				case t != stepType && len(v) > 0:
+					return fmt.Errorf("%s cannot be configured for a %s step", key, stepType)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func findWorkflowByID(ctx context.Context, conn *transfer.Client, id string) (*awstypes.DescribedWorkflow, error) {
 	input := &transfer.DescribeWorkflowInput{
 		WorkflowId: aws.String(id),
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_transfer_workflow_executions", name="Workflow Executions")
+func dataSourceWorkflowExecutions() *schema.Resource {
+	stepResultsSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"error_message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"error_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"outputs": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrType: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWorkflowExecutionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"initial_file_location": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"efs_file_location": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrFileSystemID: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrPath: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"s3_file_location": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrBucket: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"etag": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"version_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"on_exception_step_results": stepResultsSchema(),
+						"server_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"step_results": stepResultsSchema(),
+						names.AttrUserName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"workflow_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceWorkflowExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+
+	workflowID := d.Get("workflow_id").(string)
+	executions, err := findExecutionsByWorkflowID(ctx, conn, workflowID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Workflow (%s) executions: %s", workflowID, err)
+	}
+
+	tfList := make([]interface{}, 0, len(executions))
+
+	for _, execution := range executions {
+		executionID := aws.ToString(execution.ExecutionId)
+		tfMap := map[string]interface{}{
+			"execution_id":          executionID,
+			"initial_file_location": flattenFileLocation(execution.InitialFileLocation),
+			names.AttrStatus:        execution.Status,
+		}
+
+		if v := execution.ServiceMetadata; v != nil && v.UserDetails != nil {
+			tfMap["server_id"] = aws.ToString(v.UserDetails.ServerId)
+			tfMap["session_id"] = aws.ToString(v.UserDetails.SessionId)
+			tfMap[names.AttrUserName] = aws.ToString(v.UserDetails.UserName)
+		}
+
+		// Step results are only available while the execution is in progress.
+		output, err := findExecutionByTwoPartKey(ctx, conn, workflowID, executionID)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Transfer Workflow (%s) execution (%s): %s", workflowID, executionID, err)
+		default:
+			if v := output.Results; v != nil {
+				tfMap["on_exception_step_results"] = flattenExecutionStepResults(v.OnExceptionSteps)
+				tfMap["step_results"] = flattenExecutionStepResults(v.Steps)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(workflowID)
+	if err := d.Set("executions", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting executions: %s", err)
+	}
+
+	return diags
+}
+
+func findExecutionsByWorkflowID(ctx context.Context, conn *transfer.Client, workflowID string) ([]awstypes.ListedExecution, error) {
+	input := &transfer.ListExecutionsInput{
+		WorkflowId: aws.String(workflowID),
+	}
+	var output []awstypes.ListedExecution
+
+	pages := transfer.NewListExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Executions...)
+	}
+
+	return output, nil
+}
+
+func findExecutionByTwoPartKey(ctx context.Context, conn *transfer.Client, workflowID, executionID string) (*awstypes.DescribedExecution, error) {
+	input := &transfer.DescribeExecutionInput{
+		ExecutionId: aws.String(executionID),
+		WorkflowId:  aws.String(workflowID),
+	}
+
+	output, err := conn.DescribeExecution(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Execution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Execution, nil
+}
+
+func flattenFileLocation(apiObject *awstypes.FileLocation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EfsFileLocation; v != nil {
+		tfMap["efs_file_location"] = []interface{}{map[string]interface{}{
+			names.AttrFileSystemID: aws.ToString(v.FileSystemId),
+			names.AttrPath:         aws.ToString(v.Path),
+		}}
+	}
+
+	if v := apiObject.S3FileLocation; v != nil {
+		tfMap["s3_file_location"] = []interface{}{map[string]interface{}{
+			names.AttrBucket: aws.ToString(v.Bucket),
+			"etag":           aws.ToString(v.Etag),
+			names.AttrKey:    aws.ToString(v.Key),
+			"version_id":     aws.ToString(v.VersionId),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExecutionStepResults(apiObjects []awstypes.ExecutionStepResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"outputs":      aws.ToString(apiObject.Outputs),
+			names.AttrType: apiObject.StepType,
+		}
+
+		if v := apiObject.Error; v != nil {
+			tfMap["error_message"] = aws.ToString(v.Message)
+			tfMap["error_type"] = v.Type
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
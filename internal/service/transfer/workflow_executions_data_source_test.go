@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTransferWorkflowExecutionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_transfer_workflow.test"
+	dataSourceName := "data.aws_transfer_workflow_executions.test"
+	rName := sdkacctest.RandString(25)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowExecutionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "executions.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workflow_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowExecutionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_basic(rName), `
+data "aws_transfer_workflow_executions" "test" {
+  workflow_id = aws_transfer_workflow.test.id
+}
+`)
+}
@@ -296,6 +296,71 @@ func TestAccTransferWorkflow_allSteps(t *testing.T) {
 	})
 }
 
+func TestAccTransferWorkflow_onExceptionStepsAllTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedWorkflow
+	resourceName := "aws_transfer_workflow.test"
+	rName := sdkacctest.RandString(25)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_onExceptionStepsAllTypes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.0.type", "COPY"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.0.copy_step_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.0.copy_step_details.0.destination_file_location.0.s3_file_location.0.bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.0.copy_step_details.0.destination_file_location.0.s3_file_location.0.key", "failed/"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.0.copy_step_details.0.overwrite_existing", "FALSE"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.1.type", "TAG"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.1.tag_step_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.1.tag_step_details.0.tags.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.1.tag_step_details.0.tags.0.key", names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.1.tag_step_details.0.tags.0.value", "failed"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.2.type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.2.custom_step_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "on_exception_steps.2.custom_step_details.0.target", "aws_lambda_function.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.2.custom_step_details.0.timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "steps.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferWorkflow_stepDetailsMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(25)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkflowConfig_stepDetailsMismatch(rName),
+				ExpectError: regexache.MustCompile(`steps.0.delete_step_details cannot be configured for a TAG step`),
+			},
+			{
+				Config:      testAccWorkflowConfig_stepDetailsMissing(rName),
+				ExpectError: regexache.MustCompile(`steps.0.copy_step_details is required for a COPY step`),
+			},
+		},
+	})
+}
+
 func testAccCheckWorkflowExists(ctx context.Context, n string, v *awstypes.DescribedWorkflow) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -521,3 +586,88 @@ resource "aws_transfer_workflow" "test" {
 }
 `, rName))
 }
+
+func testAccWorkflowConfig_onExceptionStepsAllTypes(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name                 = %[1]q
+      source_file_location = "$${original.file}"
+    }
+    type = "DELETE"
+  }
+
+  on_exception_steps {
+    copy_step_details {
+      name                 = "copy"
+      source_file_location = "$${original.file}"
+      destination_file_location {
+        s3_file_location {
+          bucket = %[1]q
+          key    = "failed/"
+        }
+      }
+    }
+    type = "COPY"
+  }
+
+  on_exception_steps {
+    tag_step_details {
+      name                 = "tag"
+      source_file_location = "$${original.file}"
+
+      tags {
+        key   = "status"
+        value = "failed"
+      }
+    }
+    type = "TAG"
+  }
+
+  on_exception_steps {
+    custom_step_details {
+      name                 = "notify"
+      source_file_location = "$${original.file}"
+      target               = aws_lambda_function.test.arn
+      timeout_seconds      = 60
+    }
+    type = "CUSTOM"
+  }
+}
+`, rName))
+}
+
+func testAccWorkflowConfig_stepDetailsMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name                 = %[1]q
+      source_file_location = "$${original.file}"
+    }
+    type = "TAG"
+  }
+}
+`, rName)
+}
+
+func testAccWorkflowConfig_stepDetailsMissing(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  description = %[1]q
+
+  steps {
+    type = "COPY"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cedar "github.com/cedar-policy/cedar-go/x/exp/parser"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cedarStatementsEquivalent reports whether two Cedar statements differ only in whitespace and comments.
+func cedarStatementsEquivalent(a, b string) bool {
+	tokensA, err := cedar.Tokenize([]byte(a))
+	if err != nil {
+		return false
+	}
+
+	tokensB, err := cedar.Tokenize([]byte(b))
+	if err != nil {
+		return false
+	}
+
+	if len(tokensA) != len(tokensB) {
+		return false
+	}
+
+	for i := range tokensA {
+		if tokensA[i].Type != tokensB[i].Type || tokensA[i].Text != tokensB[i].Text {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cedarStatementSemanticEquality returns a plan modifier that keeps the prior state value
+// when the planned Cedar statement is semantically equivalent to it.
+func cedarStatementSemanticEquality() planmodifier.String {
+	return cedarStatementSemanticEqualityModifier{}
+}
+
+type cedarStatementSemanticEqualityModifier struct{}
+
+func (m cedarStatementSemanticEqualityModifier) Description(_ context.Context) string {
+	return "Suppresses differences in whitespace and comments between Cedar statements."
+}
+
+func (m cedarStatementSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m cedarStatementSemanticEqualityModifier) PlanModifyString(_ context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	if request.StateValue.IsNull() || request.PlanValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	if cedarStatementsEquivalent(request.StateValue.ValueString(), request.PlanValue.ValueString()) {
+		response.PlanValue = request.StateValue
+	}
+}
+
+// cedarSchemaValidator returns a validator which ensures that a string is a Cedar schema in JSON format.
+// See https://docs.cedarpolicy.com/schema/json-schema.html.
+func cedarSchemaValidator() validator.String {
+	return cedarSchemaValidatorValidator{}
+}
+
+type cedarSchemaValidatorValidator struct{}
+
+func (v cedarSchemaValidatorValidator) Description(_ context.Context) string {
+	return "value must be a Cedar schema in JSON format"
+}
+
+func (v cedarSchemaValidatorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarSchemaValidatorValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCedarSchema(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Cedar Schema",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+func validateCedarSchema(s string) error {
+	var namespaces map[string]map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(s), &namespaces); err != nil {
+		return fmt.Errorf("schema must be a JSON object of namespaces: %w", err)
+	}
+
+	for name, namespace := range namespaces {
+		for _, key := range []string{"entityTypes", "actions"} {
+			v, ok := namespace[key]
+			if !ok {
+				return fmt.Errorf("namespace %q is missing %q", name, key)
+			}
+
+			var object map[string]json.RawMessage
+			if err := json.Unmarshal(v, &object); err != nil {
+				return fmt.Errorf("namespace %q %q must be a JSON object: %w", name, key, err)
+			}
+		}
+	}
+
+	return nil
+}
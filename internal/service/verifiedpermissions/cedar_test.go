@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"testing"
+
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+)
+
+func TestCedarStatementsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"identical": {
+			a:        `permit (principal, action, resource);`,
+			b:        `permit (principal, action, resource);`,
+			expected: true,
+		},
+		"whitespace": {
+			a: `permit (principal, action, resource);`,
+			b: `permit (
+  principal,
+  action,
+  resource
+);`,
+			expected: true,
+		},
+		"comments": {
+			a: `permit (principal, action, resource);`,
+			b: `// Allow everything.
+permit (principal, action, resource);`,
+			expected: true,
+		},
+		"different effect": {
+			a:        `permit (principal, action, resource);`,
+			b:        `forbid (principal, action, resource);`,
+			expected: false,
+		},
+		"different string": {
+			a:        `permit (principal, action == Action::"view", resource);`,
+			b:        `permit (principal, action == Action::"edit", resource);`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfverifiedpermissions.CedarStatementsEquivalent(testCase.a, testCase.b), testCase.expected; got != want {
+				t.Errorf("CedarStatementsEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateCedarSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema    string
+		expectErr bool
+	}{
+		"empty": {
+			schema: `{}`,
+		},
+		"valid": {
+			schema: `{"PhotoFlash":{"entityTypes":{"User":{}},"actions":{"view":{}}}}`,
+		},
+		"not an object": {
+			schema:    `[]`,
+			expectErr: true,
+		},
+		"namespace not an object": {
+			schema:    `{"PhotoFlash":"entityTypes"}`,
+			expectErr: true,
+		},
+		"missing entityTypes": {
+			schema:    `{"PhotoFlash":{"actions":{}}}`,
+			expectErr: true,
+		},
+		"missing actions": {
+			schema:    `{"PhotoFlash":{"entityTypes":{}}}`,
+			expectErr: true,
+		},
+		"actions not an object": {
+			schema:    `{"PhotoFlash":{"entityTypes":{},"actions":[]}}`,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfverifiedpermissions.ValidateCedarSchema(testCase.schema)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("ValidateCedarSchema() error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
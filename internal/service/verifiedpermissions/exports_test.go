@@ -5,17 +5,21 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
+	ResourceIdentitySource = newResourceIdentitySource
 	ResourcePolicy         = newResourcePolicy
 	ResourcePolicyStore    = newResourcePolicyStore
 	ResourcePolicyTemplate = newResourcePolicyTemplate
 	ResourceSchema         = newResourceSchema
 
-	FindPolicyByID            = findPolicyByID
-	FindPolicyStoreByID       = findPolicyStoreByID
-	FindPolicyTemplateByID    = findPolicyTemplateByID
-	FindSchemaByPolicyStoreID = findSchemaByPolicyStoreID
+	FindIdentitySourceByTwoPartKey = findIdentitySourceByTwoPartKey
+	FindPolicyByID                 = findPolicyByID
+	FindPolicyStoreByID            = findPolicyStoreByID
+	FindPolicyTemplateByID         = findPolicyTemplateByID
+	FindSchemaByPolicyStoreID      = findSchemaByPolicyStoreID
 )
 
 var (
-	PolicyTemplateParseID = policyTemplateParseID
+	CedarStatementsEquivalent = cedarStatementsEquivalent
+	PolicyTemplateParseID     = policyTemplateParseID
+	ValidateCedarSchema       = validateCedarSchema
 )
@@ -0,0 +1,748 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIdentitySource{}
+
+	return r, nil
+}
+
+const (
+	ResNameIdentitySource = "Identity Source"
+
+	identitySourceResourceIDPartCount = 2
+)
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	clientIDsAttribute := schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID:         framework.IDAttribute(),
+			"identity_source_id": framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identitySourceConfiguration](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoUserPoolConfiguration](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("open_id_connect_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": clientIDsAttribute,
+									"user_pool_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"group_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoGroupConfiguration](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"group_entity_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"open_id_connect_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectConfiguration](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_id_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrIssuer: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"group_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectGroupConfiguration](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"group_claim": schema.StringAttribute{
+													Required: true,
+												},
+												"group_entity_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"token_selection": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectTokenSelection](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.IsRequired(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"access_token_only": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectAccessTokenConfiguration](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("identity_token_only"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"audiences": schema.ListAttribute{
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"principal_id_claim": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.UseStateForUnknown(),
+																},
+															},
+														},
+													},
+												},
+												"identity_token_only": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectIdentityTokenConfiguration](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"client_ids": schema.ListAttribute{
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"principal_id_claim": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.UseStateForUnknown(),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := expandIdentitySourceConfiguration(ctx, plan.Configuration)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:         aws.String(id.UniqueId()),
+		Configuration:       configuration,
+		PolicyStoreId:       fwflex.StringFromFramework(ctx, plan.PolicyStoreID),
+		PrincipalEntityType: fwflex.StringFromFramework(ctx, plan.PrincipalEntityType),
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	rID, err := interflex.FlattenResourceId([]string{aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId)}, identitySourceResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, rID)
+	plan.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+
+	out, err := findIdentitySourceByTwoPartKey(ctx, conn, aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId))
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(plan.refreshFromOutput(ctx, out)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := interflex.ExpandResourceId(state.ID.ValueString(), identitySourceResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIdentitySourceByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Configuration.Equal(state.Configuration) || !plan.PrincipalEntityType.Equal(state.PrincipalEntityType) {
+		configuration, diags := expandIdentitySourceUpdateConfiguration(ctx, plan.Configuration)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    fwflex.StringFromFramework(ctx, state.IdentitySourceID),
+			PolicyStoreId:       fwflex.StringFromFramework(ctx, state.PolicyStoreID),
+			PrincipalEntityType: fwflex.StringFromFramework(ctx, plan.PrincipalEntityType),
+			UpdateConfiguration: configuration,
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		output, err := findIdentitySourceByTwoPartKey(ctx, conn, state.PolicyStoreID.ValueString(), state.IdentitySourceID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Identity Source", map[string]interface{}{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: fwflex.StringFromFramework(ctx, state.IdentitySourceID),
+		PolicyStoreId:    fwflex.StringFromFramework(ctx, state.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findIdentitySourceByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, identitySourceID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	in := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	out, err := conn.GetIdentitySource(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdentitySourceId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceIdentitySourceData struct {
+	Configuration       fwtypes.ListNestedObjectValueOf[identitySourceConfiguration] `tfsdk:"configuration"`
+	ID                  types.String                                                 `tfsdk:"id"`
+	IdentitySourceID    types.String                                                 `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String                                                 `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String                                                 `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfiguration struct {
+	CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfiguration] `tfsdk:"cognito_user_pool_configuration"`
+	OpenIDConnectConfiguration   fwtypes.ListNestedObjectValueOf[openIDConnectConfiguration]   `tfsdk:"open_id_connect_configuration"`
+}
+
+type cognitoUserPoolConfiguration struct {
+	ClientIDs          types.List                                                 `tfsdk:"client_ids"`
+	GroupConfiguration fwtypes.ListNestedObjectValueOf[cognitoGroupConfiguration] `tfsdk:"group_configuration"`
+	UserPoolARN        fwtypes.ARN                                                `tfsdk:"user_pool_arn"`
+}
+
+type cognitoGroupConfiguration struct {
+	GroupEntityType types.String `tfsdk:"group_entity_type"`
+}
+
+type openIDConnectConfiguration struct {
+	EntityIDPrefix     types.String                                                     `tfsdk:"entity_id_prefix"`
+	GroupConfiguration fwtypes.ListNestedObjectValueOf[openIDConnectGroupConfiguration] `tfsdk:"group_configuration"`
+	Issuer             types.String                                                     `tfsdk:"issuer"`
+	TokenSelection     fwtypes.ListNestedObjectValueOf[openIDConnectTokenSelection]     `tfsdk:"token_selection"`
+}
+
+type openIDConnectGroupConfiguration struct {
+	GroupClaim      types.String `tfsdk:"group_claim"`
+	GroupEntityType types.String `tfsdk:"group_entity_type"`
+}
+
+type openIDConnectTokenSelection struct {
+	AccessTokenOnly   fwtypes.ListNestedObjectValueOf[openIDConnectAccessTokenConfiguration]   `tfsdk:"access_token_only"`
+	IdentityTokenOnly fwtypes.ListNestedObjectValueOf[openIDConnectIdentityTokenConfiguration] `tfsdk:"identity_token_only"`
+}
+
+type openIDConnectAccessTokenConfiguration struct {
+	Audiences        types.List   `tfsdk:"audiences"`
+	PrincipalIDClaim types.String `tfsdk:"principal_id_claim"`
+}
+
+type openIDConnectIdentityTokenConfiguration struct {
+	ClientIDs        types.List   `tfsdk:"client_ids"`
+	PrincipalIDClaim types.String `tfsdk:"principal_id_claim"`
+}
+
+func (data *resourceIdentitySourceData) refreshFromOutput(ctx context.Context, output *verifiedpermissions.GetIdentitySourceOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+	data.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PrincipalEntityType = fwflex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	configuration, d := flattenIdentitySourceConfiguration(ctx, output.Configuration)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Configuration = configuration
+
+	return diags
+}
+
+func expandIdentitySourceConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[identitySourceConfiguration]) (awstypes.Configuration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configuration == nil {
+		return nil, diags
+	}
+
+	if !configuration.CognitoUserPoolConfiguration.IsNull() {
+		tfObject, d := configuration.CognitoUserPoolConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := awstypes.CognitoUserPoolConfiguration{
+			ClientIds:   fwflex.ExpandFrameworkStringValueList(ctx, tfObject.ClientIDs),
+			UserPoolArn: fwflex.StringFromFramework(ctx, tfObject.UserPoolARN),
+		}
+
+		if !tfObject.GroupConfiguration.IsNull() {
+			group, d := tfObject.GroupConfiguration.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			apiObject.GroupConfiguration = &awstypes.CognitoGroupConfiguration{
+				GroupEntityType: fwflex.StringFromFramework(ctx, group.GroupEntityType),
+			}
+		}
+
+		return &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{Value: apiObject}, diags
+	}
+
+	if !configuration.OpenIDConnectConfiguration.IsNull() {
+		tfObject, d := configuration.OpenIDConnectConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := awstypes.OpenIdConnectConfiguration{
+			EntityIdPrefix: fwflex.StringFromFramework(ctx, tfObject.EntityIDPrefix),
+			Issuer:         fwflex.StringFromFramework(ctx, tfObject.Issuer),
+		}
+
+		if !tfObject.GroupConfiguration.IsNull() {
+			group, d := tfObject.GroupConfiguration.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			apiObject.GroupConfiguration = &awstypes.OpenIdConnectGroupConfiguration{
+				GroupClaim:      fwflex.StringFromFramework(ctx, group.GroupClaim),
+				GroupEntityType: fwflex.StringFromFramework(ctx, group.GroupEntityType),
+			}
+		}
+
+		tokenSelection, d := tfObject.TokenSelection.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if tokenSelection != nil {
+			if !tokenSelection.AccessTokenOnly.IsNull() {
+				token, d := tokenSelection.AccessTokenOnly.ToPtr(ctx)
+				diags.Append(d...)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				apiObject.TokenSelection = &awstypes.OpenIdConnectTokenSelectionMemberAccessTokenOnly{
+					Value: awstypes.OpenIdConnectAccessTokenConfiguration{
+						Audiences:        fwflex.ExpandFrameworkStringValueList(ctx, token.Audiences),
+						PrincipalIdClaim: fwflex.StringFromFramework(ctx, token.PrincipalIDClaim),
+					},
+				}
+			}
+
+			if !tokenSelection.IdentityTokenOnly.IsNull() {
+				token, d := tokenSelection.IdentityTokenOnly.ToPtr(ctx)
+				diags.Append(d...)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				apiObject.TokenSelection = &awstypes.OpenIdConnectTokenSelectionMemberIdentityTokenOnly{
+					Value: awstypes.OpenIdConnectIdentityTokenConfiguration{
+						ClientIds:        fwflex.ExpandFrameworkStringValueList(ctx, token.ClientIDs),
+						PrincipalIdClaim: fwflex.StringFromFramework(ctx, token.PrincipalIDClaim),
+					},
+				}
+			}
+		}
+
+		return &awstypes.ConfigurationMemberOpenIdConnectConfiguration{Value: apiObject}, diags
+	}
+
+	return nil, diags
+}
+
+// expandIdentitySourceUpdateConfiguration converts the configuration to the equivalent UpdateIdentitySource shape.
+func expandIdentitySourceUpdateConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[identitySourceConfiguration]) (awstypes.UpdateConfiguration, diag.Diagnostics) {
+	configuration, diags := expandIdentitySourceConfiguration(ctx, tfList)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch v := configuration.(type) {
+	case *awstypes.ConfigurationMemberCognitoUserPoolConfiguration:
+		apiObject := awstypes.UpdateCognitoUserPoolConfiguration{
+			ClientIds:   v.Value.ClientIds,
+			UserPoolArn: v.Value.UserPoolArn,
+		}
+
+		if group := v.Value.GroupConfiguration; group != nil {
+			apiObject.GroupConfiguration = &awstypes.UpdateCognitoGroupConfiguration{
+				GroupEntityType: group.GroupEntityType,
+			}
+		}
+
+		return &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{Value: apiObject}, diags
+
+	case *awstypes.ConfigurationMemberOpenIdConnectConfiguration:
+		apiObject := awstypes.UpdateOpenIdConnectConfiguration{
+			EntityIdPrefix: v.Value.EntityIdPrefix,
+			Issuer:         v.Value.Issuer,
+		}
+
+		if group := v.Value.GroupConfiguration; group != nil {
+			apiObject.GroupConfiguration = &awstypes.UpdateOpenIdConnectGroupConfiguration{
+				GroupClaim:      group.GroupClaim,
+				GroupEntityType: group.GroupEntityType,
+			}
+		}
+
+		switch token := v.Value.TokenSelection.(type) {
+		case *awstypes.OpenIdConnectTokenSelectionMemberAccessTokenOnly:
+			apiObject.TokenSelection = &awstypes.UpdateOpenIdConnectTokenSelectionMemberAccessTokenOnly{
+				Value: awstypes.UpdateOpenIdConnectAccessTokenConfiguration{
+					Audiences:        token.Value.Audiences,
+					PrincipalIdClaim: token.Value.PrincipalIdClaim,
+				},
+			}
+		case *awstypes.OpenIdConnectTokenSelectionMemberIdentityTokenOnly:
+			apiObject.TokenSelection = &awstypes.UpdateOpenIdConnectTokenSelectionMemberIdentityTokenOnly{
+				Value: awstypes.UpdateOpenIdConnectIdentityTokenConfiguration{
+					ClientIds:        token.Value.ClientIds,
+					PrincipalIdClaim: token.Value.PrincipalIdClaim,
+				},
+			}
+		}
+
+		return &awstypes.UpdateConfigurationMemberOpenIdConnectConfiguration{Value: apiObject}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenIdentitySourceConfiguration(ctx context.Context, apiObject awstypes.ConfigurationDetail) (fwtypes.ListNestedObjectValueOf[identitySourceConfiguration], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration := identitySourceConfiguration{
+		CognitoUserPoolConfiguration: fwtypes.NewListNestedObjectValueOfNull[cognitoUserPoolConfiguration](ctx),
+		OpenIDConnectConfiguration:   fwtypes.NewListNestedObjectValueOfNull[openIDConnectConfiguration](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ConfigurationDetailMemberCognitoUserPoolConfiguration:
+		tfObject := cognitoUserPoolConfiguration{
+			ClientIDs:          fwflex.FlattenFrameworkStringValueList(ctx, v.Value.ClientIds),
+			GroupConfiguration: fwtypes.NewListNestedObjectValueOfNull[cognitoGroupConfiguration](ctx),
+			UserPoolARN:        fwtypes.ARNValue(aws.ToString(v.Value.UserPoolArn)),
+		}
+
+		if group := v.Value.GroupConfiguration; group != nil {
+			tfObject.GroupConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cognitoGroupConfiguration{
+				GroupEntityType: fwflex.StringToFramework(ctx, group.GroupEntityType),
+			})
+		}
+
+		configuration.CognitoUserPoolConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+
+	case *awstypes.ConfigurationDetailMemberOpenIdConnectConfiguration:
+		tfObject := openIDConnectConfiguration{
+			EntityIDPrefix:     fwflex.StringToFramework(ctx, v.Value.EntityIdPrefix),
+			GroupConfiguration: fwtypes.NewListNestedObjectValueOfNull[openIDConnectGroupConfiguration](ctx),
+			Issuer:             fwflex.StringToFramework(ctx, v.Value.Issuer),
+			TokenSelection:     fwtypes.NewListNestedObjectValueOfNull[openIDConnectTokenSelection](ctx),
+		}
+
+		if group := v.Value.GroupConfiguration; group != nil {
+			tfObject.GroupConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &openIDConnectGroupConfiguration{
+				GroupClaim:      fwflex.StringToFramework(ctx, group.GroupClaim),
+				GroupEntityType: fwflex.StringToFramework(ctx, group.GroupEntityType),
+			})
+		}
+
+		tokenSelection := openIDConnectTokenSelection{
+			AccessTokenOnly:   fwtypes.NewListNestedObjectValueOfNull[openIDConnectAccessTokenConfiguration](ctx),
+			IdentityTokenOnly: fwtypes.NewListNestedObjectValueOfNull[openIDConnectIdentityTokenConfiguration](ctx),
+		}
+
+		switch token := v.Value.TokenSelection.(type) {
+		case *awstypes.OpenIdConnectTokenSelectionDetailMemberAccessTokenOnly:
+			tokenSelection.AccessTokenOnly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &openIDConnectAccessTokenConfiguration{
+				Audiences:        fwflex.FlattenFrameworkStringValueList(ctx, token.Value.Audiences),
+				PrincipalIDClaim: fwflex.StringToFramework(ctx, token.Value.PrincipalIdClaim),
+			})
+		case *awstypes.OpenIdConnectTokenSelectionDetailMemberIdentityTokenOnly:
+			tokenSelection.IdentityTokenOnly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &openIDConnectIdentityTokenConfiguration{
+				ClientIDs:        fwflex.FlattenFrameworkStringValueList(ctx, token.Value.ClientIds),
+				PrincipalIDClaim: fwflex.StringToFramework(ctx, token.Value.PrincipalIdClaim),
+			})
+		}
+
+		tfObject.TokenSelection = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tokenSelection)
+
+		configuration.OpenIDConnectConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[identitySourceConfiguration](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &configuration), diags
+}
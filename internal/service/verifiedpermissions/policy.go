@@ -96,6 +96,7 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 									"statement": schema.StringAttribute{
 										Required: true,
 										PlanModifiers: []planmodifier.String{
+											cedarStatementSemanticEquality(),
 											stringplanmodifier.RequiresReplaceIf(
 												statementReplaceIf, "Replace cedar statement diff", "Replace cedar statement diff",
 											),
@@ -77,6 +77,9 @@ func (r *resourcePolicyTemplate) Schema(ctx context.Context, request resource.Sc
 			},
 			"statement": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					cedarStatementSemanticEquality(),
+				},
 			},
 		},
 	}
@@ -28,6 +28,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newWorkspaceApplicationAssociationResource,
+			Name:    "Workspace Application Association",
+		},
 	}
 }
 
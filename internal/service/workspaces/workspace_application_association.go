@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Workspace Application Association")
+func newWorkspaceApplicationAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workspaceApplicationAssociationResource{}
+
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type workspaceApplicationAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*workspaceApplicationAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspaces_workspace_application_association"
+}
+
+func (r *workspaceApplicationAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *workspaceApplicationAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workspaceApplicationAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	input := &workspaces.AssociateWorkspaceApplicationInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		WorkspaceId:   fwflex.StringFromFramework(ctx, data.WorkspaceID),
+	}
+
+	output, err := conn.AssociateWorkspaceApplication(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Workspace (%s) Application (%s) Association", data.WorkspaceID.ValueString(), data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+	data.State = fwflex.StringValueToFramework(ctx, output.Association.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workspaceApplicationAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workspaceApplicationAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	output, err := findWorkspaceApplicationAssociationByTwoPartKey(ctx, conn, data.WorkspaceID.ValueString(), data.ApplicationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Workspace Application Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.State = fwflex.StringValueToFramework(ctx, output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workspaceApplicationAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workspaceApplicationAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	_, err := conn.DisassociateWorkspaceApplication(ctx, &workspaces.DisassociateWorkspaceApplicationInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		WorkspaceId:   fwflex.StringFromFramework(ctx, data.WorkspaceID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Workspace Application Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitWorkspaceApplicationAssociationDeleted(ctx, conn, data.WorkspaceID.ValueString(), data.ApplicationID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Workspace Application Association (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findWorkspaceApplicationAssociationByTwoPartKey(ctx context.Context, conn *workspaces.Client, workspaceID, applicationID string) (*awstypes.WorkspaceResourceAssociation, error) {
+	input := &workspaces.DescribeWorkspaceAssociationsInput{
+		AssociatedResourceTypes: []awstypes.WorkSpaceAssociatedResourceType{awstypes.WorkSpaceAssociatedResourceTypeApplication},
+		WorkspaceId:             aws.String(workspaceID),
+	}
+
+	output, err := conn.DescribeWorkspaceAssociations(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Associations {
+		if aws.ToString(v.AssociatedResourceId) != applicationID {
+			continue
+		}
+
+		if v.State == awstypes.AssociationStateRemoved {
+			return nil, &retry.NotFoundError{
+				Message:     string(v.State),
+				LastRequest: input,
+			}
+		}
+
+		return &v, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func statusWorkspaceApplicationAssociation(ctx context.Context, conn *workspaces.Client, workspaceID, applicationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findWorkspaceApplicationAssociationByTwoPartKey(ctx, conn, workspaceID, applicationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitWorkspaceApplicationAssociationDeleted(ctx context.Context, conn *workspaces.Client, workspaceID, applicationID string, timeout time.Duration) (*awstypes.WorkspaceResourceAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.AssociationStatePendingUninstall,
+			awstypes.AssociationStatePendingUninstallDeployment,
+			awstypes.AssociationStateUninstalling,
+		),
+		Target:  []string{},
+		Refresh: statusWorkspaceApplicationAssociation(ctx, conn, workspaceID, applicationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspaceResourceAssociation); ok {
+		if v := output.StateReason; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", v.ErrorCode, aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type workspaceApplicationAssociationResourceModel struct {
+	ApplicationID types.String   `tfsdk:"application_id"`
+	ID            types.String   `tfsdk:"id"`
+	State         types.String   `tfsdk:"state"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	WorkspaceID   types.String   `tfsdk:"workspace_id"`
+}
+
+const (
+	workspaceApplicationAssociationResourceIDPartCount = 2
+)
+
+func (m *workspaceApplicationAssociationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), workspaceApplicationAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.WorkspaceID = types.StringValue(parts[0])
+	m.ApplicationID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *workspaceApplicationAssociationResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.WorkspaceID.ValueString(), m.ApplicationID.ValueString()}, workspaceApplicationAssociationResourceIDPartCount, false)))
+}
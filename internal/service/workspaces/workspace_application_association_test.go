@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspaces "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccWorkspaceApplicationAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceResourceAssociation
+	rName := sdkacctest.RandString(8)
+	domain := acctest.RandomDomainName()
+	applicationID := acctest.SkipIfEnvVarNotSet(t, "WORKSPACES_APPLICATION_ID")
+	resourceName := "aws_workspaces_workspace_application_association.test"
+	workspaceResourceName := "aws_workspaces_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceApplicationAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceApplicationAssociationConfig_basic(rName, domain, applicationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceApplicationAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrApplicationID, applicationID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrState},
+			},
+		},
+	})
+}
+
+func testAccWorkspaceApplicationAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceResourceAssociation
+	rName := sdkacctest.RandString(8)
+	domain := acctest.RandomDomainName()
+	applicationID := acctest.SkipIfEnvVarNotSet(t, "WORKSPACES_APPLICATION_ID")
+	resourceName := "aws_workspaces_workspace_application_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceApplicationAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceApplicationAssociationConfig_basic(rName, domain, applicationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceApplicationAssociationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspaces.ResourceWorkspaceApplicationAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceApplicationAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspaces_workspace_application_association" {
+				continue
+			}
+
+			_, err := tfworkspaces.FindWorkspaceApplicationAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes[names.AttrApplicationID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Workspace Application Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkspaceApplicationAssociationExists(ctx context.Context, n string, v *types.WorkspaceResourceAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		output, err := tfworkspaces.FindWorkspaceApplicationAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes[names.AttrApplicationID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkspaceApplicationAssociationConfig_basic(rName, domain, applicationID string) string {
+	return acctest.ConfigCompose(
+		testAccWorkspaceConfig_basic(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_workspace_application_association" "test" {
+  application_id = %[1]q
+  workspace_id   = aws_workspaces_workspace.test.id
+}
+`, applicationID))
+}
@@ -40,6 +40,10 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"workspaceProperties":    testAccWorkspace_workspaceProperties,
 			"workspaceProperties_runningModeAlwaysOn": testAccWorkspace_workspaceProperties_runningModeAlwaysOn,
 		},
+		"WorkspaceApplicationAssociation": {
+			acctest.CtBasic:      testAccWorkspaceApplicationAssociation_basic,
+			acctest.CtDisappears: testAccWorkspaceApplicationAssociation_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
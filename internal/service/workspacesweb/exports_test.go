@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+// Exports for use in tests only.
+var (
+	ResourceBrowserSettings             = newBrowserSettingsResource
+	ResourceBrowserSettingsAssociation  = newBrowserSettingsAssociationResource
+	ResourceIPAccessSettings            = newIPAccessSettingsResource
+	ResourceIPAccessSettingsAssociation = newIPAccessSettingsAssociationResource
+	ResourceNetworkSettings             = newNetworkSettingsResource
+	ResourceNetworkSettingsAssociation  = newNetworkSettingsAssociationResource
+	ResourcePortal                      = newPortalResource
+	ResourceTrustStore                  = newTrustStoreResource
+	ResourceTrustStoreAssociation       = newTrustStoreAssociationResource
+	ResourceUserSettings                = newUserSettingsResource
+	ResourceUserSettingsAssociation     = newUserSettingsAssociationResource
+
+	FindBrowserSettingsAssociationByTwoPartKey  = findBrowserSettingsAssociationByTwoPartKey
+	FindBrowserSettingsByARN                    = findBrowserSettingsByARN
+	FindIPAccessSettingsAssociationByTwoPartKey = findIPAccessSettingsAssociationByTwoPartKey
+	FindIPAccessSettingsByARN                   = findIPAccessSettingsByARN
+	FindNetworkSettingsAssociationByTwoPartKey  = findNetworkSettingsAssociationByTwoPartKey
+	FindNetworkSettingsByARN                    = findNetworkSettingsByARN
+	FindPortalByARN                             = findPortalByARN
+	FindTrustStoreAssociationByTwoPartKey       = findTrustStoreAssociationByTwoPartKey
+	FindTrustStoreByARN                         = findTrustStoreByARN
+	FindUserSettingsAssociationByTwoPartKey     = findUserSettingsAssociationByTwoPartKey
+	FindUserSettingsByARN                       = findUserSettingsByARN
+)
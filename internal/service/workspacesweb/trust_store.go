@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trust Store")
+// @Tags(identifierAttribute="id")
+func newTrustStoreResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &trustStoreResource{}
+
+	return r, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*trustStoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_trust_store"
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"certificate_list": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandCertificateList(fwflex.ExpandFrameworkStringValueSet(ctx, data.CertificateList)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	data.TrustStoreARN = fwflex.StringToFramework(ctx, output.TrustStoreArn)
+	data.setID()
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueSet(ctx, trustStore.AssociatedPortalArns)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueSet(ctx, output.AssociatedPortalArns)
+
+	// Preserve the configured encoding of certificates that are still present.
+	var certificateList []string
+	configured := fwflex.ExpandFrameworkStringValueSet(ctx, data.CertificateList)
+	for _, certificate := range certificates {
+		if v, ok := findCertificateInList(configured, certificate.Body); ok {
+			certificateList = append(certificateList, v)
+		} else {
+			certificateList = append(certificateList, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER(certificate.Body)})))
+		}
+	}
+	data.CertificateList = fwflex.FlattenFrameworkStringValueSet(ctx, certificateList)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.CertificateList.Equal(old.CertificateList) {
+		oldCertificates, newCertificates := fwflex.ExpandFrameworkStringValueSet(ctx, old.CertificateList), fwflex.ExpandFrameworkStringValueSet(ctx, new.CertificateList)
+		add, del := newCertificates.Difference(oldCertificates), oldCertificates.Difference(newCertificates)
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			CertificatesToAdd: expandCertificateList(add),
+			TrustStoreArn:     fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		if len(del) > 0 {
+			certificates, err := findTrustStoreCertificatesByARN(ctx, conn, new.ID.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", new.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			for _, certificate := range certificates {
+				if _, ok := findCertificateInList(del, certificate.Body); ok {
+					input.CertificatesToDelete = append(input.CertificatesToDelete, aws.ToString(certificate.Thumbprint))
+				}
+			}
+		}
+
+		_, err := conn.UpdateTrustStore(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findTrustStoreByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueSet(ctx, output.AssociatedPortalArns)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteTrustStore(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.Client, arn string) ([]awstypes.Certificate, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []awstypes.Certificate
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The list operation only returns certificate summaries, so fetch each body.
+		for _, v := range page.CertificateList {
+			certificate, err := findTrustStoreCertificateByTwoPartKey(ctx, conn, arn, aws.ToString(v.Thumbprint))
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *certificate)
+		}
+	}
+
+	return output, nil
+}
+
+func findTrustStoreCertificateByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, arn, thumbprint string) (*awstypes.Certificate, error) {
+	input := &workspacesweb.GetTrustStoreCertificateInput{
+		Thumbprint:    aws.String(thumbprint),
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreCertificate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Certificate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificate, nil
+}
+
+func expandCertificateList(certificates []string) [][]byte {
+	return tfslices.ApplyToAll(certificates, func(v string) []byte {
+		return []byte(v)
+	})
+}
+
+// certificateDER returns the DER encoding of a PEM or DER encoded certificate.
+func certificateDER(v []byte) []byte {
+	if block, _ := pem.Decode(v); block != nil {
+		return block.Bytes
+	}
+
+	return v
+}
+
+// findCertificateInList returns the certificate in the list with the same DER encoding as body.
+func findCertificateInList(certificates []string, body []byte) (string, bool) {
+	der := certificateDER(body)
+
+	for _, v := range certificates {
+		if bytes.Equal(certificateDER([]byte(v)), der) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs types.Set    `tfsdk:"associated_portal_arns"`
+	CertificateList      types.Set    `tfsdk:"certificate_list"`
+	ID                   types.String `tfsdk:"id"`
+	Tags                 types.Map    `tfsdk:"tags"`
+	TagsAll              types.Map    `tfsdk:"tags_all"`
+	TrustStoreARN        types.String `tfsdk:"arn"`
+}
+
+func (m *trustStoreResourceModel) InitFromID() error {
+	m.TrustStoreARN = m.ID
+
+	return nil
+}
+
+func (m *trustStoreResourceModel) setID() {
+	m.ID = m.TrustStoreARN
+}
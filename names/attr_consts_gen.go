@@ -74,6 +74,7 @@ const (
 	AttrEndpoints                  = "endpoints"
 	AttrEngine                     = "engine"
 	AttrEngineVersion              = "engine_version"
+	AttrEntry                      = "entry"
 	AttrEnvironment                = "environment"
 	AttrExecutionRoleARN           = "execution_role_arn"
 	AttrExpectedBucketOwner        = "expected_bucket_owner"
@@ -110,6 +110,7 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GreengrassV2                 = "greengrassv2"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
 	HealthLake                   = "healthlake"
@@ -158,6 +159,7 @@ const (
 	NeptuneGraph                 = "neptunegraph"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
+	NetworkMonitor               = "networkmonitor"
 	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpenSearchIngestion          = "osis"
@@ -182,6 +184,7 @@ const (
 	RedshiftData                 = "redshiftdata"
 	RedshiftServerless           = "redshiftserverless"
 	Rekognition                  = "rekognition"
+	ResilienceHub                = "resiliencehub"
 	ResourceExplorer2            = "resourceexplorer2"
 	ResourceGroups               = "resourcegroups"
 	ResourceGroupsTaggingAPI     = "resourcegroupstaggingapi"
@@ -222,6 +225,7 @@ const (
 	Shield                       = "shield"
 	Signer                       = "signer"
 	SimpleDB                     = "simpledb"
+	Snowball                     = "snowball"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
 	TimestreamInfluxDB           = "timestreaminfluxdb"
@@ -351,6 +355,7 @@ const (
 	GlueServiceID                         = "Glue"
 	GrafanaServiceID                      = "grafana"
 	GreengrassServiceID                   = "Greengrass"
+	GreengrassV2ServiceID                 = "GreengrassV2"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
 	HealthLakeServiceID                   = "HealthLake"
@@ -399,6 +404,7 @@ const (
 	NeptuneGraphServiceID                 = "Neptune Graph"
 	NetworkFirewallServiceID              = "Network Firewall"
 	NetworkManagerServiceID               = "NetworkManager"
+	NetworkMonitorServiceID               = "NetworkMonitor"
 	ObservabilityAccessManagerServiceID   = "OAM"
 	OpenSearchServiceID                   = "OpenSearch"
 	OpenSearchIngestionServiceID          = "OSIS"
@@ -423,6 +429,7 @@ const (
 	RedshiftDataServiceID                 = "Redshift Data"
 	RedshiftServerlessServiceID           = "Redshift Serverless"
 	RekognitionServiceID                  = "Rekognition"
+	ResilienceHubServiceID                = "resiliencehub"
 	ResourceExplorer2ServiceID            = "Resource Explorer 2"
 	ResourceGroupsServiceID               = "Resource Groups"
 	ResourceGroupsTaggingAPIServiceID     = "Resource Groups Tagging API"
@@ -463,6 +470,7 @@ const (
 	ShieldServiceID                       = "Shield"
 	SignerServiceID                       = "signer"
 	SimpleDBServiceID                     = "SimpleDB"
+	SnowballServiceID                     = "Snowball"
 	StorageGatewayServiceID               = "Storage Gateway"
 	SyntheticsServiceID                   = "synthetics"
 	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
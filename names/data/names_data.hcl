@@ -4720,7 +4720,7 @@ service "greengrassv2" {
 
   sdk {
     id             = "GreengrassV2"
-    client_version = [1]
+    client_version = [2]
   }
 
   names {
@@ -4732,6 +4732,10 @@ service "greengrassv2" {
     go_v1_client_typename = "GreengrassV2"
   }
 
+  endpoint_info {
+    endpoint_api_call = "ListComponents"
+  }
+
   resource_prefix {
     correct = "aws_greengrassv2_"
   }
@@ -4739,7 +4743,6 @@ service "greengrassv2" {
   provider_package_correct = "greengrassv2"
   doc_prefix               = ["greengrassv2_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "iotjobsdata" {
@@ -6463,6 +6466,31 @@ service "networkmanager" {
   brand                    = "AWS"
 }
 
+service "networkmonitor" {
+
+  sdk {
+    id             = "NetworkMonitor"
+    client_version = [2]
+  }
+
+  names {
+    provider_name_upper = "NetworkMonitor"
+    human_friendly      = "CloudWatch Network Monitor"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListMonitors"
+  }
+
+  resource_prefix {
+    correct = "aws_networkmonitor_"
+  }
+
+  provider_package_correct = "networkmonitor"
+  doc_prefix               = ["networkmonitor_"]
+  brand                    = "AWS"
+}
+
 service "nimble" {
 
   go_packages {
@@ -7481,7 +7509,7 @@ service "resiliencehub" {
 
   sdk {
     id             = "resiliencehub"
-    client_version = [1]
+    client_version = [2]
   }
 
   names {
@@ -7493,6 +7521,10 @@ service "resiliencehub" {
     go_v1_client_typename = "ResilienceHub"
   }
 
+  endpoint_info {
+    endpoint_api_call = "ListApps"
+  }
+
   resource_prefix {
     correct = "aws_resiliencehub_"
   }
@@ -7500,7 +7532,6 @@ service "resiliencehub" {
   provider_package_correct = "resiliencehub"
   doc_prefix               = ["resiliencehub_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "resourceexplorer2" {
@@ -8657,6 +8688,10 @@ service "snowball" {
     go_v1_client_typename = "Snowball"
   }
 
+  endpoint_info {
+    endpoint_api_call = "ListJobs"
+  }
+
   resource_prefix {
     correct = "aws_snowball_"
   }
@@ -8664,7 +8699,6 @@ service "snowball" {
   provider_package_correct = "snowball"
   doc_prefix               = ["snowball_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "sns" {
@@ -82,6 +82,7 @@ func ConstOrQuote(constant string) string {
 		"endpoints":                     "AttrEndpoints",
 		"engine":                        "AttrEngine",
 		"engine_version":                "AttrEngineVersion",
+		"entry":                         "AttrEntry",
 		"environment":                   "AttrEnvironment",
 		"execution_role_arn":            "AttrExecutionRoleARN",
 		"expected_bucket_owner":         "AttrExpectedBucketOwner",